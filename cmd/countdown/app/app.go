@@ -17,6 +17,8 @@ import (
 	"github.com/iov-one/weave/x/batch"
 	"github.com/iov-one/weave/x/cash"
 	"github.com/iov-one/weave/x/cron"
+	"github.com/iov-one/weave/x/gov"
+	"github.com/iov-one/weave/x/msgfee"
 	"github.com/iov-one/weave/x/multisig"
 	"github.com/iov-one/weave/x/sigs"
 	"github.com/iov-one/weave/x/utils"
@@ -47,6 +49,8 @@ func Chain(authFn x.Authenticator, minFee coin.Coin) app.Decorators {
 		sigs.NewDecorator(),
 		multisig.NewDecorator(authFn),
 		cash.NewFeeDecorator(authFn, CashControl()),
+		// per message fees are required on top of the gas based fee
+		msgfee.NewFeeDecorator(),
 		batch.NewDecorator(),
 		utils.NewSavepoint().OnDeliver(),
 	)
@@ -62,12 +66,45 @@ func Router(authFn x.Authenticator, issuer weave.Address) *app.Router {
 	multisig.RegisterRoutes(r, authFn)
 	migration.RegisterRoutes(r, authFn)
 	validators.RegisterRoutes(r, authFn)
-	countdown.RegisterRoutes(r, authFn, scheduler)
+	gov.RegisterRoutes(r, authFn, decodeProposalOptions, proposalOptionsExecutor(scheduler), scheduler)
+	countdown.RegisterRoutes(r, authFn, scheduler, CashControl())
 	return r
 }
 
+// proposalOptionsExecutor returns an executor for the proposal options that
+// were accepted by a governance vote. Only messages that are part of the
+// ProposalOptions can be executed.
+func proposalOptionsExecutor(scheduler weave.Scheduler) gov.Executor {
+	r := app.NewRouter()
+	// only the election rule of the proposal authorizes the execution
+	authFn := gov.Authenticate{}
+
+	cash.RegisterRoutes(r, authFn, CashControl())
+	multisig.RegisterRoutes(r, authFn)
+	migration.RegisterRoutes(r, authFn)
+	validators.RegisterRoutes(r, authFn)
+	gov.RegisterBasicProposalRouters(r, authFn)
+	countdown.RegisterRoutes(r, authFn, scheduler, CashControl())
+
+	return gov.HandlerAsExecutor(app.ChainDecorators(
+		utils.NewActionTagger(),
+		batch.NewDecorator(),
+	).WithHandler(r))
+}
+
+// decodeProposalOptions unpacks the raw option of a proposal into the message
+// that is executed when the proposal is accepted.
+func decodeProposalOptions(raw []byte) (weave.Msg, error) {
+	var options ProposalOptions
+	if err := options.Unmarshal(raw); err != nil {
+		return nil, errors.Wrap(err, "cannot unmarshal proposal options")
+	}
+	return weave.ExtractMsgFromSum(options.GetOption())
+}
+
 // QueryRouter returns a default query router,
-// allowing access to "/countdown", "/auth", "/contracts", "/wallets", "/validators" and "/"
+// allowing access to "/countdown", "/auth", "/contracts", "/wallets", "/validators",
+// "/proposals", "/electorates", "/electionRules" and "/"
 func QueryRouter() weave.QueryRouter {
 	r := weave.NewQueryRouter()
 	r.RegisterAll(
@@ -77,6 +114,8 @@ func QueryRouter() weave.QueryRouter {
 		migration.RegisterQuery,
 		orm.RegisterQuery,
 		validators.RegisterQuery,
+		msgfee.RegisterQuery,
+		gov.RegisterQuery,
 		countdown.RegisterQuery,
 	)
 	return r
@@ -100,7 +139,8 @@ func CronStack() weave.Handler {
 	authFn := cron.Authenticator{}
 
 	// Cron is using custom router as not the same handlers are registered.
-	countdown.RegisterCronRoutes(rt, authFn, scheduler)
+	gov.RegisterCronRoutes(rt, authFn, decodeProposalOptions, proposalOptionsExecutor(scheduler))
+	countdown.RegisterCronRoutes(rt, authFn, scheduler, CashControl())
 
 	decorators := app.ChainDecorators(
 		utils.NewLogging(),
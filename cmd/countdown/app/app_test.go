@@ -0,0 +1,89 @@
+package countdown
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/gov"
+	"github.com/ng2dev/countdown/x/countdown"
+)
+
+func TestProposalUpdatesCountdownConfiguration(t *testing.T) {
+	elector := weavetest.NewCondition()
+
+	raw, err := GenInitOptions([]string{"IOV", hex.EncodeToString(elector.Address())})
+	assert.Nil(t, err)
+	var opts weave.Options
+	err = json.Unmarshal(raw, &opts)
+	assert.Nil(t, err)
+
+	kv := store.MemStore()
+	err = Initializers().FromGenesis(opts, weave.GenesisParams{}, kv)
+	assert.Nil(t, err)
+
+	// the elector alone cannot update the configuration
+	auth := &weavetest.Auth{Signer: elector}
+	rt := Router(auth, nil)
+	now := time.Now().Round(time.Second)
+	patch := &countdown.UpdateConfigurationMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Patch:    &countdown.Configuration{MaxLineLength: 200},
+	}
+	_, err = rt.Deliver(blockCtx(now), kv, &weavetest.Tx{Msg: patch})
+	if !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want a configuration update outside of governance to fail, got %+v", err)
+	}
+
+	option, err := (&ProposalOptions{
+		Option: &ProposalOptions_CdUpdateConfigurationMsg{CdUpdateConfigurationMsg: patch},
+	}).Marshal()
+	assert.Nil(t, err)
+
+	start := now.Add(time.Hour)
+	res, err := rt.Deliver(blockCtx(now), kv, &weavetest.Tx{Msg: &gov.CreateProposalMsg{
+		Metadata:       &weave.Metadata{Schema: 1},
+		Title:          "Longer lines",
+		Description:    "Allow lines of up to 200 characters.",
+		RawOption:      option,
+		ElectionRuleID: weavetest.SequenceID(1),
+		StartTime:      weave.AsUnixTime(start),
+	}})
+	assert.Nil(t, err)
+	proposalID := res.Data
+
+	_, err = rt.Deliver(blockCtx(start.Add(time.Minute)), kv, &weavetest.Tx{Msg: &gov.VoteMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		ProposalID: proposalID,
+		Selected:   gov.VoteOption_Yes,
+	}})
+	assert.Nil(t, err)
+
+	// the tally is executed by the cron once the voting period ended
+	_, err = CronStack().Deliver(blockCtx(start.Add(25*time.Hour)), kv, &weavetest.Tx{Msg: &gov.TallyMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		ProposalID: proposalID,
+	}})
+	assert.Nil(t, err)
+
+	var conf countdown.Configuration
+	err = gconf.Load(kv, "countdown", &conf)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(200), conf.MaxLineLength)
+	assert.Equal(t, electionRuleAddress(1), conf.Owner)
+}
+
+// blockCtx returns the context of a block created at the given time.
+func blockCtx(t time.Time) weave.Context {
+	ctx := weave.WithHeight(context.Background(), 1)
+	ctx = weave.WithChainID(ctx, "test-chain")
+	return weave.WithBlockTime(ctx, t)
+}
@@ -7,13 +7,14 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
-	countdown "github.com/ng2dev/countdown/x/countdown"
 	github_com_iov_one_weave "github.com/iov-one/weave"
 	migration "github.com/iov-one/weave/migration"
 	cash "github.com/iov-one/weave/x/cash"
+	gov "github.com/iov-one/weave/x/gov"
 	multisig "github.com/iov-one/weave/x/multisig"
 	sigs "github.com/iov-one/weave/x/sigs"
 	validators "github.com/iov-one/weave/x/validators"
+	countdown "github.com/ng2dev/countdown/x/countdown"
 	io "io"
 	math "math"
 )
@@ -56,9 +57,40 @@ type Tx struct {
 	//	*Tx_ValidatorsApplyDiffMsg
 	//	*Tx_ExecuteBatchMsg
 	//	*Tx_MigrationUpgradeSchemaMsg
+	//	*Tx_GovCreateProposalMsg
+	//	*Tx_GovDeleteProposalMsg
+	//	*Tx_GovVoteMsg
+	//	*Tx_GovUpdateElectorateMsg
+	//	*Tx_GovUpdateElectionRuleMsg
 	//	*Tx_CdCreateUserMsg
 	//	*Tx_CdCreateCountdownMsg
 	//	*Tx_CdDeleteCountdownMsg
+	//	*Tx_CdGrantRoleMsg
+	//	*Tx_CdRevokeRoleMsg
+	//	*Tx_CdUpdateLyricsMsg
+	//	*Tx_CdPauseCountdownMsg
+	//	*Tx_CdResumeCountdownMsg
+	//	*Tx_CdTransferCountdownMsg
+	//	*Tx_CdAcceptCountdownTransferMsg
+	//	*Tx_CdTipCountdownMsg
+	//	*Tx_CdSetUnlockPriceMsg
+	//	*Tx_CdUnlockNextLineMsg
+	//	*Tx_CdDeleteUserMsg
+	//	*Tx_CdUpdateConfigurationMsg
+	//	*Tx_CdFlagCountdownMsg
+	//	*Tx_CdModerateCountdownMsg
+	//	*Tx_CdCreateCountdownDraftMsg
+	//	*Tx_CdAppendLyricsChunkMsg
+	//	*Tx_CdPublishCountdownMsg
+	//	*Tx_CdMigrateCountdownsMsg
+	//	*Tx_CdCreateSeriesMsg
+	//	*Tx_CdAddSeriesCountdownMsg
+	//	*Tx_CdRemoveSeriesCountdownMsg
+	//	*Tx_CdReorderSeriesMsg
+	//	*Tx_CdSetSuccessorMsg
+	//	*Tx_CdRestartCountdownMsg
+	//	*Tx_CdForkCountdownMsg
+	//	*Tx_CdDeleteCountdownDraftMsg
 	Sum isTx_Sum `protobuf_oneof:"sum"`
 }
 
@@ -119,6 +151,21 @@ type Tx_ExecuteBatchMsg struct {
 type Tx_MigrationUpgradeSchemaMsg struct {
 	MigrationUpgradeSchemaMsg *migration.UpgradeSchemaMsg `protobuf:"bytes,69,opt,name=migration_upgrade_schema_msg,json=migrationUpgradeSchemaMsg,proto3,oneof"`
 }
+type Tx_GovCreateProposalMsg struct {
+	GovCreateProposalMsg *gov.CreateProposalMsg `protobuf:"bytes,73,opt,name=gov_create_proposal_msg,json=govCreateProposalMsg,proto3,oneof"`
+}
+type Tx_GovDeleteProposalMsg struct {
+	GovDeleteProposalMsg *gov.DeleteProposalMsg `protobuf:"bytes,74,opt,name=gov_delete_proposal_msg,json=govDeleteProposalMsg,proto3,oneof"`
+}
+type Tx_GovVoteMsg struct {
+	GovVoteMsg *gov.VoteMsg `protobuf:"bytes,75,opt,name=gov_vote_msg,json=govVoteMsg,proto3,oneof"`
+}
+type Tx_GovUpdateElectorateMsg struct {
+	GovUpdateElectorateMsg *gov.UpdateElectorateMsg `protobuf:"bytes,77,opt,name=gov_update_electorate_msg,json=govUpdateElectorateMsg,proto3,oneof"`
+}
+type Tx_GovUpdateElectionRuleMsg struct {
+	GovUpdateElectionRuleMsg *gov.UpdateElectionRuleMsg `protobuf:"bytes,78,opt,name=gov_update_election_rule_msg,json=govUpdateElectionRuleMsg,proto3,oneof"`
+}
 type Tx_CdCreateUserMsg struct {
 	CdCreateUserMsg *countdown.CreateUserMsg `protobuf:"bytes,100,opt,name=cd_create_user_msg,json=cdCreateUserMsg,proto3,oneof"`
 }
@@ -128,16 +175,125 @@ type Tx_CdCreateCountdownMsg struct {
 type Tx_CdDeleteCountdownMsg struct {
 	CdDeleteCountdownMsg *countdown.DeleteCountdownMsg `protobuf:"bytes,102,opt,name=cd_delete_countdown_msg,json=cdDeleteCountdownMsg,proto3,oneof"`
 }
+type Tx_CdGrantRoleMsg struct {
+	CdGrantRoleMsg *countdown.GrantRoleMsg `protobuf:"bytes,103,opt,name=cd_grant_role_msg,json=cdGrantRoleMsg,proto3,oneof"`
+}
+type Tx_CdRevokeRoleMsg struct {
+	CdRevokeRoleMsg *countdown.RevokeRoleMsg `protobuf:"bytes,104,opt,name=cd_revoke_role_msg,json=cdRevokeRoleMsg,proto3,oneof"`
+}
+type Tx_CdUpdateLyricsMsg struct {
+	CdUpdateLyricsMsg *countdown.UpdateLyricsMsg `protobuf:"bytes,105,opt,name=cd_update_lyrics_msg,json=cdUpdateLyricsMsg,proto3,oneof"`
+}
+type Tx_CdPauseCountdownMsg struct {
+	CdPauseCountdownMsg *countdown.PauseCountdownMsg `protobuf:"bytes,106,opt,name=cd_pause_countdown_msg,json=cdPauseCountdownMsg,proto3,oneof"`
+}
+type Tx_CdResumeCountdownMsg struct {
+	CdResumeCountdownMsg *countdown.ResumeCountdownMsg `protobuf:"bytes,107,opt,name=cd_resume_countdown_msg,json=cdResumeCountdownMsg,proto3,oneof"`
+}
+type Tx_CdTransferCountdownMsg struct {
+	CdTransferCountdownMsg *countdown.TransferCountdownMsg `protobuf:"bytes,108,opt,name=cd_transfer_countdown_msg,json=cdTransferCountdownMsg,proto3,oneof"`
+}
+type Tx_CdAcceptCountdownTransferMsg struct {
+	CdAcceptCountdownTransferMsg *countdown.AcceptCountdownTransferMsg `protobuf:"bytes,109,opt,name=cd_accept_countdown_transfer_msg,json=cdAcceptCountdownTransferMsg,proto3,oneof"`
+}
+type Tx_CdTipCountdownMsg struct {
+	CdTipCountdownMsg *countdown.TipCountdownMsg `protobuf:"bytes,110,opt,name=cd_tip_countdown_msg,json=cdTipCountdownMsg,proto3,oneof"`
+}
+type Tx_CdSetUnlockPriceMsg struct {
+	CdSetUnlockPriceMsg *countdown.SetUnlockPriceMsg `protobuf:"bytes,111,opt,name=cd_set_unlock_price_msg,json=cdSetUnlockPriceMsg,proto3,oneof"`
+}
+type Tx_CdUnlockNextLineMsg struct {
+	CdUnlockNextLineMsg *countdown.UnlockNextLineMsg `protobuf:"bytes,112,opt,name=cd_unlock_next_line_msg,json=cdUnlockNextLineMsg,proto3,oneof"`
+}
+type Tx_CdDeleteUserMsg struct {
+	CdDeleteUserMsg *countdown.DeleteUserMsg `protobuf:"bytes,113,opt,name=cd_delete_user_msg,json=cdDeleteUserMsg,proto3,oneof"`
+}
+type Tx_CdUpdateConfigurationMsg struct {
+	CdUpdateConfigurationMsg *countdown.UpdateConfigurationMsg `protobuf:"bytes,114,opt,name=cd_update_configuration_msg,json=cdUpdateConfigurationMsg,proto3,oneof"`
+}
+type Tx_CdFlagCountdownMsg struct {
+	CdFlagCountdownMsg *countdown.FlagCountdownMsg `protobuf:"bytes,115,opt,name=cd_flag_countdown_msg,json=cdFlagCountdownMsg,proto3,oneof"`
+}
+type Tx_CdModerateCountdownMsg struct {
+	CdModerateCountdownMsg *countdown.ModerateCountdownMsg `protobuf:"bytes,116,opt,name=cd_moderate_countdown_msg,json=cdModerateCountdownMsg,proto3,oneof"`
+}
+type Tx_CdCreateCountdownDraftMsg struct {
+	CdCreateCountdownDraftMsg *countdown.CreateCountdownDraftMsg `protobuf:"bytes,117,opt,name=cd_create_countdown_draft_msg,json=cdCreateCountdownDraftMsg,proto3,oneof"`
+}
+type Tx_CdAppendLyricsChunkMsg struct {
+	CdAppendLyricsChunkMsg *countdown.AppendLyricsChunkMsg `protobuf:"bytes,118,opt,name=cd_append_lyrics_chunk_msg,json=cdAppendLyricsChunkMsg,proto3,oneof"`
+}
+type Tx_CdPublishCountdownMsg struct {
+	CdPublishCountdownMsg *countdown.PublishCountdownMsg `protobuf:"bytes,119,opt,name=cd_publish_countdown_msg,json=cdPublishCountdownMsg,proto3,oneof"`
+}
+type Tx_CdMigrateCountdownsMsg struct {
+	CdMigrateCountdownsMsg *countdown.MigrateCountdownsMsg `protobuf:"bytes,122,opt,name=cd_migrate_countdowns_msg,json=cdMigrateCountdownsMsg,proto3,oneof"`
+}
+type Tx_CdCreateSeriesMsg struct {
+	CdCreateSeriesMsg *countdown.CreateSeriesMsg `protobuf:"bytes,123,opt,name=cd_create_series_msg,json=cdCreateSeriesMsg,proto3,oneof"`
+}
+type Tx_CdAddSeriesCountdownMsg struct {
+	CdAddSeriesCountdownMsg *countdown.AddSeriesCountdownMsg `protobuf:"bytes,124,opt,name=cd_add_series_countdown_msg,json=cdAddSeriesCountdownMsg,proto3,oneof"`
+}
+type Tx_CdRemoveSeriesCountdownMsg struct {
+	CdRemoveSeriesCountdownMsg *countdown.RemoveSeriesCountdownMsg `protobuf:"bytes,125,opt,name=cd_remove_series_countdown_msg,json=cdRemoveSeriesCountdownMsg,proto3,oneof"`
+}
+type Tx_CdReorderSeriesMsg struct {
+	CdReorderSeriesMsg *countdown.ReorderSeriesMsg `protobuf:"bytes,126,opt,name=cd_reorder_series_msg,json=cdReorderSeriesMsg,proto3,oneof"`
+}
+type Tx_CdSetSuccessorMsg struct {
+	CdSetSuccessorMsg *countdown.SetSuccessorMsg `protobuf:"bytes,127,opt,name=cd_set_successor_msg,json=cdSetSuccessorMsg,proto3,oneof"`
+}
+type Tx_CdRestartCountdownMsg struct {
+	CdRestartCountdownMsg *countdown.RestartCountdownMsg `protobuf:"bytes,128,opt,name=cd_restart_countdown_msg,json=cdRestartCountdownMsg,proto3,oneof"`
+}
+type Tx_CdForkCountdownMsg struct {
+	CdForkCountdownMsg *countdown.ForkCountdownMsg `protobuf:"bytes,129,opt,name=cd_fork_countdown_msg,json=cdForkCountdownMsg,proto3,oneof"`
+}
+type Tx_CdDeleteCountdownDraftMsg struct {
+	CdDeleteCountdownDraftMsg *countdown.DeleteCountdownDraftMsg `protobuf:"bytes,130,opt,name=cd_delete_countdown_draft_msg,json=cdDeleteCountdownDraftMsg,proto3,oneof"`
+}
 
-func (*Tx_CashSendMsg) isTx_Sum()               {}
-func (*Tx_MultisigCreateMsg) isTx_Sum()         {}
-func (*Tx_MultisigUpdateMsg) isTx_Sum()         {}
-func (*Tx_ValidatorsApplyDiffMsg) isTx_Sum()    {}
-func (*Tx_ExecuteBatchMsg) isTx_Sum()           {}
-func (*Tx_MigrationUpgradeSchemaMsg) isTx_Sum() {}
-func (*Tx_CdCreateUserMsg) isTx_Sum()           {}
-func (*Tx_CdCreateCountdownMsg) isTx_Sum()      {}
-func (*Tx_CdDeleteCountdownMsg) isTx_Sum()      {}
+func (*Tx_CashSendMsg) isTx_Sum()                  {}
+func (*Tx_MultisigCreateMsg) isTx_Sum()            {}
+func (*Tx_MultisigUpdateMsg) isTx_Sum()            {}
+func (*Tx_ValidatorsApplyDiffMsg) isTx_Sum()       {}
+func (*Tx_ExecuteBatchMsg) isTx_Sum()              {}
+func (*Tx_MigrationUpgradeSchemaMsg) isTx_Sum()    {}
+func (*Tx_GovCreateProposalMsg) isTx_Sum()         {}
+func (*Tx_GovDeleteProposalMsg) isTx_Sum()         {}
+func (*Tx_GovVoteMsg) isTx_Sum()                   {}
+func (*Tx_GovUpdateElectorateMsg) isTx_Sum()       {}
+func (*Tx_GovUpdateElectionRuleMsg) isTx_Sum()     {}
+func (*Tx_CdCreateUserMsg) isTx_Sum()              {}
+func (*Tx_CdCreateCountdownMsg) isTx_Sum()         {}
+func (*Tx_CdDeleteCountdownMsg) isTx_Sum()         {}
+func (*Tx_CdGrantRoleMsg) isTx_Sum()               {}
+func (*Tx_CdRevokeRoleMsg) isTx_Sum()              {}
+func (*Tx_CdUpdateLyricsMsg) isTx_Sum()            {}
+func (*Tx_CdPauseCountdownMsg) isTx_Sum()          {}
+func (*Tx_CdResumeCountdownMsg) isTx_Sum()         {}
+func (*Tx_CdTransferCountdownMsg) isTx_Sum()       {}
+func (*Tx_CdAcceptCountdownTransferMsg) isTx_Sum() {}
+func (*Tx_CdTipCountdownMsg) isTx_Sum()            {}
+func (*Tx_CdSetUnlockPriceMsg) isTx_Sum()          {}
+func (*Tx_CdUnlockNextLineMsg) isTx_Sum()          {}
+func (*Tx_CdDeleteUserMsg) isTx_Sum()              {}
+func (*Tx_CdUpdateConfigurationMsg) isTx_Sum()     {}
+func (*Tx_CdFlagCountdownMsg) isTx_Sum()           {}
+func (*Tx_CdModerateCountdownMsg) isTx_Sum()       {}
+func (*Tx_CdCreateCountdownDraftMsg) isTx_Sum()    {}
+func (*Tx_CdAppendLyricsChunkMsg) isTx_Sum()       {}
+func (*Tx_CdPublishCountdownMsg) isTx_Sum()        {}
+func (*Tx_CdMigrateCountdownsMsg) isTx_Sum()       {}
+func (*Tx_CdCreateSeriesMsg) isTx_Sum()            {}
+func (*Tx_CdAddSeriesCountdownMsg) isTx_Sum()      {}
+func (*Tx_CdRemoveSeriesCountdownMsg) isTx_Sum()   {}
+func (*Tx_CdReorderSeriesMsg) isTx_Sum()           {}
+func (*Tx_CdSetSuccessorMsg) isTx_Sum()            {}
+func (*Tx_CdRestartCountdownMsg) isTx_Sum()        {}
+func (*Tx_CdForkCountdownMsg) isTx_Sum()           {}
+func (*Tx_CdDeleteCountdownDraftMsg) isTx_Sum()    {}
 
 func (m *Tx) GetSum() isTx_Sum {
 	if m != nil {
@@ -209,6 +365,41 @@ func (m *Tx) GetMigrationUpgradeSchemaMsg() *migration.UpgradeSchemaMsg {
 	return nil
 }
 
+func (m *Tx) GetGovCreateProposalMsg() *gov.CreateProposalMsg {
+	if x, ok := m.GetSum().(*Tx_GovCreateProposalMsg); ok {
+		return x.GovCreateProposalMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetGovDeleteProposalMsg() *gov.DeleteProposalMsg {
+	if x, ok := m.GetSum().(*Tx_GovDeleteProposalMsg); ok {
+		return x.GovDeleteProposalMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetGovVoteMsg() *gov.VoteMsg {
+	if x, ok := m.GetSum().(*Tx_GovVoteMsg); ok {
+		return x.GovVoteMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetGovUpdateElectorateMsg() *gov.UpdateElectorateMsg {
+	if x, ok := m.GetSum().(*Tx_GovUpdateElectorateMsg); ok {
+		return x.GovUpdateElectorateMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetGovUpdateElectionRuleMsg() *gov.UpdateElectionRuleMsg {
+	if x, ok := m.GetSum().(*Tx_GovUpdateElectionRuleMsg); ok {
+		return x.GovUpdateElectionRuleMsg
+	}
+	return nil
+}
+
 func (m *Tx) GetCdCreateUserMsg() *countdown.CreateUserMsg {
 	if x, ok := m.GetSum().(*Tx_CdCreateUserMsg); ok {
 		return x.CdCreateUserMsg
@@ -230,6 +421,188 @@ func (m *Tx) GetCdDeleteCountdownMsg() *countdown.DeleteCountdownMsg {
 	return nil
 }
 
+func (m *Tx) GetCdGrantRoleMsg() *countdown.GrantRoleMsg {
+	if x, ok := m.GetSum().(*Tx_CdGrantRoleMsg); ok {
+		return x.CdGrantRoleMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCdRevokeRoleMsg() *countdown.RevokeRoleMsg {
+	if x, ok := m.GetSum().(*Tx_CdRevokeRoleMsg); ok {
+		return x.CdRevokeRoleMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCdUpdateLyricsMsg() *countdown.UpdateLyricsMsg {
+	if x, ok := m.GetSum().(*Tx_CdUpdateLyricsMsg); ok {
+		return x.CdUpdateLyricsMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCdPauseCountdownMsg() *countdown.PauseCountdownMsg {
+	if x, ok := m.GetSum().(*Tx_CdPauseCountdownMsg); ok {
+		return x.CdPauseCountdownMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCdResumeCountdownMsg() *countdown.ResumeCountdownMsg {
+	if x, ok := m.GetSum().(*Tx_CdResumeCountdownMsg); ok {
+		return x.CdResumeCountdownMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCdTransferCountdownMsg() *countdown.TransferCountdownMsg {
+	if x, ok := m.GetSum().(*Tx_CdTransferCountdownMsg); ok {
+		return x.CdTransferCountdownMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCdAcceptCountdownTransferMsg() *countdown.AcceptCountdownTransferMsg {
+	if x, ok := m.GetSum().(*Tx_CdAcceptCountdownTransferMsg); ok {
+		return x.CdAcceptCountdownTransferMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCdTipCountdownMsg() *countdown.TipCountdownMsg {
+	if x, ok := m.GetSum().(*Tx_CdTipCountdownMsg); ok {
+		return x.CdTipCountdownMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCdSetUnlockPriceMsg() *countdown.SetUnlockPriceMsg {
+	if x, ok := m.GetSum().(*Tx_CdSetUnlockPriceMsg); ok {
+		return x.CdSetUnlockPriceMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCdUnlockNextLineMsg() *countdown.UnlockNextLineMsg {
+	if x, ok := m.GetSum().(*Tx_CdUnlockNextLineMsg); ok {
+		return x.CdUnlockNextLineMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCdDeleteUserMsg() *countdown.DeleteUserMsg {
+	if x, ok := m.GetSum().(*Tx_CdDeleteUserMsg); ok {
+		return x.CdDeleteUserMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCdUpdateConfigurationMsg() *countdown.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*Tx_CdUpdateConfigurationMsg); ok {
+		return x.CdUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCdFlagCountdownMsg() *countdown.FlagCountdownMsg {
+	if x, ok := m.GetSum().(*Tx_CdFlagCountdownMsg); ok {
+		return x.CdFlagCountdownMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCdModerateCountdownMsg() *countdown.ModerateCountdownMsg {
+	if x, ok := m.GetSum().(*Tx_CdModerateCountdownMsg); ok {
+		return x.CdModerateCountdownMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCdCreateCountdownDraftMsg() *countdown.CreateCountdownDraftMsg {
+	if x, ok := m.GetSum().(*Tx_CdCreateCountdownDraftMsg); ok {
+		return x.CdCreateCountdownDraftMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCdAppendLyricsChunkMsg() *countdown.AppendLyricsChunkMsg {
+	if x, ok := m.GetSum().(*Tx_CdAppendLyricsChunkMsg); ok {
+		return x.CdAppendLyricsChunkMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCdPublishCountdownMsg() *countdown.PublishCountdownMsg {
+	if x, ok := m.GetSum().(*Tx_CdPublishCountdownMsg); ok {
+		return x.CdPublishCountdownMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCdMigrateCountdownsMsg() *countdown.MigrateCountdownsMsg {
+	if x, ok := m.GetSum().(*Tx_CdMigrateCountdownsMsg); ok {
+		return x.CdMigrateCountdownsMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCdCreateSeriesMsg() *countdown.CreateSeriesMsg {
+	if x, ok := m.GetSum().(*Tx_CdCreateSeriesMsg); ok {
+		return x.CdCreateSeriesMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCdAddSeriesCountdownMsg() *countdown.AddSeriesCountdownMsg {
+	if x, ok := m.GetSum().(*Tx_CdAddSeriesCountdownMsg); ok {
+		return x.CdAddSeriesCountdownMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCdRemoveSeriesCountdownMsg() *countdown.RemoveSeriesCountdownMsg {
+	if x, ok := m.GetSum().(*Tx_CdRemoveSeriesCountdownMsg); ok {
+		return x.CdRemoveSeriesCountdownMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCdReorderSeriesMsg() *countdown.ReorderSeriesMsg {
+	if x, ok := m.GetSum().(*Tx_CdReorderSeriesMsg); ok {
+		return x.CdReorderSeriesMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCdSetSuccessorMsg() *countdown.SetSuccessorMsg {
+	if x, ok := m.GetSum().(*Tx_CdSetSuccessorMsg); ok {
+		return x.CdSetSuccessorMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCdRestartCountdownMsg() *countdown.RestartCountdownMsg {
+	if x, ok := m.GetSum().(*Tx_CdRestartCountdownMsg); ok {
+		return x.CdRestartCountdownMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCdForkCountdownMsg() *countdown.ForkCountdownMsg {
+	if x, ok := m.GetSum().(*Tx_CdForkCountdownMsg); ok {
+		return x.CdForkCountdownMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCdDeleteCountdownDraftMsg() *countdown.DeleteCountdownDraftMsg {
+	if x, ok := m.GetSum().(*Tx_CdDeleteCountdownDraftMsg); ok {
+		return x.CdDeleteCountdownDraftMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Tx) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Tx_OneofMarshaler, _Tx_OneofUnmarshaler, _Tx_OneofSizer, []interface{}{
@@ -239,9 +612,40 @@ func (*Tx) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, fun
 		(*Tx_ValidatorsApplyDiffMsg)(nil),
 		(*Tx_ExecuteBatchMsg)(nil),
 		(*Tx_MigrationUpgradeSchemaMsg)(nil),
+		(*Tx_GovCreateProposalMsg)(nil),
+		(*Tx_GovDeleteProposalMsg)(nil),
+		(*Tx_GovVoteMsg)(nil),
+		(*Tx_GovUpdateElectorateMsg)(nil),
+		(*Tx_GovUpdateElectionRuleMsg)(nil),
 		(*Tx_CdCreateUserMsg)(nil),
 		(*Tx_CdCreateCountdownMsg)(nil),
 		(*Tx_CdDeleteCountdownMsg)(nil),
+		(*Tx_CdGrantRoleMsg)(nil),
+		(*Tx_CdRevokeRoleMsg)(nil),
+		(*Tx_CdUpdateLyricsMsg)(nil),
+		(*Tx_CdPauseCountdownMsg)(nil),
+		(*Tx_CdResumeCountdownMsg)(nil),
+		(*Tx_CdTransferCountdownMsg)(nil),
+		(*Tx_CdAcceptCountdownTransferMsg)(nil),
+		(*Tx_CdTipCountdownMsg)(nil),
+		(*Tx_CdSetUnlockPriceMsg)(nil),
+		(*Tx_CdUnlockNextLineMsg)(nil),
+		(*Tx_CdDeleteUserMsg)(nil),
+		(*Tx_CdUpdateConfigurationMsg)(nil),
+		(*Tx_CdFlagCountdownMsg)(nil),
+		(*Tx_CdModerateCountdownMsg)(nil),
+		(*Tx_CdCreateCountdownDraftMsg)(nil),
+		(*Tx_CdAppendLyricsChunkMsg)(nil),
+		(*Tx_CdPublishCountdownMsg)(nil),
+		(*Tx_CdMigrateCountdownsMsg)(nil),
+		(*Tx_CdCreateSeriesMsg)(nil),
+		(*Tx_CdAddSeriesCountdownMsg)(nil),
+		(*Tx_CdRemoveSeriesCountdownMsg)(nil),
+		(*Tx_CdReorderSeriesMsg)(nil),
+		(*Tx_CdSetSuccessorMsg)(nil),
+		(*Tx_CdRestartCountdownMsg)(nil),
+		(*Tx_CdForkCountdownMsg)(nil),
+		(*Tx_CdDeleteCountdownDraftMsg)(nil),
 	}
 }
 
@@ -279,6 +683,31 @@ func _Tx_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.MigrationUpgradeSchemaMsg); err != nil {
 			return err
 		}
+	case *Tx_GovCreateProposalMsg:
+		_ = b.EncodeVarint(73<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovCreateProposalMsg); err != nil {
+			return err
+		}
+	case *Tx_GovDeleteProposalMsg:
+		_ = b.EncodeVarint(74<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovDeleteProposalMsg); err != nil {
+			return err
+		}
+	case *Tx_GovVoteMsg:
+		_ = b.EncodeVarint(75<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovVoteMsg); err != nil {
+			return err
+		}
+	case *Tx_GovUpdateElectorateMsg:
+		_ = b.EncodeVarint(77<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovUpdateElectorateMsg); err != nil {
+			return err
+		}
+	case *Tx_GovUpdateElectionRuleMsg:
+		_ = b.EncodeVarint(78<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovUpdateElectionRuleMsg); err != nil {
+			return err
+		}
 	case *Tx_CdCreateUserMsg:
 		_ = b.EncodeVarint(100<<3 | proto.WireBytes)
 		if err := b.EncodeMessage(x.CdCreateUserMsg); err != nil {
@@ -294,6 +723,136 @@ func _Tx_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.CdDeleteCountdownMsg); err != nil {
 			return err
 		}
+	case *Tx_CdGrantRoleMsg:
+		_ = b.EncodeVarint(103<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdGrantRoleMsg); err != nil {
+			return err
+		}
+	case *Tx_CdRevokeRoleMsg:
+		_ = b.EncodeVarint(104<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdRevokeRoleMsg); err != nil {
+			return err
+		}
+	case *Tx_CdUpdateLyricsMsg:
+		_ = b.EncodeVarint(105<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdUpdateLyricsMsg); err != nil {
+			return err
+		}
+	case *Tx_CdPauseCountdownMsg:
+		_ = b.EncodeVarint(106<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdPauseCountdownMsg); err != nil {
+			return err
+		}
+	case *Tx_CdResumeCountdownMsg:
+		_ = b.EncodeVarint(107<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdResumeCountdownMsg); err != nil {
+			return err
+		}
+	case *Tx_CdTransferCountdownMsg:
+		_ = b.EncodeVarint(108<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdTransferCountdownMsg); err != nil {
+			return err
+		}
+	case *Tx_CdAcceptCountdownTransferMsg:
+		_ = b.EncodeVarint(109<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdAcceptCountdownTransferMsg); err != nil {
+			return err
+		}
+	case *Tx_CdTipCountdownMsg:
+		_ = b.EncodeVarint(110<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdTipCountdownMsg); err != nil {
+			return err
+		}
+	case *Tx_CdSetUnlockPriceMsg:
+		_ = b.EncodeVarint(111<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdSetUnlockPriceMsg); err != nil {
+			return err
+		}
+	case *Tx_CdUnlockNextLineMsg:
+		_ = b.EncodeVarint(112<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdUnlockNextLineMsg); err != nil {
+			return err
+		}
+	case *Tx_CdDeleteUserMsg:
+		_ = b.EncodeVarint(113<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdDeleteUserMsg); err != nil {
+			return err
+		}
+	case *Tx_CdUpdateConfigurationMsg:
+		_ = b.EncodeVarint(114<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *Tx_CdFlagCountdownMsg:
+		_ = b.EncodeVarint(115<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdFlagCountdownMsg); err != nil {
+			return err
+		}
+	case *Tx_CdModerateCountdownMsg:
+		_ = b.EncodeVarint(116<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdModerateCountdownMsg); err != nil {
+			return err
+		}
+	case *Tx_CdCreateCountdownDraftMsg:
+		_ = b.EncodeVarint(117<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdCreateCountdownDraftMsg); err != nil {
+			return err
+		}
+	case *Tx_CdAppendLyricsChunkMsg:
+		_ = b.EncodeVarint(118<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdAppendLyricsChunkMsg); err != nil {
+			return err
+		}
+	case *Tx_CdPublishCountdownMsg:
+		_ = b.EncodeVarint(119<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdPublishCountdownMsg); err != nil {
+			return err
+		}
+	case *Tx_CdMigrateCountdownsMsg:
+		_ = b.EncodeVarint(122<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdMigrateCountdownsMsg); err != nil {
+			return err
+		}
+	case *Tx_CdCreateSeriesMsg:
+		_ = b.EncodeVarint(123<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdCreateSeriesMsg); err != nil {
+			return err
+		}
+	case *Tx_CdAddSeriesCountdownMsg:
+		_ = b.EncodeVarint(124<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdAddSeriesCountdownMsg); err != nil {
+			return err
+		}
+	case *Tx_CdRemoveSeriesCountdownMsg:
+		_ = b.EncodeVarint(125<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdRemoveSeriesCountdownMsg); err != nil {
+			return err
+		}
+	case *Tx_CdReorderSeriesMsg:
+		_ = b.EncodeVarint(126<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdReorderSeriesMsg); err != nil {
+			return err
+		}
+	case *Tx_CdSetSuccessorMsg:
+		_ = b.EncodeVarint(127<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdSetSuccessorMsg); err != nil {
+			return err
+		}
+	case *Tx_CdRestartCountdownMsg:
+		_ = b.EncodeVarint(128<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdRestartCountdownMsg); err != nil {
+			return err
+		}
+	case *Tx_CdForkCountdownMsg:
+		_ = b.EncodeVarint(129<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdForkCountdownMsg); err != nil {
+			return err
+		}
+	case *Tx_CdDeleteCountdownDraftMsg:
+		_ = b.EncodeVarint(130<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdDeleteCountdownDraftMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Tx.Sum has unexpected type %T", x)
@@ -352,6 +911,46 @@ func _Tx_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bo
 		err := b.DecodeMessage(msg)
 		m.Sum = &Tx_MigrationUpgradeSchemaMsg{msg}
 		return true, err
+	case 73: // sum.gov_create_proposal_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.CreateProposalMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_GovCreateProposalMsg{msg}
+		return true, err
+	case 74: // sum.gov_delete_proposal_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.DeleteProposalMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_GovDeleteProposalMsg{msg}
+		return true, err
+	case 75: // sum.gov_vote_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.VoteMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_GovVoteMsg{msg}
+		return true, err
+	case 77: // sum.gov_update_electorate_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.UpdateElectorateMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_GovUpdateElectorateMsg{msg}
+		return true, err
+	case 78: // sum.gov_update_election_rule_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.UpdateElectionRuleMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_GovUpdateElectionRuleMsg{msg}
+		return true, err
 	case 100: // sum.cd_create_user_msg
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
@@ -376,18 +975,226 @@ func _Tx_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bo
 		err := b.DecodeMessage(msg)
 		m.Sum = &Tx_CdDeleteCountdownMsg{msg}
 		return true, err
-	default:
-		return false, nil
-	}
-}
-
-func _Tx_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*Tx)
-	// sum
-	switch x := m.Sum.(type) {
-	case *Tx_CashSendMsg:
-		s := proto.Size(x.CashSendMsg)
-		n += 2 // tag and wire
+	case 103: // sum.cd_grant_role_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.GrantRoleMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CdGrantRoleMsg{msg}
+		return true, err
+	case 104: // sum.cd_revoke_role_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.RevokeRoleMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CdRevokeRoleMsg{msg}
+		return true, err
+	case 105: // sum.cd_update_lyrics_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.UpdateLyricsMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CdUpdateLyricsMsg{msg}
+		return true, err
+	case 106: // sum.cd_pause_countdown_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.PauseCountdownMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CdPauseCountdownMsg{msg}
+		return true, err
+	case 107: // sum.cd_resume_countdown_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.ResumeCountdownMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CdResumeCountdownMsg{msg}
+		return true, err
+	case 108: // sum.cd_transfer_countdown_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.TransferCountdownMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CdTransferCountdownMsg{msg}
+		return true, err
+	case 109: // sum.cd_accept_countdown_transfer_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.AcceptCountdownTransferMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CdAcceptCountdownTransferMsg{msg}
+		return true, err
+	case 110: // sum.cd_tip_countdown_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.TipCountdownMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CdTipCountdownMsg{msg}
+		return true, err
+	case 111: // sum.cd_set_unlock_price_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.SetUnlockPriceMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CdSetUnlockPriceMsg{msg}
+		return true, err
+	case 112: // sum.cd_unlock_next_line_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.UnlockNextLineMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CdUnlockNextLineMsg{msg}
+		return true, err
+	case 113: // sum.cd_delete_user_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.DeleteUserMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CdDeleteUserMsg{msg}
+		return true, err
+	case 114: // sum.cd_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CdUpdateConfigurationMsg{msg}
+		return true, err
+	case 115: // sum.cd_flag_countdown_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.FlagCountdownMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CdFlagCountdownMsg{msg}
+		return true, err
+	case 116: // sum.cd_moderate_countdown_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.ModerateCountdownMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CdModerateCountdownMsg{msg}
+		return true, err
+	case 117: // sum.cd_create_countdown_draft_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.CreateCountdownDraftMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CdCreateCountdownDraftMsg{msg}
+		return true, err
+	case 118: // sum.cd_append_lyrics_chunk_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.AppendLyricsChunkMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CdAppendLyricsChunkMsg{msg}
+		return true, err
+	case 119: // sum.cd_publish_countdown_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.PublishCountdownMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CdPublishCountdownMsg{msg}
+		return true, err
+	case 122: // sum.cd_migrate_countdowns_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.MigrateCountdownsMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CdMigrateCountdownsMsg{msg}
+		return true, err
+	case 123: // sum.cd_create_series_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.CreateSeriesMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CdCreateSeriesMsg{msg}
+		return true, err
+	case 124: // sum.cd_add_series_countdown_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.AddSeriesCountdownMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CdAddSeriesCountdownMsg{msg}
+		return true, err
+	case 125: // sum.cd_remove_series_countdown_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.RemoveSeriesCountdownMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CdRemoveSeriesCountdownMsg{msg}
+		return true, err
+	case 126: // sum.cd_reorder_series_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.ReorderSeriesMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CdReorderSeriesMsg{msg}
+		return true, err
+	case 127: // sum.cd_set_successor_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.SetSuccessorMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CdSetSuccessorMsg{msg}
+		return true, err
+	case 128: // sum.cd_restart_countdown_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.RestartCountdownMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CdRestartCountdownMsg{msg}
+		return true, err
+	case 129: // sum.cd_fork_countdown_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.ForkCountdownMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CdForkCountdownMsg{msg}
+		return true, err
+	case 130: // sum.cd_delete_countdown_draft_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.DeleteCountdownDraftMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CdDeleteCountdownDraftMsg{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Tx_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Tx)
+	// sum
+	switch x := m.Sum.(type) {
+	case *Tx_CashSendMsg:
+		s := proto.Size(x.CashSendMsg)
+		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
 	case *Tx_MultisigCreateMsg:
@@ -415,6 +1222,31 @@ func _Tx_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Tx_GovCreateProposalMsg:
+		s := proto.Size(x.GovCreateProposalMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_GovDeleteProposalMsg:
+		s := proto.Size(x.GovDeleteProposalMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_GovVoteMsg:
+		s := proto.Size(x.GovVoteMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_GovUpdateElectorateMsg:
+		s := proto.Size(x.GovUpdateElectorateMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_GovUpdateElectionRuleMsg:
+		s := proto.Size(x.GovUpdateElectionRuleMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case *Tx_CdCreateUserMsg:
 		s := proto.Size(x.CdCreateUserMsg)
 		n += 2 // tag and wire
@@ -430,6 +1262,136 @@ func _Tx_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Tx_CdGrantRoleMsg:
+		s := proto.Size(x.CdGrantRoleMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CdRevokeRoleMsg:
+		s := proto.Size(x.CdRevokeRoleMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CdUpdateLyricsMsg:
+		s := proto.Size(x.CdUpdateLyricsMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CdPauseCountdownMsg:
+		s := proto.Size(x.CdPauseCountdownMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CdResumeCountdownMsg:
+		s := proto.Size(x.CdResumeCountdownMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CdTransferCountdownMsg:
+		s := proto.Size(x.CdTransferCountdownMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CdAcceptCountdownTransferMsg:
+		s := proto.Size(x.CdAcceptCountdownTransferMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CdTipCountdownMsg:
+		s := proto.Size(x.CdTipCountdownMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CdSetUnlockPriceMsg:
+		s := proto.Size(x.CdSetUnlockPriceMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CdUnlockNextLineMsg:
+		s := proto.Size(x.CdUnlockNextLineMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CdDeleteUserMsg:
+		s := proto.Size(x.CdDeleteUserMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CdUpdateConfigurationMsg:
+		s := proto.Size(x.CdUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CdFlagCountdownMsg:
+		s := proto.Size(x.CdFlagCountdownMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CdModerateCountdownMsg:
+		s := proto.Size(x.CdModerateCountdownMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CdCreateCountdownDraftMsg:
+		s := proto.Size(x.CdCreateCountdownDraftMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CdAppendLyricsChunkMsg:
+		s := proto.Size(x.CdAppendLyricsChunkMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CdPublishCountdownMsg:
+		s := proto.Size(x.CdPublishCountdownMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CdMigrateCountdownsMsg:
+		s := proto.Size(x.CdMigrateCountdownsMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CdCreateSeriesMsg:
+		s := proto.Size(x.CdCreateSeriesMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CdAddSeriesCountdownMsg:
+		s := proto.Size(x.CdAddSeriesCountdownMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CdRemoveSeriesCountdownMsg:
+		s := proto.Size(x.CdRemoveSeriesCountdownMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CdReorderSeriesMsg:
+		s := proto.Size(x.CdReorderSeriesMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CdSetSuccessorMsg:
+		s := proto.Size(x.CdSetSuccessorMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CdRestartCountdownMsg:
+		s := proto.Size(x.CdRestartCountdownMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CdForkCountdownMsg:
+		s := proto.Size(x.CdForkCountdownMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CdDeleteCountdownDraftMsg:
+		s := proto.Size(x.CdDeleteCountdownDraftMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -666,39 +1628,35 @@ func _ExecuteBatchMsg_Union_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
-// CronTask is a format used by the CronMarshaler to marshal and unmarshal cron
-// task.
-//
-// When there is a gap in message sequence numbers - that most likely means some
-// old fields got deprecated. This is done to maintain binary compatibility.
-type CronTask struct {
-	// Authenticators contains a list of conditions that authenticate execution
-	// of this task.
-	// This is one of the main differences between the CronTask and Tx entities.
-	// CronTask is created interanlly and does not have to be signed. Because we
-	// use the same handlers as for the Tx to process a cron task, we must
-	// provide authentication method. This attribute contains all authentication
-	// conditions required for execution, that will be inserted into the context.
-	Authenticators []github_com_iov_one_weave.Condition `protobuf:"bytes,1,rep,name=authenticators,proto3,casttype=github.com/iov-one/weave.Condition" json:"authenticators,omitempty"`
-	// Use the same indexes for the messages as the Tx message.
-	//
-	// Types that are valid to be assigned to Sum:
-	//	*CronTask_CdAddLyricsMsg
-	Sum isCronTask_Sum `protobuf_oneof:"sum"`
+// ProposalOptions are possible items that can be enacted by a governance vote
+type ProposalOptions struct {
+	// Types that are valid to be assigned to Option:
+	//	*ProposalOptions_CashSendMsg
+	//	*ProposalOptions_MultisigUpdateMsg
+	//	*ProposalOptions_ValidatorsApplyDiffMsg
+	//	*ProposalOptions_ExecuteProposalBatchMsg
+	//	*ProposalOptions_MigrationUpgradeSchemaMsg
+	//	*ProposalOptions_GovUpdateElectorateMsg
+	//	*ProposalOptions_GovUpdateElectionRuleMsg
+	//	*ProposalOptions_GovCreateTextResolutionMsg
+	//	*ProposalOptions_CdUpdateConfigurationMsg
+	//	*ProposalOptions_CdModerateCountdownMsg
+	//	*ProposalOptions_CdMigrateCountdownsMsg
+	Option isProposalOptions_Option `protobuf_oneof:"option"`
 }
 
-func (m *CronTask) Reset()         { *m = CronTask{} }
-func (m *CronTask) String() string { return proto.CompactTextString(m) }
-func (*CronTask) ProtoMessage()    {}
-func (*CronTask) Descriptor() ([]byte, []int) {
+func (m *ProposalOptions) Reset()         { *m = ProposalOptions{} }
+func (m *ProposalOptions) String() string { return proto.CompactTextString(m) }
+func (*ProposalOptions) ProtoMessage()    {}
+func (*ProposalOptions) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c873c2eb2d33dfe8, []int{2}
 }
-func (m *CronTask) XXX_Unmarshal(b []byte) error {
+func (m *ProposalOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CronTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ProposalOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CronTask.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ProposalOptions.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -708,96 +1666,389 @@ func (m *CronTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *CronTask) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CronTask.Merge(m, src)
+func (m *ProposalOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalOptions.Merge(m, src)
 }
-func (m *CronTask) XXX_Size() int {
+func (m *ProposalOptions) XXX_Size() int {
 	return m.Size()
 }
-func (m *CronTask) XXX_DiscardUnknown() {
-	xxx_messageInfo_CronTask.DiscardUnknown(m)
+func (m *ProposalOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalOptions.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CronTask proto.InternalMessageInfo
+var xxx_messageInfo_ProposalOptions proto.InternalMessageInfo
 
-type isCronTask_Sum interface {
-	isCronTask_Sum()
+type isProposalOptions_Option interface {
+	isProposalOptions_Option()
 	MarshalTo([]byte) (int, error)
 	Size() int
 }
 
-type CronTask_CdAddLyricsMsg struct {
-	CdAddLyricsMsg *countdown.CountdownTask `protobuf:"bytes,120,opt,name=cd_add_lyrics_msg,json=cdAddLyricsMsg,proto3,oneof"`
+type ProposalOptions_CashSendMsg struct {
+	CashSendMsg *cash.SendMsg `protobuf:"bytes,51,opt,name=cash_send_msg,json=cashSendMsg,proto3,oneof"`
+}
+type ProposalOptions_MultisigUpdateMsg struct {
+	MultisigUpdateMsg *multisig.UpdateMsg `protobuf:"bytes,57,opt,name=multisig_update_msg,json=multisigUpdateMsg,proto3,oneof"`
+}
+type ProposalOptions_ValidatorsApplyDiffMsg struct {
+	ValidatorsApplyDiffMsg *validators.ApplyDiffMsg `protobuf:"bytes,58,opt,name=validators_apply_diff_msg,json=validatorsApplyDiffMsg,proto3,oneof"`
+}
+type ProposalOptions_ExecuteProposalBatchMsg struct {
+	ExecuteProposalBatchMsg *ExecuteProposalBatchMsg `protobuf:"bytes,60,opt,name=execute_proposal_batch_msg,json=executeProposalBatchMsg,proto3,oneof"`
+}
+type ProposalOptions_MigrationUpgradeSchemaMsg struct {
+	MigrationUpgradeSchemaMsg *migration.UpgradeSchemaMsg `protobuf:"bytes,69,opt,name=migration_upgrade_schema_msg,json=migrationUpgradeSchemaMsg,proto3,oneof"`
+}
+type ProposalOptions_GovUpdateElectorateMsg struct {
+	GovUpdateElectorateMsg *gov.UpdateElectorateMsg `protobuf:"bytes,77,opt,name=gov_update_electorate_msg,json=govUpdateElectorateMsg,proto3,oneof"`
+}
+type ProposalOptions_GovUpdateElectionRuleMsg struct {
+	GovUpdateElectionRuleMsg *gov.UpdateElectionRuleMsg `protobuf:"bytes,78,opt,name=gov_update_election_rule_msg,json=govUpdateElectionRuleMsg,proto3,oneof"`
+}
+type ProposalOptions_GovCreateTextResolutionMsg struct {
+	GovCreateTextResolutionMsg *gov.CreateTextResolutionMsg `protobuf:"bytes,79,opt,name=gov_create_text_resolution_msg,json=govCreateTextResolutionMsg,proto3,oneof"`
+}
+type ProposalOptions_CdUpdateConfigurationMsg struct {
+	CdUpdateConfigurationMsg *countdown.UpdateConfigurationMsg `protobuf:"bytes,114,opt,name=cd_update_configuration_msg,json=cdUpdateConfigurationMsg,proto3,oneof"`
+}
+type ProposalOptions_CdModerateCountdownMsg struct {
+	CdModerateCountdownMsg *countdown.ModerateCountdownMsg `protobuf:"bytes,116,opt,name=cd_moderate_countdown_msg,json=cdModerateCountdownMsg,proto3,oneof"`
+}
+type ProposalOptions_CdMigrateCountdownsMsg struct {
+	CdMigrateCountdownsMsg *countdown.MigrateCountdownsMsg `protobuf:"bytes,122,opt,name=cd_migrate_countdowns_msg,json=cdMigrateCountdownsMsg,proto3,oneof"`
 }
 
-func (*CronTask_CdAddLyricsMsg) isCronTask_Sum() {}
+func (*ProposalOptions_CashSendMsg) isProposalOptions_Option()                {}
+func (*ProposalOptions_MultisigUpdateMsg) isProposalOptions_Option()          {}
+func (*ProposalOptions_ValidatorsApplyDiffMsg) isProposalOptions_Option()     {}
+func (*ProposalOptions_ExecuteProposalBatchMsg) isProposalOptions_Option()    {}
+func (*ProposalOptions_MigrationUpgradeSchemaMsg) isProposalOptions_Option()  {}
+func (*ProposalOptions_GovUpdateElectorateMsg) isProposalOptions_Option()     {}
+func (*ProposalOptions_GovUpdateElectionRuleMsg) isProposalOptions_Option()   {}
+func (*ProposalOptions_GovCreateTextResolutionMsg) isProposalOptions_Option() {}
+func (*ProposalOptions_CdUpdateConfigurationMsg) isProposalOptions_Option()   {}
+func (*ProposalOptions_CdModerateCountdownMsg) isProposalOptions_Option()     {}
+func (*ProposalOptions_CdMigrateCountdownsMsg) isProposalOptions_Option()     {}
 
-func (m *CronTask) GetSum() isCronTask_Sum {
+func (m *ProposalOptions) GetOption() isProposalOptions_Option {
 	if m != nil {
-		return m.Sum
+		return m.Option
 	}
 	return nil
 }
 
-func (m *CronTask) GetAuthenticators() []github_com_iov_one_weave.Condition {
-	if m != nil {
-		return m.Authenticators
+func (m *ProposalOptions) GetCashSendMsg() *cash.SendMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_CashSendMsg); ok {
+		return x.CashSendMsg
 	}
 	return nil
 }
 
-func (m *CronTask) GetCdAddLyricsMsg() *countdown.CountdownTask {
-	if x, ok := m.GetSum().(*CronTask_CdAddLyricsMsg); ok {
-		return x.CdAddLyricsMsg
+func (m *ProposalOptions) GetMultisigUpdateMsg() *multisig.UpdateMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_MultisigUpdateMsg); ok {
+		return x.MultisigUpdateMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetValidatorsApplyDiffMsg() *validators.ApplyDiffMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_ValidatorsApplyDiffMsg); ok {
+		return x.ValidatorsApplyDiffMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetExecuteProposalBatchMsg() *ExecuteProposalBatchMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_ExecuteProposalBatchMsg); ok {
+		return x.ExecuteProposalBatchMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetMigrationUpgradeSchemaMsg() *migration.UpgradeSchemaMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_MigrationUpgradeSchemaMsg); ok {
+		return x.MigrationUpgradeSchemaMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetGovUpdateElectorateMsg() *gov.UpdateElectorateMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_GovUpdateElectorateMsg); ok {
+		return x.GovUpdateElectorateMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetGovUpdateElectionRuleMsg() *gov.UpdateElectionRuleMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_GovUpdateElectionRuleMsg); ok {
+		return x.GovUpdateElectionRuleMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetGovCreateTextResolutionMsg() *gov.CreateTextResolutionMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_GovCreateTextResolutionMsg); ok {
+		return x.GovCreateTextResolutionMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetCdUpdateConfigurationMsg() *countdown.UpdateConfigurationMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_CdUpdateConfigurationMsg); ok {
+		return x.CdUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetCdModerateCountdownMsg() *countdown.ModerateCountdownMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_CdModerateCountdownMsg); ok {
+		return x.CdModerateCountdownMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetCdMigrateCountdownsMsg() *countdown.MigrateCountdownsMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_CdMigrateCountdownsMsg); ok {
+		return x.CdMigrateCountdownsMsg
 	}
 	return nil
 }
 
 // XXX_OneofFuncs is for the internal use of the proto package.
-func (*CronTask) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _CronTask_OneofMarshaler, _CronTask_OneofUnmarshaler, _CronTask_OneofSizer, []interface{}{
-		(*CronTask_CdAddLyricsMsg)(nil),
+func (*ProposalOptions) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _ProposalOptions_OneofMarshaler, _ProposalOptions_OneofUnmarshaler, _ProposalOptions_OneofSizer, []interface{}{
+		(*ProposalOptions_CashSendMsg)(nil),
+		(*ProposalOptions_MultisigUpdateMsg)(nil),
+		(*ProposalOptions_ValidatorsApplyDiffMsg)(nil),
+		(*ProposalOptions_ExecuteProposalBatchMsg)(nil),
+		(*ProposalOptions_MigrationUpgradeSchemaMsg)(nil),
+		(*ProposalOptions_GovUpdateElectorateMsg)(nil),
+		(*ProposalOptions_GovUpdateElectionRuleMsg)(nil),
+		(*ProposalOptions_GovCreateTextResolutionMsg)(nil),
+		(*ProposalOptions_CdUpdateConfigurationMsg)(nil),
+		(*ProposalOptions_CdModerateCountdownMsg)(nil),
+		(*ProposalOptions_CdMigrateCountdownsMsg)(nil),
 	}
 }
 
-func _CronTask_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*CronTask)
-	// sum
-	switch x := m.Sum.(type) {
-	case *CronTask_CdAddLyricsMsg:
-		_ = b.EncodeVarint(120<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.CdAddLyricsMsg); err != nil {
+func _ProposalOptions_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*ProposalOptions)
+	// option
+	switch x := m.Option.(type) {
+	case *ProposalOptions_CashSendMsg:
+		_ = b.EncodeVarint(51<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashSendMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_MultisigUpdateMsg:
+		_ = b.EncodeVarint(57<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MultisigUpdateMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_ValidatorsApplyDiffMsg:
+		_ = b.EncodeVarint(58<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ValidatorsApplyDiffMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_ExecuteProposalBatchMsg:
+		_ = b.EncodeVarint(60<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ExecuteProposalBatchMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_MigrationUpgradeSchemaMsg:
+		_ = b.EncodeVarint(69<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MigrationUpgradeSchemaMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_GovUpdateElectorateMsg:
+		_ = b.EncodeVarint(77<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovUpdateElectorateMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_GovUpdateElectionRuleMsg:
+		_ = b.EncodeVarint(78<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovUpdateElectionRuleMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_GovCreateTextResolutionMsg:
+		_ = b.EncodeVarint(79<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovCreateTextResolutionMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_CdUpdateConfigurationMsg:
+		_ = b.EncodeVarint(114<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_CdModerateCountdownMsg:
+		_ = b.EncodeVarint(116<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdModerateCountdownMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_CdMigrateCountdownsMsg:
+		_ = b.EncodeVarint(122<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdMigrateCountdownsMsg); err != nil {
 			return err
 		}
 	case nil:
 	default:
-		return fmt.Errorf("CronTask.Sum has unexpected type %T", x)
+		return fmt.Errorf("ProposalOptions.Option has unexpected type %T", x)
 	}
 	return nil
 }
 
-func _CronTask_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*CronTask)
+func _ProposalOptions_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*ProposalOptions)
 	switch tag {
-	case 120: // sum.cd_add_lyrics_msg
+	case 51: // option.cash_send_msg
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
 		}
-		msg := new(countdown.CountdownTask)
+		msg := new(cash.SendMsg)
 		err := b.DecodeMessage(msg)
-		m.Sum = &CronTask_CdAddLyricsMsg{msg}
+		m.Option = &ProposalOptions_CashSendMsg{msg}
+		return true, err
+	case 57: // option.multisig_update_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(multisig.UpdateMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_MultisigUpdateMsg{msg}
+		return true, err
+	case 58: // option.validators_apply_diff_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(validators.ApplyDiffMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_ValidatorsApplyDiffMsg{msg}
+		return true, err
+	case 60: // option.execute_proposal_batch_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ExecuteProposalBatchMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_ExecuteProposalBatchMsg{msg}
+		return true, err
+	case 69: // option.migration_upgrade_schema_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(migration.UpgradeSchemaMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_MigrationUpgradeSchemaMsg{msg}
+		return true, err
+	case 77: // option.gov_update_electorate_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.UpdateElectorateMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_GovUpdateElectorateMsg{msg}
+		return true, err
+	case 78: // option.gov_update_election_rule_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.UpdateElectionRuleMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_GovUpdateElectionRuleMsg{msg}
+		return true, err
+	case 79: // option.gov_create_text_resolution_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.CreateTextResolutionMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_GovCreateTextResolutionMsg{msg}
+		return true, err
+	case 114: // option.cd_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_CdUpdateConfigurationMsg{msg}
+		return true, err
+	case 116: // option.cd_moderate_countdown_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.ModerateCountdownMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_CdModerateCountdownMsg{msg}
+		return true, err
+	case 122: // option.cd_migrate_countdowns_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.MigrateCountdownsMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_CdMigrateCountdownsMsg{msg}
 		return true, err
 	default:
 		return false, nil
 	}
 }
 
-func _CronTask_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*CronTask)
-	// sum
-	switch x := m.Sum.(type) {
-	case *CronTask_CdAddLyricsMsg:
-		s := proto.Size(x.CdAddLyricsMsg)
+func _ProposalOptions_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*ProposalOptions)
+	// option
+	switch x := m.Option.(type) {
+	case *ProposalOptions_CashSendMsg:
+		s := proto.Size(x.CashSendMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_MultisigUpdateMsg:
+		s := proto.Size(x.MultisigUpdateMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_ValidatorsApplyDiffMsg:
+		s := proto.Size(x.ValidatorsApplyDiffMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_ExecuteProposalBatchMsg:
+		s := proto.Size(x.ExecuteProposalBatchMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_MigrationUpgradeSchemaMsg:
+		s := proto.Size(x.MigrationUpgradeSchemaMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_GovUpdateElectorateMsg:
+		s := proto.Size(x.GovUpdateElectorateMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_GovUpdateElectionRuleMsg:
+		s := proto.Size(x.GovUpdateElectionRuleMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_GovCreateTextResolutionMsg:
+		s := proto.Size(x.GovCreateTextResolutionMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_CdUpdateConfigurationMsg:
+		s := proto.Size(x.CdUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_CdModerateCountdownMsg:
+		s := proto.Size(x.CdModerateCountdownMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_CdMigrateCountdownsMsg:
+		s := proto.Size(x.CdMigrateCountdownsMsg)
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
@@ -808,665 +2059,4450 @@ func _CronTask_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
-func init() {
-	proto.RegisterType((*Tx)(nil), "countdown.Tx")
-	proto.RegisterType((*ExecuteBatchMsg)(nil), "countdown.ExecuteBatchMsg")
-	proto.RegisterType((*ExecuteBatchMsg_Union)(nil), "countdown.ExecuteBatchMsg.Union")
-	proto.RegisterType((*CronTask)(nil), "countdown.CronTask")
+// ExecuteProposalBatchMsg encapsulates multiple messages executed by a single
+// governance vote
+type ExecuteProposalBatchMsg struct {
+	Messages []ExecuteProposalBatchMsg_Union `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages"`
 }
 
-func init() { proto.RegisterFile("cmd/countdown/app/codec.proto", fileDescriptor_c873c2eb2d33dfe8) }
-
-var fileDescriptor_c873c2eb2d33dfe8 = []byte{
-	// 670 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x94, 0xcd, 0x4e, 0xdb, 0x4a,
-	0x14, 0xc7, 0x63, 0xbe, 0xc4, 0x1d, 0xbe, 0xc4, 0x80, 0x2e, 0x21, 0xf7, 0x12, 0x72, 0x59, 0x5c,
-	0x21, 0x55, 0x1d, 0xab, 0xb0, 0x69, 0xab, 0x6e, 0x48, 0x48, 0x4b, 0xa5, 0xb6, 0x0b, 0x87, 0x74,
-	0x59, 0x6b, 0x98, 0x39, 0x76, 0x46, 0x8d, 0x3d, 0x96, 0x67, 0x0c, 0xe1, 0x2d, 0xfa, 0x18, 0xed,
-	0x33, 0xf4, 0x05, 0x58, 0xd2, 0x5d, 0x57, 0xa8, 0x82, 0xb7, 0xe8, 0xaa, 0xf2, 0x38, 0x76, 0x9c,
-	0x44, 0xa0, 0xae, 0xbb, 0x9b, 0x39, 0xff, 0xff, 0xf9, 0xf9, 0xf8, 0x9c, 0x99, 0x41, 0x3b, 0x2c,
-	0xe0, 0x36, 0x93, 0x49, 0xa8, 0xb9, 0xbc, 0x08, 0x6d, 0x1a, 0x45, 0x36, 0x93, 0x1c, 0x18, 0x89,
-	0x62, 0xa9, 0x25, 0xfe, 0xab, 0x90, 0x6a, 0xc4, 0x17, 0xba, 0x97, 0x9c, 0x11, 0x26, 0x03, 0x5b,
-	0xc8, 0xf3, 0xc7, 0x32, 0x04, 0xfb, 0x02, 0xe8, 0x39, 0xd8, 0x81, 0xf0, 0x63, 0xaa, 0x85, 0x0c,
-	0xcb, 0xa9, 0xb5, 0x47, 0xf7, 0xfa, 0x07, 0x36, 0xa3, 0xaa, 0x37, 0x66, 0xb6, 0x1f, 0x30, 0x07,
-	0x49, 0x5f, 0x0b, 0x25, 0xfc, 0xdf, 0xa6, 0x2b, 0xe1, 0xab, 0x31, 0xf3, 0x93, 0x07, 0xcc, 0xe7,
-	0xb4, 0x2f, 0x38, 0xd5, 0x32, 0x1e, 0x4f, 0xd9, 0xf4, 0xa5, 0x2f, 0xcd, 0xd2, 0x4e, 0x57, 0xc3,
-	0xe8, 0xd6, 0xa0, 0xd4, 0xab, 0x92, 0x7d, 0xef, 0xeb, 0x02, 0x9a, 0x39, 0x1d, 0xe0, 0xff, 0xd0,
-	0x9c, 0x07, 0xa0, 0xaa, 0x56, 0xc3, 0xda, 0x5f, 0x3a, 0x58, 0x21, 0xe9, 0x7f, 0x92, 0x97, 0x00,
-	0xaf, 0x43, 0x4f, 0x3a, 0x46, 0xc2, 0x07, 0x08, 0x29, 0xe1, 0x87, 0x54, 0x27, 0x31, 0xa8, 0xea,
-	0x4c, 0x63, 0x76, 0x7f, 0xe9, 0x00, 0x93, 0xb4, 0x64, 0xd2, 0xd1, 0xbc, 0x93, 0x4b, 0x4e, 0xc9,
-	0x85, 0x6b, 0x68, 0x31, 0x6f, 0x42, 0x75, 0xae, 0x31, 0xbb, 0xbf, 0xec, 0x14, 0x7b, 0x7c, 0x88,
-	0x56, 0xd2, 0xaf, 0xb8, 0x0a, 0x42, 0xee, 0x06, 0xca, 0xaf, 0x1e, 0x96, 0xbf, 0xdd, 0x81, 0x90,
-	0xbf, 0x55, 0xfe, 0x49, 0xc5, 0x59, 0x4a, 0xf7, 0xc3, 0x2d, 0x6e, 0xa3, 0x8d, 0x1c, 0xe0, 0xb2,
-	0x18, 0xa8, 0x06, 0x93, 0xfa, 0xd4, 0xa4, 0x6e, 0x90, 0x5c, 0x23, 0x2d, 0xa3, 0x65, 0x80, 0xf5,
-	0x3c, 0x5a, 0x04, 0xc7, 0x30, 0x49, 0xc4, 0x73, 0xcc, 0xb3, 0x49, 0x4c, 0xd7, 0x68, 0x13, 0x98,
-	0x22, 0x88, 0xbb, 0x68, 0x7b, 0x34, 0x05, 0x97, 0x46, 0x51, 0xff, 0xd2, 0xe5, 0xc2, 0xf3, 0x0c,
-	0xec, 0xb9, 0x81, 0x55, 0xc9, 0xc8, 0x41, 0x8e, 0x52, 0xc7, 0xb1, 0xf0, 0xbc, 0x8c, 0xf8, 0xf7,
-	0x48, 0x2a, 0x2b, 0xf8, 0x04, 0xad, 0xc3, 0x00, 0x58, 0xa2, 0xc1, 0x3d, 0xa3, 0x9a, 0xf5, 0x0c,
-	0xee, 0x85, 0xc1, 0xd5, 0x48, 0x31, 0x46, 0xd2, 0xce, 0x3c, 0xcd, 0xd4, 0x92, 0x01, 0xd7, 0x60,
-	0x3c, 0x84, 0x3f, 0xa0, 0x7f, 0x8b, 0x33, 0xee, 0x26, 0x91, 0x1f, 0x53, 0x0e, 0xae, 0x62, 0x3d,
-	0x08, 0xa8, 0x81, 0xb6, 0x0d, 0xf4, 0x1f, 0x52, 0x98, 0x48, 0x37, 0x33, 0x75, 0x8c, 0x27, 0xa3,
-	0x6e, 0x17, 0xea, 0xa4, 0x88, 0x5f, 0x21, 0xcc, 0x78, 0x3e, 0x88, 0x44, 0x41, 0x6c, 0xa8, 0x7c,
-	0xf8, 0xe7, 0xa3, 0x52, 0xb3, 0xce, 0x77, 0x15, 0xc4, 0xc3, 0x42, 0x19, 0x1f, 0x0b, 0xe1, 0xf7,
-	0x68, 0x6b, 0x04, 0x2a, 0xf2, 0x0c, 0x0d, 0x0c, 0x6d, 0x67, 0x8a, 0xd6, 0xca, 0xf7, 0x19, 0x72,
-	0x33, 0x47, 0x96, 0xe3, 0x43, 0x2e, 0x87, 0x3e, 0x4c, 0x71, 0xbd, 0x29, 0xee, 0xb1, 0xb1, 0x4d,
-	0x73, 0xa7, 0xe3, 0xcd, 0x79, 0x34, 0xab, 0x92, 0x60, 0xef, 0xcb, 0x0c, 0x5a, 0x9b, 0x18, 0x03,
-	0x6e, 0xa2, 0xc5, 0x00, 0x94, 0xa2, 0xbe, 0xb9, 0x4e, 0xe9, 0x2d, 0x69, 0xdc, 0x3f, 0x34, 0xd2,
-	0x0d, 0x85, 0x0c, 0x9b, 0x73, 0x57, 0x37, 0xbb, 0x15, 0xa7, 0xc8, 0xab, 0x7d, 0xb3, 0xd0, 0xbc,
-	0x51, 0xfe, 0x80, 0x5b, 0x92, 0xf7, 0xea, 0xb3, 0x85, 0x16, 0x5b, 0xb1, 0x0c, 0x4f, 0xa9, 0xfa,
-	0x88, 0xdf, 0xa1, 0x55, 0x9a, 0xe8, 0x1e, 0x84, 0x5a, 0x30, 0x73, 0x01, 0x4c, 0xab, 0x96, 0x9b,
-	0xff, 0xff, 0xbc, 0xd9, 0xdd, 0xbb, 0xef, 0xd1, 0x23, 0x2d, 0x19, 0x72, 0x91, 0x1e, 0x44, 0x67,
-	0x22, 0x1b, 0xb7, 0xd1, 0x3a, 0xe3, 0x2e, 0xe5, 0xdc, 0xed, 0x5f, 0xc6, 0x82, 0x29, 0x53, 0xe8,
-	0x60, 0xfa, 0x1c, 0xe6, 0xab, 0xb4, 0x88, 0x93, 0x8a, 0xb3, 0xca, 0xf8, 0x11, 0xe7, 0x6f, 0x4c,
-	0xca, 0xa8, 0xd4, 0x66, 0xf5, 0xea, 0xb6, 0x6e, 0x5d, 0xdf, 0xd6, 0xad, 0x1f, 0xb7, 0x75, 0xeb,
-	0xd3, 0x5d, 0xbd, 0x72, 0x7d, 0x57, 0xaf, 0x7c, 0xbf, 0xab, 0x57, 0xce, 0x16, 0xcc, 0xab, 0x79,
-	0xf8, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x33, 0xe4, 0x2f, 0xe6, 0x7e, 0x06, 0x00, 0x00,
+func (m *ExecuteProposalBatchMsg) Reset()         { *m = ExecuteProposalBatchMsg{} }
+func (m *ExecuteProposalBatchMsg) String() string { return proto.CompactTextString(m) }
+func (*ExecuteProposalBatchMsg) ProtoMessage()    {}
+func (*ExecuteProposalBatchMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c873c2eb2d33dfe8, []int{3}
 }
-
-func (m *Tx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ExecuteProposalBatchMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Tx) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Fees != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Fees.Size()))
-		n1, err := m.Fees.MarshalTo(dAtA[i:])
+func (m *ExecuteProposalBatchMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecuteProposalBatchMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n1
+		return b[:n], nil
 	}
-	if len(m.Signatures) > 0 {
-		for _, msg := range m.Signatures {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.Multisig) > 0 {
-		for _, b := range m.Multisig {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
-	}
-	if m.Sum != nil {
-		nn2, err := m.Sum.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += nn2
-	}
-	return i, nil
+}
+func (m *ExecuteProposalBatchMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteProposalBatchMsg.Merge(m, src)
+}
+func (m *ExecuteProposalBatchMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecuteProposalBatchMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteProposalBatchMsg.DiscardUnknown(m)
 }
 
-func (m *Tx_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.CashSendMsg != nil {
-		dAtA[i] = 0x9a
-		i++
-		dAtA[i] = 0x3
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
-		n3, err := m.CashSendMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
+var xxx_messageInfo_ExecuteProposalBatchMsg proto.InternalMessageInfo
+
+func (m *ExecuteProposalBatchMsg) GetMessages() []ExecuteProposalBatchMsg_Union {
+	if m != nil {
+		return m.Messages
 	}
-	return i, nil
+	return nil
 }
-func (m *Tx_MultisigCreateMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.MultisigCreateMsg != nil {
-		dAtA[i] = 0xc2
-		i++
-		dAtA[i] = 0x3
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigCreateMsg.Size()))
-		n4, err := m.MultisigCreateMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
-	}
-	return i, nil
+
+type ExecuteProposalBatchMsg_Union struct {
+	// No recursive batches!
+	//
+	// Types that are valid to be assigned to Sum:
+	//	*ExecuteProposalBatchMsg_Union_CashSendMsg
+	//	*ExecuteProposalBatchMsg_Union_MultisigUpdateMsg
+	//	*ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg
+	//	*ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg
+	//	*ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg
+	//	*ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg
+	//	*ExecuteProposalBatchMsg_Union_CdUpdateConfigurationMsg
+	//	*ExecuteProposalBatchMsg_Union_CdModerateCountdownMsg
+	Sum isExecuteProposalBatchMsg_Union_Sum `protobuf_oneof:"sum"`
 }
-func (m *Tx_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.MultisigUpdateMsg != nil {
-		dAtA[i] = 0xca
-		i++
-		dAtA[i] = 0x3
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
-		n5, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n5
-	}
-	return i, nil
+
+func (m *ExecuteProposalBatchMsg_Union) Reset()         { *m = ExecuteProposalBatchMsg_Union{} }
+func (m *ExecuteProposalBatchMsg_Union) String() string { return proto.CompactTextString(m) }
+func (*ExecuteProposalBatchMsg_Union) ProtoMessage()    {}
+func (*ExecuteProposalBatchMsg_Union) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c873c2eb2d33dfe8, []int{3, 0}
 }
-func (m *Tx_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.ValidatorsApplyDiffMsg != nil {
-		dAtA[i] = 0xd2
-		i++
-		dAtA[i] = 0x3
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
-		n6, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
+func (m *ExecuteProposalBatchMsg_Union) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecuteProposalBatchMsg_Union) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecuteProposalBatchMsg_Union.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n6
+		return b[:n], nil
 	}
-	return i, nil
 }
-func (m *Tx_ExecuteBatchMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.ExecuteBatchMsg != nil {
-		dAtA[i] = 0xe2
-		i++
-		dAtA[i] = 0x3
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.ExecuteBatchMsg.Size()))
-		n7, err := m.ExecuteBatchMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n7
+func (m *ExecuteProposalBatchMsg_Union) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteProposalBatchMsg_Union.Merge(m, src)
+}
+func (m *ExecuteProposalBatchMsg_Union) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecuteProposalBatchMsg_Union) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteProposalBatchMsg_Union.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteProposalBatchMsg_Union proto.InternalMessageInfo
+
+type isExecuteProposalBatchMsg_Union_Sum interface {
+	isExecuteProposalBatchMsg_Union_Sum()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type ExecuteProposalBatchMsg_Union_CashSendMsg struct {
+	CashSendMsg *cash.SendMsg `protobuf:"bytes,51,opt,name=cash_send_msg,json=cashSendMsg,proto3,oneof"`
+}
+type ExecuteProposalBatchMsg_Union_MultisigUpdateMsg struct {
+	MultisigUpdateMsg *multisig.UpdateMsg `protobuf:"bytes,57,opt,name=multisig_update_msg,json=multisigUpdateMsg,proto3,oneof"`
+}
+type ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg struct {
+	ValidatorsApplyDiffMsg *validators.ApplyDiffMsg `protobuf:"bytes,58,opt,name=validators_apply_diff_msg,json=validatorsApplyDiffMsg,proto3,oneof"`
+}
+type ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg struct {
+	GovUpdateElectorateMsg *gov.UpdateElectorateMsg `protobuf:"bytes,77,opt,name=gov_update_electorate_msg,json=govUpdateElectorateMsg,proto3,oneof"`
+}
+type ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg struct {
+	GovUpdateElectionRuleMsg *gov.UpdateElectionRuleMsg `protobuf:"bytes,78,opt,name=gov_update_election_rule_msg,json=govUpdateElectionRuleMsg,proto3,oneof"`
+}
+type ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg struct {
+	GovCreateTextResolutionMsg *gov.CreateTextResolutionMsg `protobuf:"bytes,79,opt,name=gov_create_text_resolution_msg,json=govCreateTextResolutionMsg,proto3,oneof"`
+}
+type ExecuteProposalBatchMsg_Union_CdUpdateConfigurationMsg struct {
+	CdUpdateConfigurationMsg *countdown.UpdateConfigurationMsg `protobuf:"bytes,114,opt,name=cd_update_configuration_msg,json=cdUpdateConfigurationMsg,proto3,oneof"`
+}
+type ExecuteProposalBatchMsg_Union_CdModerateCountdownMsg struct {
+	CdModerateCountdownMsg *countdown.ModerateCountdownMsg `protobuf:"bytes,116,opt,name=cd_moderate_countdown_msg,json=cdModerateCountdownMsg,proto3,oneof"`
+}
+
+func (*ExecuteProposalBatchMsg_Union_CashSendMsg) isExecuteProposalBatchMsg_Union_Sum()            {}
+func (*ExecuteProposalBatchMsg_Union_MultisigUpdateMsg) isExecuteProposalBatchMsg_Union_Sum()      {}
+func (*ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
+func (*ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
+func (*ExecuteProposalBatchMsg_Union_CdUpdateConfigurationMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
+func (*ExecuteProposalBatchMsg_Union_CdModerateCountdownMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+
+func (m *ExecuteProposalBatchMsg_Union) GetSum() isExecuteProposalBatchMsg_Union_Sum {
+	if m != nil {
+		return m.Sum
 	}
-	return i, nil
+	return nil
 }
-func (m *Tx_MigrationUpgradeSchemaMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.MigrationUpgradeSchemaMsg != nil {
-		dAtA[i] = 0xaa
-		i++
-		dAtA[i] = 0x4
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.MigrationUpgradeSchemaMsg.Size()))
-		n8, err := m.MigrationUpgradeSchemaMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n8
+
+func (m *ExecuteProposalBatchMsg_Union) GetCashSendMsg() *cash.SendMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_CashSendMsg); ok {
+		return x.CashSendMsg
 	}
-	return i, nil
+	return nil
 }
-func (m *Tx_CdCreateUserMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.CdCreateUserMsg != nil {
-		dAtA[i] = 0xa2
-		i++
-		dAtA[i] = 0x6
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.CdCreateUserMsg.Size()))
-		n9, err := m.CdCreateUserMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n9
+
+func (m *ExecuteProposalBatchMsg_Union) GetMultisigUpdateMsg() *multisig.UpdateMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_MultisigUpdateMsg); ok {
+		return x.MultisigUpdateMsg
 	}
-	return i, nil
+	return nil
 }
-func (m *Tx_CdCreateCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.CdCreateCountdownMsg != nil {
-		dAtA[i] = 0xaa
-		i++
-		dAtA[i] = 0x6
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.CdCreateCountdownMsg.Size()))
-		n10, err := m.CdCreateCountdownMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n10
+
+func (m *ExecuteProposalBatchMsg_Union) GetValidatorsApplyDiffMsg() *validators.ApplyDiffMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg); ok {
+		return x.ValidatorsApplyDiffMsg
 	}
-	return i, nil
+	return nil
 }
-func (m *Tx_CdDeleteCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.CdDeleteCountdownMsg != nil {
-		dAtA[i] = 0xb2
-		i++
-		dAtA[i] = 0x6
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.CdDeleteCountdownMsg.Size()))
-		n11, err := m.CdDeleteCountdownMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n11
+
+func (m *ExecuteProposalBatchMsg_Union) GetGovUpdateElectorateMsg() *gov.UpdateElectorateMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg); ok {
+		return x.GovUpdateElectorateMsg
 	}
-	return i, nil
+	return nil
 }
-func (m *ExecuteBatchMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+
+func (m *ExecuteProposalBatchMsg_Union) GetGovUpdateElectionRuleMsg() *gov.UpdateElectionRuleMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg); ok {
+		return x.GovUpdateElectionRuleMsg
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ExecuteBatchMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for _, msg := range m.Messages {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func (m *ExecuteProposalBatchMsg_Union) GetGovCreateTextResolutionMsg() *gov.CreateTextResolutionMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg); ok {
+		return x.GovCreateTextResolutionMsg
 	}
-	return i, nil
+	return nil
 }
 
-func (m *ExecuteBatchMsg_Union) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ExecuteProposalBatchMsg_Union) GetCdUpdateConfigurationMsg() *countdown.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_CdUpdateConfigurationMsg); ok {
+		return x.CdUpdateConfigurationMsg
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ExecuteBatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Sum != nil {
-		nn12, err := m.Sum.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += nn12
+func (m *ExecuteProposalBatchMsg_Union) GetCdModerateCountdownMsg() *countdown.ModerateCountdownMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_CdModerateCountdownMsg); ok {
+		return x.CdModerateCountdownMsg
 	}
-	return i, nil
+	return nil
 }
 
-func (m *ExecuteBatchMsg_Union_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.CashSendMsg != nil {
-		dAtA[i] = 0x9a
-		i++
-		dAtA[i] = 0x3
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
-		n13, err := m.CashSendMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n13
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*ExecuteProposalBatchMsg_Union) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _ExecuteProposalBatchMsg_Union_OneofMarshaler, _ExecuteProposalBatchMsg_Union_OneofUnmarshaler, _ExecuteProposalBatchMsg_Union_OneofSizer, []interface{}{
+		(*ExecuteProposalBatchMsg_Union_CashSendMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_MultisigUpdateMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_CdUpdateConfigurationMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_CdModerateCountdownMsg)(nil),
 	}
-	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_MultisigCreateMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.MultisigCreateMsg != nil {
-		dAtA[i] = 0xc2
-		i++
-		dAtA[i] = 0x3
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigCreateMsg.Size()))
-		n14, err := m.MultisigCreateMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+
+func _ExecuteProposalBatchMsg_Union_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*ExecuteProposalBatchMsg_Union)
+	// sum
+	switch x := m.Sum.(type) {
+	case *ExecuteProposalBatchMsg_Union_CashSendMsg:
+		_ = b.EncodeVarint(51<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashSendMsg); err != nil {
+			return err
 		}
-		i += n14
-	}
-	return i, nil
-}
-func (m *ExecuteBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.MultisigUpdateMsg != nil {
-		dAtA[i] = 0xca
-		i++
-		dAtA[i] = 0x3
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
-		n15, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	case *ExecuteProposalBatchMsg_Union_MultisigUpdateMsg:
+		_ = b.EncodeVarint(57<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MultisigUpdateMsg); err != nil {
+			return err
 		}
-		i += n15
-	}
-	return i, nil
-}
-func (m *CronTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *CronTask) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authenticators) > 0 {
-		for _, b := range m.Authenticators {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
+	case *ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg:
+		_ = b.EncodeVarint(58<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ValidatorsApplyDiffMsg); err != nil {
+			return err
 		}
-	}
-	if m.Sum != nil {
-		nn16, err := m.Sum.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	case *ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg:
+		_ = b.EncodeVarint(77<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovUpdateElectorateMsg); err != nil {
+			return err
+		}
+	case *ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg:
+		_ = b.EncodeVarint(78<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovUpdateElectionRuleMsg); err != nil {
+			return err
+		}
+	case *ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg:
+		_ = b.EncodeVarint(79<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovCreateTextResolutionMsg); err != nil {
+			return err
+		}
+	case *ExecuteProposalBatchMsg_Union_CdUpdateConfigurationMsg:
+		_ = b.EncodeVarint(114<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *ExecuteProposalBatchMsg_Union_CdModerateCountdownMsg:
+		_ = b.EncodeVarint(116<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdModerateCountdownMsg); err != nil {
+			return err
 		}
-		i += nn16
+	case nil:
+	default:
+		return fmt.Errorf("ExecuteProposalBatchMsg_Union.Sum has unexpected type %T", x)
 	}
-	return i, nil
+	return nil
 }
 
-func (m *CronTask_CdAddLyricsMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.CdAddLyricsMsg != nil {
-		dAtA[i] = 0xc2
-		i++
-		dAtA[i] = 0x7
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.CdAddLyricsMsg.Size()))
-		n17, err := m.CdAddLyricsMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+func _ExecuteProposalBatchMsg_Union_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*ExecuteProposalBatchMsg_Union)
+	switch tag {
+	case 51: // sum.cash_send_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
 		}
-		i += n17
-	}
-	return i, nil
-}
-func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *Tx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Fees != nil {
-		l = m.Fees.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	if len(m.Signatures) > 0 {
-		for _, e := range m.Signatures {
-			l = e.Size()
-			n += 1 + l + sovCodec(uint64(l))
+		msg := new(cash.SendMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_CashSendMsg{msg}
+		return true, err
+	case 57: // sum.multisig_update_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
 		}
-	}
-	if len(m.Multisig) > 0 {
-		for _, b := range m.Multisig {
-			l = len(b)
-			n += 1 + l + sovCodec(uint64(l))
+		msg := new(multisig.UpdateMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_MultisigUpdateMsg{msg}
+		return true, err
+	case 58: // sum.validators_apply_diff_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
 		}
+		msg := new(validators.ApplyDiffMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg{msg}
+		return true, err
+	case 77: // sum.gov_update_electorate_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.UpdateElectorateMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg{msg}
+		return true, err
+	case 78: // sum.gov_update_election_rule_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.UpdateElectionRuleMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg{msg}
+		return true, err
+	case 79: // sum.gov_create_text_resolution_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.CreateTextResolutionMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg{msg}
+		return true, err
+	case 114: // sum.cd_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_CdUpdateConfigurationMsg{msg}
+		return true, err
+	case 116: // sum.cd_moderate_countdown_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.ModerateCountdownMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_CdModerateCountdownMsg{msg}
+		return true, err
+	default:
+		return false, nil
 	}
-	if m.Sum != nil {
-		n += m.Sum.Size()
-	}
-	return n
 }
 
-func (m *Tx_CashSendMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CashSendMsg != nil {
-		l = m.CashSendMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+func _ExecuteProposalBatchMsg_Union_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*ExecuteProposalBatchMsg_Union)
+	// sum
+	switch x := m.Sum.(type) {
+	case *ExecuteProposalBatchMsg_Union_CashSendMsg:
+		s := proto.Size(x.CashSendMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_MultisigUpdateMsg:
+		s := proto.Size(x.MultisigUpdateMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg:
+		s := proto.Size(x.ValidatorsApplyDiffMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg:
+		s := proto.Size(x.GovUpdateElectorateMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg:
+		s := proto.Size(x.GovUpdateElectionRuleMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg:
+		s := proto.Size(x.GovCreateTextResolutionMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_CdUpdateConfigurationMsg:
+		s := proto.Size(x.CdUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_CdModerateCountdownMsg:
+		s := proto.Size(x.CdModerateCountdownMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
 	}
 	return n
 }
-func (m *Tx_MultisigCreateMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MultisigCreateMsg != nil {
-		l = m.MultisigCreateMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
-	}
-	return n
+
+// CronTask is a format used by the CronMarshaler to marshal and unmarshal cron
+// task.
+//
+// When there is a gap in message sequence numbers - that most likely means some
+// old fields got deprecated. This is done to maintain binary compatibility.
+type CronTask struct {
+	// Authenticators contains a list of conditions that authenticate execution
+	// of this task.
+	// This is one of the main differences between the CronTask and Tx entities.
+	// CronTask is created interanlly and does not have to be signed. Because we
+	// use the same handlers as for the Tx to process a cron task, we must
+	// provide authentication method. This attribute contains all authentication
+	// conditions required for execution, that will be inserted into the context.
+	Authenticators []github_com_iov_one_weave.Condition `protobuf:"bytes,1,rep,name=authenticators,proto3,casttype=github.com/iov-one/weave.Condition" json:"authenticators,omitempty"`
+	// Use the same indexes for the messages as the Tx message.
+	//
+	// Types that are valid to be assigned to Sum:
+	//	*CronTask_GovTallyMsg
+	//	*CronTask_CdAddLyricsMsg
+	//	*CronTask_CdExpireCountdownTask
+	Sum isCronTask_Sum `protobuf_oneof:"sum"`
 }
-func (m *Tx_MultisigUpdateMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MultisigUpdateMsg != nil {
-		l = m.MultisigUpdateMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
-	}
-	return n
+
+func (m *CronTask) Reset()         { *m = CronTask{} }
+func (m *CronTask) String() string { return proto.CompactTextString(m) }
+func (*CronTask) ProtoMessage()    {}
+func (*CronTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c873c2eb2d33dfe8, []int{4}
 }
-func (m *Tx_ValidatorsApplyDiffMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ValidatorsApplyDiffMsg != nil {
-		l = m.ValidatorsApplyDiffMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
-	}
-	return n
+func (m *CronTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *Tx_ExecuteBatchMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ExecuteBatchMsg != nil {
-		l = m.ExecuteBatchMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+func (m *CronTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CronTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
 }
-func (m *Tx_MigrationUpgradeSchemaMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MigrationUpgradeSchemaMsg != nil {
-		l = m.MigrationUpgradeSchemaMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
-	}
-	return n
+func (m *CronTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronTask.Merge(m, src)
 }
-func (m *Tx_CdCreateUserMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CdCreateUserMsg != nil {
-		l = m.CdCreateUserMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
-	}
-	return n
+func (m *CronTask) XXX_Size() int {
+	return m.Size()
 }
-func (m *Tx_CdCreateCountdownMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CdCreateCountdownMsg != nil {
-		l = m.CdCreateCountdownMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
-	}
-	return n
+func (m *CronTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronTask.DiscardUnknown(m)
 }
-func (m *Tx_CdDeleteCountdownMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CdDeleteCountdownMsg != nil {
-		l = m.CdDeleteCountdownMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
-	}
-	return n
+
+var xxx_messageInfo_CronTask proto.InternalMessageInfo
+
+type isCronTask_Sum interface {
+	isCronTask_Sum()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
-func (m *ExecuteBatchMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for _, e := range m.Messages {
-			l = e.Size()
-			n += 1 + l + sovCodec(uint64(l))
-		}
-	}
-	return n
+
+type CronTask_GovTallyMsg struct {
+	GovTallyMsg *gov.TallyMsg `protobuf:"bytes,76,opt,name=gov_tally_msg,json=govTallyMsg,proto3,oneof"`
+}
+type CronTask_CdAddLyricsMsg struct {
+	CdAddLyricsMsg *countdown.CountdownTask `protobuf:"bytes,120,opt,name=cd_add_lyrics_msg,json=cdAddLyricsMsg,proto3,oneof"`
+}
+type CronTask_CdExpireCountdownTask struct {
+	CdExpireCountdownTask *countdown.ExpireCountdownTask `protobuf:"bytes,121,opt,name=cd_expire_countdown_task,json=cdExpireCountdownTask,proto3,oneof"`
 }
 
-func (m *ExecuteBatchMsg_Union) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sum != nil {
-		n += m.Sum.Size()
+func (*CronTask_GovTallyMsg) isCronTask_Sum()           {}
+func (*CronTask_CdAddLyricsMsg) isCronTask_Sum()        {}
+func (*CronTask_CdExpireCountdownTask) isCronTask_Sum() {}
+
+func (m *CronTask) GetSum() isCronTask_Sum {
+	if m != nil {
+		return m.Sum
 	}
-	return n
+	return nil
 }
 
-func (m *ExecuteBatchMsg_Union_CashSendMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CashSendMsg != nil {
-		l = m.CashSendMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+func (m *CronTask) GetAuthenticators() []github_com_iov_one_weave.Condition {
+	if m != nil {
+		return m.Authenticators
 	}
-	return n
+	return nil
 }
-func (m *ExecuteBatchMsg_Union_MultisigCreateMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MultisigCreateMsg != nil {
-		l = m.MultisigCreateMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+
+func (m *CronTask) GetGovTallyMsg() *gov.TallyMsg {
+	if x, ok := m.GetSum().(*CronTask_GovTallyMsg); ok {
+		return x.GovTallyMsg
 	}
-	return n
+	return nil
 }
-func (m *ExecuteBatchMsg_Union_MultisigUpdateMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MultisigUpdateMsg != nil {
-		l = m.MultisigUpdateMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+
+func (m *CronTask) GetCdAddLyricsMsg() *countdown.CountdownTask {
+	if x, ok := m.GetSum().(*CronTask_CdAddLyricsMsg); ok {
+		return x.CdAddLyricsMsg
 	}
-	return n
+	return nil
 }
-func (m *CronTask) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Authenticators) > 0 {
-		for _, b := range m.Authenticators {
-			l = len(b)
-			n += 1 + l + sovCodec(uint64(l))
-		}
-	}
-	if m.Sum != nil {
-		n += m.Sum.Size()
+
+func (m *CronTask) GetCdExpireCountdownTask() *countdown.ExpireCountdownTask {
+	if x, ok := m.GetSum().(*CronTask_CdExpireCountdownTask); ok {
+		return x.CdExpireCountdownTask
 	}
-	return n
+	return nil
 }
 
-func (m *CronTask_CdAddLyricsMsg) Size() (n int) {
-	if m == nil {
-		return 0
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*CronTask) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _CronTask_OneofMarshaler, _CronTask_OneofUnmarshaler, _CronTask_OneofSizer, []interface{}{
+		(*CronTask_GovTallyMsg)(nil),
+		(*CronTask_CdAddLyricsMsg)(nil),
+		(*CronTask_CdExpireCountdownTask)(nil),
 	}
-	var l int
-	_ = l
-	if m.CdAddLyricsMsg != nil {
-		l = m.CdAddLyricsMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+}
+
+func _CronTask_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*CronTask)
+	// sum
+	switch x := m.Sum.(type) {
+	case *CronTask_GovTallyMsg:
+		_ = b.EncodeVarint(76<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovTallyMsg); err != nil {
+			return err
+		}
+	case *CronTask_CdAddLyricsMsg:
+		_ = b.EncodeVarint(120<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdAddLyricsMsg); err != nil {
+			return err
+		}
+	case *CronTask_CdExpireCountdownTask:
+		_ = b.EncodeVarint(121<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CdExpireCountdownTask); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("CronTask.Sum has unexpected type %T", x)
 	}
-	return n
+	return nil
 }
 
-func sovCodec(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func _CronTask_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*CronTask)
+	switch tag {
+	case 76: // sum.gov_tally_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.TallyMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &CronTask_GovTallyMsg{msg}
+		return true, err
+	case 120: // sum.cd_add_lyrics_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(countdown.CountdownTask)
+		err := b.DecodeMessage(msg)
+		m.Sum = &CronTask_CdAddLyricsMsg{msg}
+		return true, err
+	case 121: // sum.cd_expire_countdown_task
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
 		}
+		msg := new(countdown.ExpireCountdownTask)
+		err := b.DecodeMessage(msg)
+		m.Sum = &CronTask_CdExpireCountdownTask{msg}
+		return true, err
+	default:
+		return false, nil
 	}
-	return n
+}
+
+func _CronTask_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*CronTask)
+	// sum
+	switch x := m.Sum.(type) {
+	case *CronTask_GovTallyMsg:
+		s := proto.Size(x.GovTallyMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *CronTask_CdAddLyricsMsg:
+		s := proto.Size(x.CdAddLyricsMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *CronTask_CdExpireCountdownTask:
+		s := proto.Size(x.CdExpireCountdownTask)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+func init() {
+	proto.RegisterType((*Tx)(nil), "countdown.Tx")
+	proto.RegisterType((*ExecuteBatchMsg)(nil), "countdown.ExecuteBatchMsg")
+	proto.RegisterType((*ExecuteBatchMsg_Union)(nil), "countdown.ExecuteBatchMsg.Union")
+	proto.RegisterType((*ProposalOptions)(nil), "countdown.ProposalOptions")
+	proto.RegisterType((*ExecuteProposalBatchMsg)(nil), "countdown.ExecuteProposalBatchMsg")
+	proto.RegisterType((*ExecuteProposalBatchMsg_Union)(nil), "countdown.ExecuteProposalBatchMsg.Union")
+	proto.RegisterType((*CronTask)(nil), "countdown.CronTask")
+}
+
+func init() { proto.RegisterFile("cmd/countdown/app/codec.proto", fileDescriptor_c873c2eb2d33dfe8) }
+
+var fileDescriptor_c873c2eb2d33dfe8 = []byte{
+	// 1623 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x98, 0xcd, 0x6e, 0xdc, 0xb6,
+	0x16, 0xc7, 0x3d, 0x76, 0x92, 0xeb, 0xcb, 0x38, 0x31, 0xcc, 0x24, 0xf6, 0x64, 0x62, 0x4f, 0x1c,
+	0x5f, 0xdc, 0x0b, 0xe3, 0x5e, 0x5c, 0xcd, 0xbd, 0xc9, 0xa6, 0x2d, 0xba, 0xf1, 0x57, 0xbe, 0x1a,
+	0x27, 0xee, 0x8c, 0x1d, 0xa0, 0x45, 0x5a, 0x55, 0x26, 0x39, 0x1a, 0x75, 0x34, 0xa2, 0x4a, 0x52,
+	0x93, 0x71, 0xbf, 0xdb, 0x27, 0xe8, 0x43, 0x74, 0xd3, 0x37, 0xe8, 0xa2, 0x0f, 0x10, 0xa0, 0x9b,
+	0x74, 0xd7, 0x55, 0x50, 0x24, 0xbb, 0x3e, 0x42, 0x57, 0x85, 0x48, 0x4a, 0x23, 0x91, 0x9a, 0xb4,
+	0x40, 0x9b, 0x22, 0x0d, 0xba, 0x93, 0xce, 0xf9, 0xf3, 0x47, 0xe9, 0x1c, 0x9e, 0x23, 0x52, 0x60,
+	0x05, 0x0d, 0x70, 0x0b, 0xd1, 0x24, 0x12, 0x98, 0xde, 0x8f, 0x5a, 0x5e, 0x1c, 0xb7, 0x10, 0xc5,
+	0x04, 0x39, 0x31, 0xa3, 0x82, 0xc2, 0xbf, 0xe7, 0xae, 0x86, 0xe3, 0x07, 0xa2, 0x97, 0x1c, 0x3a,
+	0x88, 0x0e, 0x5a, 0x01, 0x1d, 0xfe, 0x97, 0x46, 0xa4, 0x75, 0x9f, 0x78, 0x43, 0xd2, 0x1a, 0x04,
+	0x3e, 0xf3, 0x44, 0x40, 0xa3, 0xe2, 0xd0, 0xc6, 0x7f, 0x26, 0xea, 0x47, 0x2d, 0xe4, 0xf1, 0x5e,
+	0x49, 0xfc, 0xef, 0xa7, 0x88, 0x7d, 0x3a, 0x2c, 0x69, 0x5b, 0x4f, 0xd1, 0x0e, 0x92, 0x50, 0x04,
+	0x3c, 0xf0, 0x7f, 0xf5, 0x93, 0xf0, 0xc0, 0xe7, 0x25, 0xf1, 0xff, 0x9f, 0x22, 0x1e, 0x7a, 0x61,
+	0x80, 0x3d, 0x41, 0x59, 0x79, 0xc8, 0x59, 0x9f, 0xfa, 0x54, 0x5e, 0xb6, 0xd2, 0x2b, 0x6d, 0x5d,
+	0x1a, 0x15, 0xe2, 0x5a, 0x90, 0xaf, 0x7d, 0x7b, 0x01, 0x4c, 0xef, 0x8f, 0xe0, 0x25, 0x70, 0xac,
+	0x4b, 0x08, 0xaf, 0xd7, 0x56, 0x6b, 0xeb, 0x27, 0x2f, 0x9f, 0x72, 0xd2, 0x98, 0x38, 0x57, 0x09,
+	0xb9, 0x11, 0x75, 0x69, 0x5b, 0xba, 0xe0, 0x65, 0x00, 0x78, 0xe0, 0x47, 0x9e, 0x48, 0x18, 0xe1,
+	0xf5, 0xe9, 0xd5, 0x99, 0xf5, 0x93, 0x97, 0xa1, 0x93, 0x3e, 0xb2, 0xd3, 0x11, 0xb8, 0x93, 0xb9,
+	0xda, 0x05, 0x15, 0x6c, 0x80, 0xd9, 0x2c, 0x08, 0xf5, 0x63, 0xab, 0x33, 0xeb, 0x73, 0xed, 0xfc,
+	0x1e, 0x5e, 0x01, 0xa7, 0xd2, 0x59, 0x5c, 0x4e, 0x22, 0xec, 0x0e, 0xb8, 0x5f, 0xbf, 0x52, 0x9c,
+	0xbb, 0x43, 0x22, 0xbc, 0xcb, 0xfd, 0xeb, 0x53, 0xed, 0x93, 0xe9, 0xbd, 0xbe, 0x85, 0x3b, 0xe0,
+	0x4c, 0x06, 0x70, 0x11, 0x23, 0x9e, 0x20, 0x72, 0xe8, 0x4b, 0x72, 0xe8, 0x19, 0x27, 0xf3, 0x39,
+	0x5b, 0xd2, 0xa7, 0x00, 0x0b, 0x99, 0x35, 0x37, 0x96, 0x30, 0x49, 0x8c, 0x33, 0xcc, 0xcb, 0x26,
+	0xe6, 0x20, 0xc6, 0x36, 0x26, 0x37, 0xc2, 0x03, 0x70, 0x7e, 0x9c, 0x05, 0xd7, 0x8b, 0xe3, 0xf0,
+	0xc8, 0xc5, 0x41, 0xb7, 0x2b, 0x61, 0xaf, 0x48, 0x58, 0xdd, 0x19, 0x2b, 0x9c, 0x8d, 0x54, 0xb1,
+	0x1d, 0x74, 0xbb, 0x8a, 0xb8, 0x38, 0x76, 0x15, 0x3d, 0xf0, 0x3a, 0x58, 0x20, 0x23, 0x82, 0x12,
+	0x41, 0xdc, 0x43, 0x4f, 0xa0, 0x9e, 0xc4, 0xbd, 0x2a, 0x71, 0x0d, 0x27, 0x4f, 0xa3, 0xb3, 0xa3,
+	0x34, 0x9b, 0xa9, 0x44, 0x01, 0xe7, 0x49, 0xd9, 0x04, 0xdf, 0x06, 0xcb, 0x79, 0x3d, 0xb8, 0x49,
+	0xec, 0x33, 0x0f, 0x13, 0x97, 0xa3, 0x1e, 0x19, 0x78, 0x12, 0xba, 0x23, 0xa1, 0x17, 0x9c, 0x5c,
+	0xe4, 0x1c, 0x28, 0x51, 0x47, 0x6a, 0x14, 0xf5, 0x7c, 0xee, 0x35, 0x9d, 0xf0, 0x0e, 0x58, 0xf2,
+	0xe9, 0x30, 0xcb, 0x44, 0xcc, 0x68, 0x4c, 0xb9, 0x17, 0x4a, 0xf4, 0x0d, 0x89, 0x5e, 0x74, 0x7c,
+	0x3a, 0xd4, 0xd9, 0xd8, 0xd3, 0x6e, 0x45, 0x3d, 0xeb, 0xd3, 0xa1, 0x65, 0xcf, 0x80, 0x98, 0x84,
+	0xc4, 0x04, 0xde, 0x2c, 0x00, 0xb7, 0xa5, 0xdf, 0x06, 0x5a, 0x76, 0xf8, 0x3f, 0x30, 0x97, 0x02,
+	0x87, 0x54, 0xa7, 0xf8, 0x35, 0x49, 0x99, 0x93, 0x94, 0xbb, 0x34, 0xcb, 0x2d, 0xf0, 0xe9, 0xf0,
+	0x2e, 0xcd, 0x93, 0x9a, 0x8e, 0xd0, 0xcb, 0x82, 0x84, 0x04, 0x09, 0xca, 0xb2, 0x15, 0xb2, 0xab,
+	0x93, 0x9a, 0x0e, 0x57, 0xeb, 0x60, 0x27, 0x17, 0xe8, 0xa4, 0xfa, 0x74, 0x58, 0xe1, 0x81, 0xf7,
+	0xc0, 0xb2, 0x89, 0x4d, 0x93, 0xc2, 0x92, 0x50, 0x91, 0x6f, 0xeb, 0xfc, 0x1a, 0xe4, 0x80, 0x46,
+	0xed, 0x24, 0xd4, 0xec, 0x7a, 0x99, 0x3d, 0xf6, 0xc1, 0x6b, 0x00, 0x22, 0x9c, 0xe5, 0x21, 0xe1,
+	0x84, 0x49, 0x26, 0xd6, 0x4f, 0x3b, 0x5e, 0x33, 0x2a, 0xe2, 0x07, 0x9c, 0x30, 0xbd, 0x62, 0x10,
+	0x2e, 0x99, 0xe0, 0x5d, 0xb0, 0x34, 0x06, 0xe5, 0xe3, 0x24, 0x8d, 0x48, 0xda, 0x8a, 0x45, 0xdb,
+	0xca, 0xee, 0x75, 0x1e, 0x10, 0xb6, 0xed, 0x9a, 0xab, 0xf3, 0x5a, 0xe6, 0x76, 0x2d, 0xae, 0x4a,
+	0xa3, 0xcd, 0xb5, 0xed, 0x70, 0x1b, 0x2c, 0x20, 0xec, 0xfa, 0xcc, 0x8b, 0x84, 0xcb, 0xa8, 0x8e,
+	0xa5, 0x2f, 0x89, 0x4b, 0x05, 0xe2, 0xb5, 0x54, 0xd0, 0xa6, 0x59, 0x20, 0x4f, 0x23, 0x5c, 0xb4,
+	0xe8, 0xf0, 0x31, 0x32, 0xa4, 0x7d, 0x32, 0xc6, 0xf4, 0xac, 0xf0, 0xb5, 0xa5, 0x62, 0xcc, 0x99,
+	0x47, 0xb8, 0x64, 0x82, 0xbb, 0xe0, 0x2c, 0xc2, 0x59, 0x92, 0xc3, 0x23, 0x16, 0x20, 0x2e, 0x51,
+	0x81, 0x55, 0xbd, 0x2a, 0x8f, 0xb7, 0xa4, 0x44, 0x37, 0x18, 0x84, 0x0d, 0x23, 0xec, 0x80, 0x45,
+	0x84, 0xdd, 0xd8, 0x4b, 0xb8, 0x19, 0xb4, 0x77, 0x25, 0x70, 0xb9, 0x00, 0xdc, 0x4b, 0x55, 0x46,
+	0xcc, 0xce, 0x20, 0x6c, 0x99, 0x75, 0x2a, 0x18, 0xe1, 0xc9, 0xc0, 0xa4, 0xf6, 0xad, 0x54, 0xb4,
+	0xa5, 0xcc, 0x4e, 0x85, 0x6d, 0x87, 0xf7, 0xc0, 0x79, 0x84, 0x5d, 0xc1, 0xbc, 0x88, 0x77, 0x09,
+	0x33, 0xc8, 0xa1, 0x24, 0x5f, 0x2c, 0x90, 0xf7, 0xb5, 0xd0, 0x60, 0x2f, 0x22, 0x5c, 0xe5, 0x81,
+	0x14, 0xac, 0x22, 0xec, 0x7a, 0x08, 0x91, 0x58, 0x14, 0xd8, 0xf9, 0x74, 0xe9, 0x24, 0x03, 0x39,
+	0xc9, 0x3f, 0x0b, 0x93, 0x6c, 0x48, 0x7d, 0x0e, 0xca, 0xc8, 0x6a, 0xaa, 0x65, 0x84, 0x27, 0xfb,
+	0x75, 0x2a, 0x45, 0x10, 0x1b, 0x6f, 0x12, 0x59, 0xa9, 0xdc, 0x0f, 0x62, 0xe3, 0x25, 0x16, 0x10,
+	0x36, 0x8c, 0x70, 0x5f, 0x46, 0x9d, 0x13, 0xe1, 0x26, 0x51, 0x48, 0x51, 0xdf, 0x8d, 0x59, 0x80,
+	0xd4, 0x3a, 0xa3, 0x56, 0x2e, 0x3b, 0x44, 0x1c, 0x48, 0xd5, 0x5e, 0x2a, 0xca, 0x73, 0x69, 0x99,
+	0x35, 0x55, 0x13, 0x23, 0x32, 0x12, 0x6e, 0x18, 0x44, 0x8a, 0x1a, 0x5b, 0x54, 0x35, 0xf6, 0x36,
+	0x19, 0x89, 0x5b, 0x41, 0x34, 0xa6, 0x5a, 0x66, 0x5d, 0x0e, 0xba, 0x58, 0xf3, 0x6e, 0xf2, 0x9e,
+	0x55, 0x0e, 0xaa, 0x1e, 0x4b, 0xdd, 0xa4, 0x64, 0x82, 0x87, 0xe0, 0xc2, 0xb8, 0x1c, 0x10, 0x8d,
+	0xba, 0x81, 0x9f, 0xe8, 0xaf, 0x51, 0x4a, 0x64, 0x92, 0x78, 0xc9, 0xaa, 0x8a, 0xad, 0xa2, 0x52,
+	0xb7, 0x3e, 0x84, 0xab, 0x7d, 0x70, 0x0f, 0x9c, 0x43, 0xd8, 0xed, 0x86, 0x9e, 0x6f, 0x24, 0x8a,
+	0xeb, 0x8f, 0xdb, 0x98, 0x7e, 0x35, 0xf4, 0x7c, 0x23, 0x53, 0x10, 0x61, 0xd3, 0xaa, 0x17, 0xf2,
+	0x80, 0x62, 0xc2, 0xec, 0x2e, 0x28, 0xac, 0x85, 0xbc, 0xab, 0x85, 0xf6, 0x42, 0xae, 0xf2, 0xc0,
+	0x2e, 0x58, 0xa9, 0xea, 0xb0, 0x98, 0x79, 0x5d, 0x21, 0x67, 0x48, 0xe4, 0x0c, 0x6b, 0x93, 0xfb,
+	0xec, 0x76, 0x2a, 0xd5, 0xdf, 0x66, 0x84, 0x27, 0x38, 0xe1, 0x5b, 0xa0, 0x91, 0x16, 0x4c, 0x1c,
+	0xa7, 0xfb, 0x2b, 0xdd, 0x8a, 0x50, 0x2f, 0x89, 0xfa, 0x72, 0x92, 0xa1, 0xf5, 0x1a, 0x1b, 0x52,
+	0xa9, 0x7a, 0xcf, 0x56, 0xaa, 0xcb, 0x5f, 0xa3, 0xca, 0x03, 0xdf, 0x00, 0xf5, 0xb4, 0x35, 0x25,
+	0x87, 0x61, 0xc0, 0x7b, 0x46, 0x8c, 0xee, 0x4b, 0x78, 0xb3, 0xd8, 0x9c, 0x94, 0xce, 0x08, 0xd1,
+	0x39, 0x84, 0x2b, 0x1c, 0x59, 0xfc, 0xe5, 0xae, 0xa3, 0x10, 0x22, 0xd5, 0x49, 0xdf, 0xb7, 0xe3,
+	0xaf, 0x84, 0x39, 0x82, 0x8f, 0xe3, 0x5f, 0xe1, 0xd1, 0x75, 0xad, 0xe3, 0xcf, 0x09, 0x0b, 0x88,
+	0x02, 0x7f, 0x60, 0xd5, 0xb5, 0x8a, 0x6c, 0x47, 0x4a, 0xf2, 0xba, 0x36, 0x8c, 0xf0, 0x1d, 0xb9,
+	0xc4, 0x3d, 0x8c, 0x33, 0x56, 0x39, 0x14, 0x1f, 0x4a, 0xea, 0x6a, 0x31, 0xce, 0x18, 0xab, 0xd1,
+	0x46, 0x30, 0x96, 0x10, 0xae, 0x74, 0xc1, 0x00, 0x34, 0x65, 0xbf, 0x1e, 0xd0, 0x21, 0xa9, 0x9e,
+	0xe4, 0x23, 0x39, 0xc9, 0x3f, 0x4a, 0x6d, 0x3b, 0x55, 0x57, 0xce, 0xd3, 0x40, 0x78, 0x92, 0x57,
+	0xd7, 0x12, 0x23, 0x94, 0x61, 0xc2, 0x8a, 0xc1, 0xf9, 0xd8, 0xaa, 0xa5, 0xb6, 0x12, 0x15, 0xa3,
+	0x03, 0x11, 0x36, 0xad, 0x3a, 0xda, 0x69, 0xdb, 0xe3, 0x09, 0x42, 0x84, 0x73, 0xaa, 0x9a, 0xc9,
+	0x27, 0x56, 0xb4, 0x3b, 0x44, 0x74, 0x32, 0x49, 0x1e, 0x6d, 0xc3, 0x08, 0xdf, 0x94, 0xab, 0x8e,
+	0x11, 0x2e, 0x3c, 0x26, 0x8c, 0x28, 0x7c, 0x5a, 0xb3, 0x96, 0x5d, 0x5b, 0x09, 0xed, 0x65, 0x57,
+	0xe1, 0x80, 0xaf, 0xab, 0x46, 0x42, 0x59, 0xdf, 0x00, 0x7f, 0x56, 0xb3, 0x3b, 0x09, 0x65, 0xfd,
+	0x8a, 0x4e, 0x62, 0x58, 0xa1, 0x0f, 0x56, 0xc6, 0x8d, 0xb4, 0xaa, 0xd6, 0x3f, 0xaf, 0x59, 0xc5,
+	0x6e, 0x6c, 0x72, 0xca, 0xc5, 0x3e, 0xc1, 0xb9, 0x79, 0x1c, 0xcc, 0xf0, 0x64, 0xb0, 0xf6, 0xd5,
+	0x34, 0x98, 0x37, 0x8e, 0x05, 0x70, 0x13, 0xcc, 0x0e, 0x08, 0xe7, 0x9e, 0x2f, 0x8f, 0x77, 0x33,
+	0xc6, 0x6a, 0x34, 0xd4, 0xce, 0x41, 0x14, 0xd0, 0x68, 0xf3, 0xd8, 0x83, 0x47, 0x17, 0xa7, 0xda,
+	0xf9, 0xb8, 0xc6, 0x77, 0x35, 0x70, 0x5c, 0x7a, 0x5e, 0x80, 0x53, 0x5b, 0x16, 0xab, 0x1f, 0xff,
+	0x06, 0xe6, 0xb3, 0x93, 0xc2, 0x9d, 0x38, 0xfd, 0x9a, 0xf0, 0xdf, 0xfe, 0x76, 0xcf, 0xdf, 0x61,
+	0xd2, 0x03, 0x8d, 0xec, 0x30, 0x99, 0x1f, 0xa7, 0xcc, 0x53, 0xe5, 0x9a, 0xbd, 0x20, 0xb2, 0xc8,
+	0x14, 0x4e, 0x97, 0x4b, 0xa4, 0xda, 0xf5, 0xcc, 0x4f, 0x99, 0x7f, 0xca, 0x13, 0xd9, 0x21, 0x68,
+	0x16, 0x8e, 0xc6, 0x22, 0xdd, 0x9a, 0x31, 0xc2, 0x69, 0x98, 0xe4, 0xbb, 0x9f, 0x3b, 0x7a, 0x83,
+	0x36, 0x3e, 0x21, 0xef, 0x93, 0x91, 0x68, 0xe7, 0x22, 0xdd, 0xae, 0xf3, 0x73, 0xb2, 0xe5, 0xfd,
+	0x43, 0xb6, 0x57, 0xcf, 0x76, 0x33, 0xf4, 0x4c, 0x3f, 0xf5, 0x9b, 0xb3, 0xe0, 0x04, 0x95, 0x95,
+	0xbd, 0xf6, 0xe5, 0x09, 0xb0, 0x34, 0x61, 0x65, 0xc3, 0x9b, 0x56, 0x83, 0x5c, 0xff, 0xe5, 0x7a,
+	0x98, 0xd0, 0x28, 0xbf, 0x39, 0xfe, 0xbb, 0x35, 0xca, 0xe7, 0xaf, 0x95, 0xfc, 0x55, 0x87, 0x2f,
+	0x6a, 0x1d, 0x66, 0xdf, 0xc4, 0xaf, 0xa7, 0xc1, 0xec, 0x16, 0xa3, 0xd1, 0xbe, 0xc7, 0xfb, 0xf0,
+	0x36, 0x38, 0xed, 0x25, 0xa2, 0x47, 0x22, 0x11, 0x20, 0xb9, 0x18, 0x64, 0x75, 0xcc, 0x6d, 0xfe,
+	0xeb, 0xa7, 0x47, 0x17, 0xd7, 0x26, 0xfd, 0x98, 0x76, 0xb6, 0x68, 0x84, 0x03, 0x99, 0x00, 0x63,
+	0x74, 0x5a, 0x11, 0x69, 0x26, 0x84, 0x17, 0x86, 0x47, 0xf2, 0xa9, 0x6f, 0xe9, 0x8a, 0x48, 0x03,
+	0xbf, 0x9f, 0x5a, 0x75, 0x45, 0xf8, 0x74, 0x98, 0xdd, 0xc2, 0x1d, 0xb0, 0xa0, 0xb7, 0xd7, 0x85,
+	0xbf, 0x29, 0x23, 0xfb, 0xbf, 0x56, 0x76, 0x95, 0x3e, 0xb9, 0xfa, 0xc1, 0xb3, 0x81, 0xf1, 0xf8,
+	0x47, 0x8a, 0x3a, 0xad, 0x90, 0x51, 0x1c, 0xb0, 0x62, 0xec, 0x84, 0xc7, 0xfb, 0xf5, 0x23, 0x6b,
+	0xdb, 0xb8, 0x23, 0x75, 0x26, 0xf3, 0x1c, 0xc2, 0x15, 0x0e, 0x1d, 0xba, 0xcd, 0xfa, 0x83, 0xc7,
+	0xcd, 0xda, 0xc3, 0xc7, 0xcd, 0xda, 0x0f, 0x8f, 0x9b, 0xb5, 0x2f, 0x9e, 0x34, 0xa7, 0x1e, 0x3e,
+	0x69, 0x4e, 0x7d, 0xff, 0xa4, 0x39, 0x75, 0x78, 0x42, 0xfe, 0x69, 0xbf, 0xf2, 0xf3, 0x00, 0x86,
+	0x0c, 0x2d, 0xf5, 0xde, 0x18, 0x00, 0x00,
+}
+
+func (m *Tx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Tx) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Fees != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Fees.Size()))
+		n1, err := m.Fees.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if len(m.Signatures) > 0 {
+		for _, msg := range m.Signatures {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Multisig) > 0 {
+		for _, b := range m.Multisig {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.Sum != nil {
+		nn2, err := m.Sum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn2
+	}
+	return i, nil
+}
+
+func (m *Tx_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashSendMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
+		n3, err := m.CashSendMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+func (m *Tx_MultisigCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MultisigCreateMsg != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigCreateMsg.Size()))
+		n4, err := m.MultisigCreateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	return i, nil
+}
+func (m *Tx_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MultisigUpdateMsg != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
+		n5, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	return i, nil
+}
+func (m *Tx_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ValidatorsApplyDiffMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
+		n6, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	return i, nil
+}
+func (m *Tx_ExecuteBatchMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ExecuteBatchMsg != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ExecuteBatchMsg.Size()))
+		n7, err := m.ExecuteBatchMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	return i, nil
+}
+func (m *Tx_MigrationUpgradeSchemaMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MigrationUpgradeSchemaMsg != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MigrationUpgradeSchemaMsg.Size()))
+		n8, err := m.MigrationUpgradeSchemaMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	return i, nil
+}
+func (m *Tx_GovCreateProposalMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovCreateProposalMsg != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovCreateProposalMsg.Size()))
+		n9, err := m.GovCreateProposalMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	return i, nil
+}
+func (m *Tx_GovDeleteProposalMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovDeleteProposalMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovDeleteProposalMsg.Size()))
+		n10, err := m.GovDeleteProposalMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	return i, nil
+}
+func (m *Tx_GovVoteMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovVoteMsg != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovVoteMsg.Size()))
+		n11, err := m.GovVoteMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	return i, nil
+}
+func (m *Tx_GovUpdateElectorateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovUpdateElectorateMsg != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectorateMsg.Size()))
+		n12, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	return i, nil
+}
+func (m *Tx_GovUpdateElectionRuleMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovUpdateElectionRuleMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectionRuleMsg.Size()))
+		n13, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	return i, nil
+}
+func (m *Tx_CdCreateUserMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdCreateUserMsg != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdCreateUserMsg.Size()))
+		n14, err := m.CdCreateUserMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	return i, nil
+}
+func (m *Tx_CdCreateCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdCreateCountdownMsg != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdCreateCountdownMsg.Size()))
+		n15, err := m.CdCreateCountdownMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	return i, nil
+}
+func (m *Tx_CdDeleteCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdDeleteCountdownMsg != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdDeleteCountdownMsg.Size()))
+		n16, err := m.CdDeleteCountdownMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	return i, nil
+}
+func (m *Tx_CdGrantRoleMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdGrantRoleMsg != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdGrantRoleMsg.Size()))
+		n17, err := m.CdGrantRoleMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	return i, nil
+}
+func (m *Tx_CdRevokeRoleMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdRevokeRoleMsg != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdRevokeRoleMsg.Size()))
+		n18, err := m.CdRevokeRoleMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	return i, nil
+}
+func (m *Tx_CdUpdateLyricsMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdUpdateLyricsMsg != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdUpdateLyricsMsg.Size()))
+		n19, err := m.CdUpdateLyricsMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	return i, nil
+}
+func (m *Tx_CdPauseCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdPauseCountdownMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdPauseCountdownMsg.Size()))
+		n20, err := m.CdPauseCountdownMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	return i, nil
+}
+func (m *Tx_CdResumeCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdResumeCountdownMsg != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdResumeCountdownMsg.Size()))
+		n21, err := m.CdResumeCountdownMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	return i, nil
+}
+func (m *Tx_CdTransferCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdTransferCountdownMsg != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdTransferCountdownMsg.Size()))
+		n22, err := m.CdTransferCountdownMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	return i, nil
+}
+func (m *Tx_CdAcceptCountdownTransferMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdAcceptCountdownTransferMsg != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdAcceptCountdownTransferMsg.Size()))
+		n23, err := m.CdAcceptCountdownTransferMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	return i, nil
+}
+func (m *Tx_CdTipCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdTipCountdownMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdTipCountdownMsg.Size()))
+		n24, err := m.CdTipCountdownMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	return i, nil
+}
+func (m *Tx_CdSetUnlockPriceMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdSetUnlockPriceMsg != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdSetUnlockPriceMsg.Size()))
+		n25, err := m.CdSetUnlockPriceMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
+	return i, nil
+}
+func (m *Tx_CdUnlockNextLineMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdUnlockNextLineMsg != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdUnlockNextLineMsg.Size()))
+		n26, err := m.CdUnlockNextLineMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
+	}
+	return i, nil
+}
+func (m *Tx_CdDeleteUserMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdDeleteUserMsg != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdDeleteUserMsg.Size()))
+		n27, err := m.CdDeleteUserMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
+	}
+	return i, nil
+}
+func (m *Tx_CdUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdUpdateConfigurationMsg != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdUpdateConfigurationMsg.Size()))
+		n28, err := m.CdUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	return i, nil
+}
+func (m *Tx_CdFlagCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdFlagCountdownMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdFlagCountdownMsg.Size()))
+		n29, err := m.CdFlagCountdownMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
+	return i, nil
+}
+func (m *Tx_CdModerateCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdModerateCountdownMsg != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdModerateCountdownMsg.Size()))
+		n30, err := m.CdModerateCountdownMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
+	}
+	return i, nil
+}
+func (m *Tx_CdCreateCountdownDraftMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdCreateCountdownDraftMsg != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdCreateCountdownDraftMsg.Size()))
+		n31, err := m.CdCreateCountdownDraftMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
+	}
+	return i, nil
+}
+func (m *Tx_CdAppendLyricsChunkMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdAppendLyricsChunkMsg != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdAppendLyricsChunkMsg.Size()))
+		n32, err := m.CdAppendLyricsChunkMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
+	}
+	return i, nil
+}
+func (m *Tx_CdPublishCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdPublishCountdownMsg != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdPublishCountdownMsg.Size()))
+		n33, err := m.CdPublishCountdownMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
+	}
+	return i, nil
+}
+func (m *Tx_CdMigrateCountdownsMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdMigrateCountdownsMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdMigrateCountdownsMsg.Size()))
+		n34, err := m.CdMigrateCountdownsMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
+	}
+	return i, nil
+}
+func (m *Tx_CdCreateSeriesMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdCreateSeriesMsg != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdCreateSeriesMsg.Size()))
+		n35, err := m.CdCreateSeriesMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
+	}
+	return i, nil
+}
+func (m *Tx_CdAddSeriesCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdAddSeriesCountdownMsg != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdAddSeriesCountdownMsg.Size()))
+		n36, err := m.CdAddSeriesCountdownMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
+	}
+	return i, nil
+}
+func (m *Tx_CdRemoveSeriesCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdRemoveSeriesCountdownMsg != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdRemoveSeriesCountdownMsg.Size()))
+		n37, err := m.CdRemoveSeriesCountdownMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n37
+	}
+	return i, nil
+}
+func (m *Tx_CdReorderSeriesMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdReorderSeriesMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdReorderSeriesMsg.Size()))
+		n38, err := m.CdReorderSeriesMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n38
+	}
+	return i, nil
+}
+func (m *Tx_CdSetSuccessorMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdSetSuccessorMsg != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdSetSuccessorMsg.Size()))
+		n39, err := m.CdSetSuccessorMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
+	}
+	return i, nil
+}
+func (m *Tx_CdRestartCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdRestartCountdownMsg != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdRestartCountdownMsg.Size()))
+		n40, err := m.CdRestartCountdownMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
+	}
+	return i, nil
+}
+func (m *Tx_CdForkCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdForkCountdownMsg != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdForkCountdownMsg.Size()))
+		n41, err := m.CdForkCountdownMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n41
+	}
+	return i, nil
+}
+func (m *Tx_CdDeleteCountdownDraftMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdDeleteCountdownDraftMsg != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdDeleteCountdownDraftMsg.Size()))
+		n42, err := m.CdDeleteCountdownDraftMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n42
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteBatchMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, msg := range m.Messages {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ExecuteBatchMsg_Union) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteBatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		nn43, err := m.Sum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn43
+	}
+	return i, nil
+}
+
+func (m *ExecuteBatchMsg_Union_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashSendMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
+		n44, err := m.CashSendMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n44
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_MultisigCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MultisigCreateMsg != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigCreateMsg.Size()))
+		n45, err := m.MultisigCreateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n45
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MultisigUpdateMsg != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
+		n46, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n46
+	}
+	return i, nil
+}
+func (m *ProposalOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalOptions) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Option != nil {
+		nn47, err := m.Option.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn47
+	}
+	return i, nil
+}
+
+func (m *ProposalOptions_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashSendMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
+		n48, err := m.CashSendMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n48
+	}
+	return i, nil
+}
+func (m *ProposalOptions_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MultisigUpdateMsg != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
+		n49, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n49
+	}
+	return i, nil
+}
+func (m *ProposalOptions_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ValidatorsApplyDiffMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
+		n50, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n50
+	}
+	return i, nil
+}
+func (m *ProposalOptions_ExecuteProposalBatchMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ExecuteProposalBatchMsg != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ExecuteProposalBatchMsg.Size()))
+		n51, err := m.ExecuteProposalBatchMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n51
+	}
+	return i, nil
+}
+func (m *ProposalOptions_MigrationUpgradeSchemaMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MigrationUpgradeSchemaMsg != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MigrationUpgradeSchemaMsg.Size()))
+		n52, err := m.MigrationUpgradeSchemaMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n52
+	}
+	return i, nil
+}
+func (m *ProposalOptions_GovUpdateElectorateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovUpdateElectorateMsg != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectorateMsg.Size()))
+		n53, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n53
+	}
+	return i, nil
+}
+func (m *ProposalOptions_GovUpdateElectionRuleMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovUpdateElectionRuleMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectionRuleMsg.Size()))
+		n54, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n54
+	}
+	return i, nil
+}
+func (m *ProposalOptions_GovCreateTextResolutionMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovCreateTextResolutionMsg != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovCreateTextResolutionMsg.Size()))
+		n55, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n55
+	}
+	return i, nil
+}
+func (m *ProposalOptions_CdUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdUpdateConfigurationMsg != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdUpdateConfigurationMsg.Size()))
+		n56, err := m.CdUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n56
+	}
+	return i, nil
+}
+func (m *ProposalOptions_CdModerateCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdModerateCountdownMsg != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdModerateCountdownMsg.Size()))
+		n57, err := m.CdModerateCountdownMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n57
+	}
+	return i, nil
+}
+func (m *ProposalOptions_CdMigrateCountdownsMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdMigrateCountdownsMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdMigrateCountdownsMsg.Size()))
+		n58, err := m.CdMigrateCountdownsMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n58
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteProposalBatchMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, msg := range m.Messages {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		nn59, err := m.Sum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn59
+	}
+	return i, nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashSendMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
+		n60, err := m.CashSendMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n60
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MultisigUpdateMsg != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
+		n61, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n61
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ValidatorsApplyDiffMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
+		n62, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n62
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovUpdateElectorateMsg != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectorateMsg.Size()))
+		n63, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n63
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovUpdateElectionRuleMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectionRuleMsg.Size()))
+		n64, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n64
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovCreateTextResolutionMsg != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovCreateTextResolutionMsg.Size()))
+		n65, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n65
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_CdUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdUpdateConfigurationMsg != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdUpdateConfigurationMsg.Size()))
+		n66, err := m.CdUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n66
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_CdModerateCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdModerateCountdownMsg != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdModerateCountdownMsg.Size()))
+		n67, err := m.CdModerateCountdownMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n67
+	}
+	return i, nil
+}
+func (m *CronTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronTask) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authenticators) > 0 {
+		for _, b := range m.Authenticators {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.Sum != nil {
+		nn68, err := m.Sum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn68
+	}
+	return i, nil
+}
+
+func (m *CronTask_GovTallyMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovTallyMsg != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovTallyMsg.Size()))
+		n69, err := m.GovTallyMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n69
+	}
+	return i, nil
+}
+func (m *CronTask_CdAddLyricsMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdAddLyricsMsg != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdAddLyricsMsg.Size()))
+		n70, err := m.CdAddLyricsMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n70
+	}
+	return i, nil
+}
+func (m *CronTask_CdExpireCountdownTask) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CdExpireCountdownTask != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CdExpireCountdownTask.Size()))
+		n71, err := m.CdExpireCountdownTask.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n71
+	}
+	return i, nil
+}
+func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Tx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fees != nil {
+		l = m.Fees.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if len(m.Multisig) > 0 {
+		for _, b := range m.Multisig {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *Tx_CashSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashSendMsg != nil {
+		l = m.CashSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MultisigCreateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigCreateMsg != nil {
+		l = m.MultisigCreateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MultisigUpdateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigUpdateMsg != nil {
+		l = m.MultisigUpdateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_ValidatorsApplyDiffMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorsApplyDiffMsg != nil {
+		l = m.ValidatorsApplyDiffMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_ExecuteBatchMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExecuteBatchMsg != nil {
+		l = m.ExecuteBatchMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MigrationUpgradeSchemaMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MigrationUpgradeSchemaMsg != nil {
+		l = m.MigrationUpgradeSchemaMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_GovCreateProposalMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovCreateProposalMsg != nil {
+		l = m.GovCreateProposalMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_GovDeleteProposalMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovDeleteProposalMsg != nil {
+		l = m.GovDeleteProposalMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_GovVoteMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovVoteMsg != nil {
+		l = m.GovVoteMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_GovUpdateElectorateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovUpdateElectorateMsg != nil {
+		l = m.GovUpdateElectorateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_GovUpdateElectionRuleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovUpdateElectionRuleMsg != nil {
+		l = m.GovUpdateElectionRuleMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdCreateUserMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdCreateUserMsg != nil {
+		l = m.CdCreateUserMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdCreateCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdCreateCountdownMsg != nil {
+		l = m.CdCreateCountdownMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdDeleteCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdDeleteCountdownMsg != nil {
+		l = m.CdDeleteCountdownMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdGrantRoleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdGrantRoleMsg != nil {
+		l = m.CdGrantRoleMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdRevokeRoleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdRevokeRoleMsg != nil {
+		l = m.CdRevokeRoleMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdUpdateLyricsMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdUpdateLyricsMsg != nil {
+		l = m.CdUpdateLyricsMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdPauseCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdPauseCountdownMsg != nil {
+		l = m.CdPauseCountdownMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdResumeCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdResumeCountdownMsg != nil {
+		l = m.CdResumeCountdownMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdTransferCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdTransferCountdownMsg != nil {
+		l = m.CdTransferCountdownMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdAcceptCountdownTransferMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdAcceptCountdownTransferMsg != nil {
+		l = m.CdAcceptCountdownTransferMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdTipCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdTipCountdownMsg != nil {
+		l = m.CdTipCountdownMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdSetUnlockPriceMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdSetUnlockPriceMsg != nil {
+		l = m.CdSetUnlockPriceMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdUnlockNextLineMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdUnlockNextLineMsg != nil {
+		l = m.CdUnlockNextLineMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdDeleteUserMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdDeleteUserMsg != nil {
+		l = m.CdDeleteUserMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdUpdateConfigurationMsg != nil {
+		l = m.CdUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdFlagCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdFlagCountdownMsg != nil {
+		l = m.CdFlagCountdownMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdModerateCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdModerateCountdownMsg != nil {
+		l = m.CdModerateCountdownMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdCreateCountdownDraftMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdCreateCountdownDraftMsg != nil {
+		l = m.CdCreateCountdownDraftMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdAppendLyricsChunkMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdAppendLyricsChunkMsg != nil {
+		l = m.CdAppendLyricsChunkMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdPublishCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdPublishCountdownMsg != nil {
+		l = m.CdPublishCountdownMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdMigrateCountdownsMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdMigrateCountdownsMsg != nil {
+		l = m.CdMigrateCountdownsMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdCreateSeriesMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdCreateSeriesMsg != nil {
+		l = m.CdCreateSeriesMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdAddSeriesCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdAddSeriesCountdownMsg != nil {
+		l = m.CdAddSeriesCountdownMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdRemoveSeriesCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdRemoveSeriesCountdownMsg != nil {
+		l = m.CdRemoveSeriesCountdownMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdReorderSeriesMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdReorderSeriesMsg != nil {
+		l = m.CdReorderSeriesMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdSetSuccessorMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdSetSuccessorMsg != nil {
+		l = m.CdSetSuccessorMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdRestartCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdRestartCountdownMsg != nil {
+		l = m.CdRestartCountdownMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdForkCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdForkCountdownMsg != nil {
+		l = m.CdForkCountdownMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CdDeleteCountdownDraftMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdDeleteCountdownDraftMsg != nil {
+		l = m.CdDeleteCountdownDraftMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExecuteBatchMsg_Union) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *ExecuteBatchMsg_Union_CashSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashSendMsg != nil {
+		l = m.CashSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_MultisigCreateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigCreateMsg != nil {
+		l = m.MultisigCreateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_MultisigUpdateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigUpdateMsg != nil {
+		l = m.MultisigUpdateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != nil {
+		n += m.Option.Size()
+	}
+	return n
+}
+
+func (m *ProposalOptions_CashSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashSendMsg != nil {
+		l = m.CashSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_MultisigUpdateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigUpdateMsg != nil {
+		l = m.MultisigUpdateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_ValidatorsApplyDiffMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorsApplyDiffMsg != nil {
+		l = m.ValidatorsApplyDiffMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_ExecuteProposalBatchMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExecuteProposalBatchMsg != nil {
+		l = m.ExecuteProposalBatchMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_MigrationUpgradeSchemaMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MigrationUpgradeSchemaMsg != nil {
+		l = m.MigrationUpgradeSchemaMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_GovUpdateElectorateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovUpdateElectorateMsg != nil {
+		l = m.GovUpdateElectorateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_GovUpdateElectionRuleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovUpdateElectionRuleMsg != nil {
+		l = m.GovUpdateElectionRuleMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_GovCreateTextResolutionMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovCreateTextResolutionMsg != nil {
+		l = m.GovCreateTextResolutionMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_CdUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdUpdateConfigurationMsg != nil {
+		l = m.CdUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_CdModerateCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdModerateCountdownMsg != nil {
+		l = m.CdModerateCountdownMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_CdMigrateCountdownsMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdMigrateCountdownsMsg != nil {
+		l = m.CdMigrateCountdownsMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExecuteProposalBatchMsg_Union) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *ExecuteProposalBatchMsg_Union_CashSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashSendMsg != nil {
+		l = m.CashSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_MultisigUpdateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigUpdateMsg != nil {
+		l = m.MultisigUpdateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorsApplyDiffMsg != nil {
+		l = m.ValidatorsApplyDiffMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovUpdateElectorateMsg != nil {
+		l = m.GovUpdateElectorateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovUpdateElectionRuleMsg != nil {
+		l = m.GovUpdateElectionRuleMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovCreateTextResolutionMsg != nil {
+		l = m.GovCreateTextResolutionMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_CdUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdUpdateConfigurationMsg != nil {
+		l = m.CdUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_CdModerateCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdModerateCountdownMsg != nil {
+		l = m.CdModerateCountdownMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *CronTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Authenticators) > 0 {
+		for _, b := range m.Authenticators {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *CronTask_GovTallyMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovTallyMsg != nil {
+		l = m.GovTallyMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *CronTask_CdAddLyricsMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdAddLyricsMsg != nil {
+		l = m.CdAddLyricsMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *CronTask_CdExpireCountdownTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdExpireCountdownTask != nil {
+		l = m.CdExpireCountdownTask.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func sovCodec(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
 }
 func sozCodec(x uint64) (n int) {
 	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Tx) Unmarshal(dAtA []byte) error {
+func (m *Tx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fees == nil {
+				m.Fees = &cash.FeeInfo{}
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, &sigs.StdSignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multisig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Multisig = append(m.Multisig, make([]byte, postIndex-iNdEx))
+			copy(m.Multisig[len(m.Multisig)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashSendMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.SendMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CashSendMsg{v}
+			iNdEx = postIndex
+		case 56:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &multisig.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MultisigCreateMsg{v}
+			iNdEx = postIndex
+		case 57:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &multisig.UpdateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MultisigUpdateMsg{v}
+			iNdEx = postIndex
+		case 58:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsApplyDiffMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &validators.ApplyDiffMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_ValidatorsApplyDiffMsg{v}
+			iNdEx = postIndex
+		case 60:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteBatchMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ExecuteBatchMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_ExecuteBatchMsg{v}
+			iNdEx = postIndex
+		case 69:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationUpgradeSchemaMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &migration.UpgradeSchemaMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MigrationUpgradeSchemaMsg{v}
+			iNdEx = postIndex
+		case 73:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovCreateProposalMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.CreateProposalMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_GovCreateProposalMsg{v}
+			iNdEx = postIndex
+		case 74:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovDeleteProposalMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.DeleteProposalMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_GovDeleteProposalMsg{v}
+			iNdEx = postIndex
+		case 75:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovVoteMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.VoteMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_GovVoteMsg{v}
+			iNdEx = postIndex
+		case 77:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectorateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.UpdateElectorateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_GovUpdateElectorateMsg{v}
+			iNdEx = postIndex
+		case 78:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectionRuleMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.UpdateElectionRuleMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_GovUpdateElectionRuleMsg{v}
+			iNdEx = postIndex
+		case 100:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdCreateUserMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.CreateUserMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdCreateUserMsg{v}
+			iNdEx = postIndex
+		case 101:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdCreateCountdownMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.CreateCountdownMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdCreateCountdownMsg{v}
+			iNdEx = postIndex
+		case 102:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdDeleteCountdownMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.DeleteCountdownMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdDeleteCountdownMsg{v}
+			iNdEx = postIndex
+		case 103:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdGrantRoleMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.GrantRoleMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdGrantRoleMsg{v}
+			iNdEx = postIndex
+		case 104:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdRevokeRoleMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.RevokeRoleMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdRevokeRoleMsg{v}
+			iNdEx = postIndex
+		case 105:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdUpdateLyricsMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.UpdateLyricsMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdUpdateLyricsMsg{v}
+			iNdEx = postIndex
+		case 106:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdPauseCountdownMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.PauseCountdownMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdPauseCountdownMsg{v}
+			iNdEx = postIndex
+		case 107:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdResumeCountdownMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.ResumeCountdownMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdResumeCountdownMsg{v}
+			iNdEx = postIndex
+		case 108:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdTransferCountdownMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.TransferCountdownMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdTransferCountdownMsg{v}
+			iNdEx = postIndex
+		case 109:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdAcceptCountdownTransferMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.AcceptCountdownTransferMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdAcceptCountdownTransferMsg{v}
+			iNdEx = postIndex
+		case 110:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdTipCountdownMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.TipCountdownMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdTipCountdownMsg{v}
+			iNdEx = postIndex
+		case 111:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdSetUnlockPriceMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.SetUnlockPriceMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdSetUnlockPriceMsg{v}
+			iNdEx = postIndex
+		case 112:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdUnlockNextLineMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.UnlockNextLineMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdUnlockNextLineMsg{v}
+			iNdEx = postIndex
+		case 113:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdDeleteUserMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.DeleteUserMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdDeleteUserMsg{v}
+			iNdEx = postIndex
+		case 114:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdUpdateConfigurationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.UpdateConfigurationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdUpdateConfigurationMsg{v}
+			iNdEx = postIndex
+		case 115:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdFlagCountdownMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.FlagCountdownMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdFlagCountdownMsg{v}
+			iNdEx = postIndex
+		case 116:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdModerateCountdownMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.ModerateCountdownMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdModerateCountdownMsg{v}
+			iNdEx = postIndex
+		case 117:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdCreateCountdownDraftMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.CreateCountdownDraftMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdCreateCountdownDraftMsg{v}
+			iNdEx = postIndex
+		case 118:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdAppendLyricsChunkMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.AppendLyricsChunkMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdAppendLyricsChunkMsg{v}
+			iNdEx = postIndex
+		case 119:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdPublishCountdownMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.PublishCountdownMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdPublishCountdownMsg{v}
+			iNdEx = postIndex
+		case 122:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdMigrateCountdownsMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.MigrateCountdownsMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdMigrateCountdownsMsg{v}
+			iNdEx = postIndex
+		case 123:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdCreateSeriesMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.CreateSeriesMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdCreateSeriesMsg{v}
+			iNdEx = postIndex
+		case 124:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdAddSeriesCountdownMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.AddSeriesCountdownMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdAddSeriesCountdownMsg{v}
+			iNdEx = postIndex
+		case 125:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdRemoveSeriesCountdownMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.RemoveSeriesCountdownMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdRemoveSeriesCountdownMsg{v}
+			iNdEx = postIndex
+		case 126:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdReorderSeriesMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.ReorderSeriesMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdReorderSeriesMsg{v}
+			iNdEx = postIndex
+		case 127:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdSetSuccessorMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.SetSuccessorMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdSetSuccessorMsg{v}
+			iNdEx = postIndex
+		case 128:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdRestartCountdownMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.RestartCountdownMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdRestartCountdownMsg{v}
+			iNdEx = postIndex
+		case 129:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdForkCountdownMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.ForkCountdownMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdForkCountdownMsg{v}
+			iNdEx = postIndex
+		case 130:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdDeleteCountdownDraftMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.DeleteCountdownDraftMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CdDeleteCountdownDraftMsg{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecuteBatchMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1489,15 +6525,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Tx: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecuteBatchMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecuteBatchMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1524,16 +6560,67 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Fees == nil {
-				m.Fees = &cash.FeeInfo{}
-			}
-			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Messages = append(m.Messages, ExecuteBatchMsg_Union{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Union: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Union: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 51:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashSendMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1560,16 +6647,17 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signatures = append(m.Signatures, &sigs.StdSignature{})
-			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &cash.SendMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Sum = &ExecuteBatchMsg_Union_CashSendMsg{v}
 			iNdEx = postIndex
-		case 4:
+		case 56:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Multisig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigCreateMsg", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1579,27 +6667,30 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Multisig = append(m.Multisig, make([]byte, postIndex-iNdEx))
-			copy(m.Multisig[len(m.Multisig)-1], dAtA[iNdEx:postIndex])
+			v := &multisig.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_MultisigCreateMsg{v}
 			iNdEx = postIndex
-		case 51:
+		case 57:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CashSendMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1626,15 +6717,68 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &cash.SendMsg{}
+			v := &multisig.UpdateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_CashSendMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_MultisigUpdateMsg{v}
 			iNdEx = postIndex
-		case 56:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 51:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashSendMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1661,11 +6805,11 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &multisig.CreateMsg{}
+			v := &cash.SendMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_MultisigCreateMsg{v}
+			m.Option = &ProposalOptions_CashSendMsg{v}
 			iNdEx = postIndex
 		case 57:
 			if wireType != 2 {
@@ -1700,7 +6844,7 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_MultisigUpdateMsg{v}
+			m.Option = &ProposalOptions_MultisigUpdateMsg{v}
 			iNdEx = postIndex
 		case 58:
 			if wireType != 2 {
@@ -1735,11 +6879,116 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_ValidatorsApplyDiffMsg{v}
+			m.Option = &ProposalOptions_ValidatorsApplyDiffMsg{v}
 			iNdEx = postIndex
 		case 60:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteBatchMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteProposalBatchMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ExecuteProposalBatchMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Option = &ProposalOptions_ExecuteProposalBatchMsg{v}
+			iNdEx = postIndex
+		case 69:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationUpgradeSchemaMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &migration.UpgradeSchemaMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Option = &ProposalOptions_MigrationUpgradeSchemaMsg{v}
+			iNdEx = postIndex
+		case 77:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectorateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.UpdateElectorateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Option = &ProposalOptions_GovUpdateElectorateMsg{v}
+			iNdEx = postIndex
+		case 78:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectionRuleMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1766,15 +7015,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ExecuteBatchMsg{}
+			v := &gov.UpdateElectionRuleMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_ExecuteBatchMsg{v}
+			m.Option = &ProposalOptions_GovUpdateElectionRuleMsg{v}
 			iNdEx = postIndex
-		case 69:
+		case 79:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MigrationUpgradeSchemaMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovCreateTextResolutionMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1801,15 +7050,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &migration.UpgradeSchemaMsg{}
+			v := &gov.CreateTextResolutionMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_MigrationUpgradeSchemaMsg{v}
+			m.Option = &ProposalOptions_GovCreateTextResolutionMsg{v}
 			iNdEx = postIndex
-		case 100:
+		case 114:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CdCreateUserMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CdUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1836,15 +7085,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &countdown.CreateUserMsg{}
+			v := &countdown.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_CdCreateUserMsg{v}
+			m.Option = &ProposalOptions_CdUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 101:
+		case 116:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CdCreateCountdownMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CdModerateCountdownMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1871,15 +7120,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &countdown.CreateCountdownMsg{}
+			v := &countdown.ModerateCountdownMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_CdCreateCountdownMsg{v}
+			m.Option = &ProposalOptions_CdModerateCountdownMsg{v}
 			iNdEx = postIndex
-		case 102:
+		case 122:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CdDeleteCountdownMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CdMigrateCountdownsMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1906,11 +7155,11 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &countdown.DeleteCountdownMsg{}
+			v := &countdown.MigrateCountdownsMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_CdDeleteCountdownMsg{v}
+			m.Option = &ProposalOptions_CdMigrateCountdownsMsg{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1936,7 +7185,7 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecuteBatchMsg) Unmarshal(dAtA []byte) error {
+func (m *ExecuteProposalBatchMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1959,10 +7208,10 @@ func (m *ExecuteBatchMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecuteBatchMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecuteProposalBatchMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecuteBatchMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecuteProposalBatchMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1994,7 +7243,7 @@ func (m *ExecuteBatchMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, ExecuteBatchMsg_Union{})
+			m.Messages = append(m.Messages, ExecuteProposalBatchMsg_Union{})
 			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -2023,7 +7272,7 @@ func (m *ExecuteBatchMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
+func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2085,11 +7334,11 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_CashSendMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_CashSendMsg{v}
 			iNdEx = postIndex
-		case 56:
+		case 57:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2116,15 +7365,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &multisig.CreateMsg{}
+			v := &multisig.UpdateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_MultisigCreateMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_MultisigUpdateMsg{v}
 			iNdEx = postIndex
-		case 57:
+		case 58:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsApplyDiffMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2151,11 +7400,186 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &multisig.UpdateMsg{}
+			v := &validators.ApplyDiffMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_MultisigUpdateMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg{v}
+			iNdEx = postIndex
+		case 77:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectorateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.UpdateElectorateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg{v}
+			iNdEx = postIndex
+		case 78:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectionRuleMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.UpdateElectionRuleMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg{v}
+			iNdEx = postIndex
+		case 79:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovCreateTextResolutionMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.CreateTextResolutionMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg{v}
+			iNdEx = postIndex
+		case 114:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdUpdateConfigurationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.UpdateConfigurationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteProposalBatchMsg_Union_CdUpdateConfigurationMsg{v}
+			iNdEx = postIndex
+		case 116:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdModerateCountdownMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.ModerateCountdownMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteProposalBatchMsg_Union_CdModerateCountdownMsg{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2242,6 +7666,41 @@ func (m *CronTask) Unmarshal(dAtA []byte) error {
 			m.Authenticators = append(m.Authenticators, make([]byte, postIndex-iNdEx))
 			copy(m.Authenticators[len(m.Authenticators)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 76:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovTallyMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.TallyMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &CronTask_GovTallyMsg{v}
+			iNdEx = postIndex
 		case 120:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CdAddLyricsMsg", wireType)
@@ -2277,6 +7736,41 @@ func (m *CronTask) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &CronTask_CdAddLyricsMsg{v}
 			iNdEx = postIndex
+		case 121:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdExpireCountdownTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &countdown.ExpireCountdownTask{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &CronTask_CdExpireCountdownTask{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
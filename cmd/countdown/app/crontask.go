@@ -4,6 +4,7 @@ import (
 	"github.com/ng2dev/countdown/x/countdown"
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/x/gov"
 )
 
 // CronTaskMarshaler is a task marshaler implementation to be used by the weave
@@ -24,9 +25,17 @@ func (taskMarshaler) MarshalTask(auth []weave.Condition, msg weave.Msg) ([]byte,
 	default:
 		return nil, errors.Wrapf(errors.ErrType, "unsupported message type: %T", msg)
 
+	case *gov.TallyMsg:
+		t.Sum = &CronTask_GovTallyMsg{
+			GovTallyMsg: msg,
+		}
 	case *countdown.CountdownTask:
 		t.Sum = &CronTask_CdAddLyricsMsg{
-			CountdownTask: msg,
+			CdAddLyricsMsg: msg,
+		}
+	case *countdown.ExpireCountdownTask:
+		t.Sum = &CronTask_CdExpireCountdownTask{
+			CdExpireCountdownTask: msg,
 		}
 	}
 
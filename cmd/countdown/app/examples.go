@@ -81,8 +81,9 @@ func Examples() []commands.Example {
 		Username: "enigma",
 	}
 	createCountdownMsg := &countdown.CreateCountdownMsg{
-		Metadata: &weave.Metadata{Schema: 1},
-		Title:    "final countdown",
+		Metadata:           &weave.Metadata{Schema: 1},
+		Title:              "final countdown",
+		MissedRevealPolicy: countdown.MissedRevealPolicy_CatchUp,
 	}
 
 	countdownID := weavetest.SequenceID(1)
@@ -2,10 +2,12 @@ package countdown
 
 import (
 	"context"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"time"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/app"
@@ -14,8 +16,11 @@ import (
 	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/gov"
+	"github.com/iov-one/weave/x/msgfee"
 	"github.com/iov-one/weave/x/multisig"
 	"github.com/iov-one/weave/x/validators"
+	"github.com/ng2dev/countdown/x/countdown"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 )
@@ -56,6 +61,11 @@ func GenInitOptions(args []string) (json.RawMessage, error) {
 	// stashed and then distributed to stakeholders
 	collectorAddr := cond1.Address()
 
+	// proposals accepted under the first election rule are executed on behalf
+	// of its address, so that governance can update the countdown
+	// configuration and moderate countdowns
+	governanceAddr := electionRuleAddress(1)
+
 	return json.Marshal(dict{
 		"cash": array{
 			dict{
@@ -77,6 +87,53 @@ func GenInitOptions(args []string) (json.RawMessage, error) {
 				// admin is who can change this redistribution address to other address
 				"admin": addr,
 			},
+			"countdown": dict{
+				"owner": governanceAddr,
+				// storage deposits are taken per stored byte
+				"user_deposit":       coin.Coin{Fractional: 1000, Ticker: ticker},
+				"countdown_deposit":  coin.Coin{Fractional: 1000, Ticker: ticker},
+				"new_user_cost":      1,
+				"new_countdown_cost": 10,
+				// first 1000 chars are free then pay 1 per mille
+				"countdown_cost_unit": 1000,
+				"min_line_length":     4,
+				"max_line_length":     1000,
+				"reveal_interval":     weave.AsUnixDuration(24 * time.Hour),
+				// moderators can hide and remove flagged countdowns
+				"moderators": array{addr, governanceAddr},
+				// an owner can have 10 running countdowns and create 5 a day
+				"max_active_countdowns":     10,
+				"max_countdowns_per_window": 5,
+				"creation_window":           weave.AsUnixDuration(24 * time.Hour),
+			},
+		},
+		// fees paid for each message of the given path, on top of the
+		// minimal fee
+		"msgfee": array{
+			dict{"msg_path": "countdown/create_countdown", "fee": coin.Coin{Whole: 1, Ticker: ticker}},
+			dict{"msg_path": "countdown/create_user", "fee": coin.Coin{Fractional: 100000000, Ticker: ticker}},
+		},
+		// a single stakeholder decides on proposals until the electorate
+		// is updated by a governance vote
+		"governance": dict{
+			"electorate": array{
+				dict{
+					"admin": addr,
+					"title": "Countdown stakeholders",
+					"electors": array{
+						dict{"address": addr, "weight": 1},
+					},
+				},
+			},
+			"rules": array{
+				dict{
+					"admin":         addr,
+					"electorate_id": 1,
+					"title":         "Simple majority",
+					"voting_period": weave.AsUnixDuration(24 * time.Hour),
+					"threshold":     gov.Fraction{Numerator: 1, Denominator: 2},
+				},
+			},
 		},
 		"initialize_schema": []dict{
 			{"pkg": "migration", "ver": 1},
@@ -85,10 +142,20 @@ func GenInitOptions(args []string) (json.RawMessage, error) {
 			{"pkg": "multisig", "ver": 1},
 			{"pkg": "utils", "ver": 1},
 			{"pkg": "validators", "ver": 1},
+			{"pkg": "msgfee", "ver": 1},
+			{"pkg": "gov", "ver": 1},
 		},
 	})
 }
 
+// electionRuleAddress returns the address authorizing the execution of the
+// proposals accepted under the election rule with the given sequence ID.
+func electionRuleAddress(seq uint64) weave.Address {
+	id := make([]byte, 8)
+	binary.BigEndian.PutUint64(id, seq)
+	return gov.ElectionCondition(id).Address()
+}
+
 // GenerateApp is used to create a stub for server/start.go command
 func GenerateApp(options *server.Options) (abci.Application, error) {
 	// db goes in a subdir, but "" -> "" for memdb
@@ -108,14 +175,23 @@ func GenerateApp(options *server.Options) (abci.Application, error) {
 
 // DecorateApp adds initializers and Logger to an Application
 func DecorateApp(application app.BaseApp, logger log.Logger) app.BaseApp {
-	application.WithInit(app.ChainInitializers(
+	application.WithInit(Initializers())
+	application.WithLogger(logger)
+	return application
+}
+
+// Initializers returns the initializers loading the genesis state of all
+// extensions
+func Initializers() weave.Initializer {
+	return app.ChainInitializers(
 		&migration.Initializer{},
 		&cash.Initializer{},
 		&multisig.Initializer{},
 		&validators.Initializer{},
-	))
-	application.WithLogger(logger)
-	return application
+		&msgfee.Initializer{},
+		&gov.Initializer{},
+		&countdown.Initializer{},
+	)
 }
 
 // InlineApp will take a previously prepared CommitStore and return a complete Application
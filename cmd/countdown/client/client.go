@@ -9,6 +9,7 @@ import (
 	"github.com/iov-one/weave/app"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/x/sigs"
+	"github.com/ng2dev/countdown/x/countdown"
 	cmn "github.com/tendermint/tendermint/libs/common"
 	tmpubsub "github.com/tendermint/tendermint/libs/pubsub"
 	"github.com/tendermint/tendermint/rpc/client"
@@ -419,3 +420,120 @@ func (cc *CountdownClient) NextNonce(addr weave.Address) (int64, error) {
 	// new account starts at 0
 	return 0, nil
 }
+
+// CountdownResponse is a response on a query for a Countdown
+type CountdownResponse struct {
+	ID        []byte
+	Countdown countdown.Countdown
+	Height    int64
+}
+
+// GetCountdown will return the countdown with the given ID.
+// Error codes are used when the query failed on the server
+func (cc *CountdownClient) GetCountdown(id []byte) (*CountdownResponse, error) {
+	resp, err := cc.AbciQuery("/countdowns", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Models) == 0 { // empty list or nil
+		return nil, errors.Wrap(errors.ErrNotFound, "model not found")
+	}
+	// assume only one result
+	model := resp.Models[0]
+	out := CountdownResponse{
+		ID:     countdownKeyToID(model.Key),
+		Height: resp.Height,
+	}
+
+	// parse the value as countdown bytes
+	err = out.Countdown.Unmarshal(model.Value)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// key is the id prefixed with "countdown:"
+func countdownKeyToID(key []byte) []byte {
+	return key[10:]
+}
+
+// ProgressResponse is a response on a query for the progress of a Countdown
+type ProgressResponse struct {
+	ID       []byte
+	Progress countdown.CountdownProgress
+	Height   int64
+}
+
+// GetCountdownProgress will return the progress of the countdown with the
+// given ID.
+// Error codes are used when the query failed on the server
+func (cc *CountdownClient) GetCountdownProgress(id []byte) (*ProgressResponse, error) {
+	res, err := cc.queryProgress("/countdownProgress", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, errors.Wrap(errors.ErrNotFound, "model not found")
+	}
+	// assume only one result
+	return &res[0], nil
+}
+
+// CountdownProgressByStatus returns the progress of all countdowns with the
+// given status.
+func (cc *CountdownClient) CountdownProgressByStatus(status countdown.CountdownStatus) ([]ProgressResponse, error) {
+	return cc.queryProgress("/countdownProgress/status", countdown.StatusQuery(status))
+}
+
+// queryProgress runs a progress query and parses all returned models.
+func (cc *CountdownClient) queryProgress(path string, data []byte) ([]ProgressResponse, error) {
+	resp, err := cc.AbciQuery(path, data)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]ProgressResponse, len(resp.Models))
+	for i, model := range resp.Models {
+		out[i].ID = countdownKeyToID(model.Key)
+		out[i].Height = resp.Height
+		if err := out[i].Progress.Unmarshal(model.Value); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// RevealedLine is a revealed line of the lyrics together with
+// the block it was revealed at
+type RevealedLine struct {
+	Line       string
+	Height     int64
+	RevealedAt weave.UnixTime
+}
+
+// RevealHistory returns the revealed lines of the countdown with the given
+// ID, in reveal order, together with the block height and time of their
+// reveal.
+func (cc *CountdownClient) RevealHistory(id []byte) ([]RevealedLine, error) {
+	resp, err := cc.GetCountdown(id)
+	if err != nil {
+		return nil, err
+	}
+	lines, err := resp.Countdown.Lines()
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]RevealedLine, 0, len(resp.Countdown.Reveals))
+	for _, r := range resp.Countdown.Reveals {
+		if int(r.Line) >= len(lines) {
+			return nil, errors.Wrapf(ErrInvalid, "reveal of line %d out of range", r.Line)
+		}
+		out = append(out, RevealedLine{
+			Line:       lines[r.Line],
+			Height:     r.Height,
+			RevealedAt: r.RevealedAt,
+		})
+	}
+	return out, nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/weavetest/assert"
 	"github.com/tendermint/tendermint/rpc/client"
 	rpctest "github.com/tendermint/tendermint/rpc/test"
@@ -69,6 +70,25 @@ func TestWalletQuery(t *testing.T) {
 	assert.Equal(t, initBalance.Ticker, coin.Ticker)
 }
 
+func TestCountdownQuery(t *testing.T) {
+	conn := NewLocalConnection(node)
+	countdown := NewClient(conn)
+	client.WaitForHeight(conn, 5, fastWaiter)
+
+	// missing countdown returns not found
+	cd, err := countdown.GetCountdown(weavetest.SequenceID(12345))
+	assert.IsErr(t, errors.ErrNotFound, err)
+	assert.Nil(t, cd)
+
+	history, err := countdown.RevealHistory(weavetest.SequenceID(12345))
+	assert.IsErr(t, errors.ErrNotFound, err)
+	assert.Nil(t, history)
+
+	progress, err := countdown.GetCountdownProgress(weavetest.SequenceID(12345))
+	assert.IsErr(t, errors.ErrNotFound, err)
+	assert.Nil(t, progress)
+}
+
 func TestNonce(t *testing.T) {
 	src := faucet.PublicKey().Address()
 	rcpt := GenPrivateKey().PublicKey().Address()
@@ -235,3 +255,57 @@ func TestSendMultipleTx(t *testing.T) {
 	assert.Equal(t, true, resp.Response.Height > prepH+1)
 	assert.Equal(t, true, resp2.Response.Height > prepH+1)
 }
+
+func TestMessageFee(t *testing.T) {
+	conn := NewLocalConnection(node)
+	countdown := NewClient(conn)
+
+	src := faucet.PublicKey().Address()
+	chainID := getChainID()
+
+	underpaid := createUserFee
+	underpaid.Whole--
+
+	cases := map[string]struct {
+		fee     *coin.Coin
+		wantErr bool
+	}{
+		"missing fee": {
+			fee:     nil,
+			wantErr: true,
+		},
+		"underpaid fee": {
+			fee:     &underpaid,
+			wantErr: true,
+		},
+		"exact fee": {
+			fee:     &createUserFee,
+			wantErr: false,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			tx := BuildCreateUserTx("feepayer")
+			if tc.fee != nil {
+				SetFee(tx, nil, *tc.fee)
+			}
+			n, err := countdown.NextNonce(src)
+			assert.Nil(t, err)
+			SignTx(tx, faucet, chainID, n)
+
+			res := countdown.BroadcastTxSync(tx, time.Minute)
+			if tc.wantErr != (res.IsError() != nil) {
+				t.Fatalf("unexpected broadcast error: %+v", res.IsError())
+			}
+
+			// a rejected transaction does not increment the nonce
+			n2, err := countdown.NextNonce(src)
+			assert.Nil(t, err)
+			if tc.wantErr {
+				assert.Equal(t, n, n2)
+			} else {
+				assert.Equal(t, n+1, n2)
+			}
+		})
+	}
+}
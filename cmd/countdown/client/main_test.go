@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"bitbucket.org/swoldt/pkg/xerrors/iferr"
 	countdown "github.com/ng2dev/countdown/cmd/countdown/app"
@@ -15,6 +16,7 @@ import (
 	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/x/cash"
+	xcountdown "github.com/ng2dev/countdown/x/countdown"
 	abci "github.com/tendermint/tendermint/abci/types"
 	cfg "github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/libs/log"
@@ -29,6 +31,12 @@ var initBalance = coin.Coin{
 	Ticker: "COUNTDOWN",
 }
 
+// createUserFee is the message fee of a user registration
+var createUserFee = coin.Coin{
+	Whole:  2,
+	Ticker: "COUNTDOWN",
+}
+
 // adjust this to get debug output
 var logger = log.NewNopLogger()
 
@@ -97,6 +105,17 @@ func initGenesis(filename string, addr weave.Address) error {
 			"migration": migration.Configuration{
 				Admin: weave.Condition("multisig/usage/0000000000000001").Address(),
 			},
+			"countdown": xcountdown.Configuration{
+				Owner: addr,
+				// no storage deposits
+				MinLineLength:  4,
+				MaxLineLength:  1000,
+				RevealInterval: weave.AsUnixDuration(24 * time.Hour),
+			},
+		},
+		// creating a user requires a message fee
+		"msgfee": []dict{
+			{"msg_path": "countdown/create_user", "fee": createUserFee},
 		},
 		"initialize_schema": []dict{
 			{"pkg": "migration", "ver": 1},
@@ -105,6 +124,8 @@ func initGenesis(filename string, addr weave.Address) error {
 			{"pkg": "multisig", "ver": 1},
 			{"pkg": "utils", "ver": 1},
 			{"pkg": "validators", "ver": 1},
+			{"pkg": "msgfee", "ver": 1},
+			{"pkg": "gov", "ver": 1},
 		},
 	})
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"github.com/iov-one/weave/x/cash"
 	"github.com/iov-one/weave/x/sigs"
 	"github.com/iov-one/weave/x/validators"
+	xcountdown "github.com/ng2dev/countdown/x/countdown"
 )
 
 // Tx is all the interfaces we need rolled into one
@@ -31,6 +32,28 @@ func BuildSendTx(source, destination weave.Address, amount coin.Coin, memo strin
 	}
 }
 
+// BuildCreateUserTx will create an unsigned tx to register a user owned by
+// the main signer
+func BuildCreateUserTx(username string) *countdown.Tx {
+	return &countdown.Tx{
+		Sum: &countdown.Tx_CdCreateUserMsg{
+			CdCreateUserMsg: &xcountdown.CreateUserMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Username: username,
+			},
+		},
+	}
+}
+
+// SetFee modifies the tx in-place, setting the fee paid by the payer. An
+// empty payer means the main signer pays the fee
+func SetFee(tx *countdown.Tx, payer weave.Address, fee coin.Coin) {
+	tx.Fees = &cash.FeeInfo{
+		Payer: payer,
+		Fees:  &fee,
+	}
+}
+
 // SignTx modifies the tx in-place, adding signatures
 func SignTx(tx *countdown.Tx, signer *crypto.PrivateKey, chainID string, nonce int64) error {
 	sig, err := sigs.SignTx(signer, tx, chainID, nonce)
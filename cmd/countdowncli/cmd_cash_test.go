@@ -18,6 +18,7 @@ import (
 	"github.com/iov-one/weave/weavetest/assert"
 	"github.com/iov-one/weave/x/cash"
 	"github.com/iov-one/weave/x/msgfee"
+	xcountdown "github.com/ng2dev/countdown/x/countdown"
 )
 
 func TestCmdSendTokensHappyPath(t *testing.T) {
@@ -169,6 +170,44 @@ func TestCmdWithFeeHappyPathDefaultAmount(t *testing.T) {
 	}
 }
 
+func TestCmdWithFeeCountdownMessageFee(t *testing.T) {
+	createTx := &countdown.Tx{
+		Sum: &countdown.Tx_CdCreateCountdownMsg{
+			CdCreateCountdownMsg: &xcountdown.CreateCountdownMsg{
+				Metadata:           &weave.Metadata{Schema: 1},
+				Title:              "final countdown",
+				Lyrics:             []byte(`["It's the final countdown"]`),
+				MissedRevealPolicy: xcountdown.MissedRevealPolicy_CatchUp,
+			},
+		},
+	}
+	var input bytes.Buffer
+	if _, err := writeTx(&input, createTx); err != nil {
+		t.Fatalf("cannot serialize transaction: %s", err)
+	}
+
+	conf := cash.Configuration{
+		Metadata:   &weave.Metadata{Schema: 1},
+		MinimalFee: coin.NewCoin(0, 1000, "COUNTDOWN"),
+	}
+	fees := map[string]coin.Coin{
+		"countdown/create_countdown": coin.NewCoin(1, 0, "COUNTDOWN"),
+	}
+	tm := newCashConfTendermintServer(t, conf, fees)
+	defer tm.Close()
+
+	var output bytes.Buffer
+	if err := cmdWithFee(&input, &output, []string{"-tm", tm.URL}); err != nil {
+		t.Fatalf("cannot attach a fee to transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+	assert.Equal(t, coin.NewCoinp(1, 0, "COUNTDOWN"), tx.Fees.Fees)
+}
+
 type abciQueryRequest struct {
 	Method string `json:"method"`
 	Params struct {
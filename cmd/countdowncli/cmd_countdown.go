@@ -0,0 +1,1091 @@
+package main
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/gconf"
+	countdown "github.com/ng2dev/countdown/cmd/countdown/app"
+	xcountdown "github.com/ng2dev/countdown/x/countdown"
+	"golang.org/x/text/unicode/norm"
+)
+
+func cmdCreateCountdown(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for creating a new countdown. Lyrics are read from the
+given text file, one line of the file per line of the lyrics.
+
+By default the countdown is owned by the main signer of the transaction. Use
+the owner flag to create a countdown owned by a different address, for example
+a multisig contract. Such transaction must be authorized by the owner, for
+example by attaching the multisig contract ID using the with-multisig command.
+
+A bounty can be locked during creation. It is paid to the beneficiary once the
+last line is revealed, or refunded to the sponsor if the countdown is deleted
+before.
+
+Creating a countdown takes a storage deposit from the owner, which is refunded
+when the countdown is deleted or expires.
+
+Lines can reference an image or an audio file with the attach flag, which can
+be repeated. Only the hash and the media type of the file are stored on chain,
+the file must be published separately.
+
+A repeating countdown starts over one reveal interval after it completes, until
+it repeated the given number of times.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		titleFl  = fl.String("title", "", "Title of the countdown.")
+		lyricsFl = fl.String("lyrics", "", "Path to a text file containing the lyrics.")
+		policyFl = fl.String("policy", "catch-up", "Policy for reveals missed during chain downtime. Either catch-up or shift.")
+		repeatFl = fl.String("repeat", "none", "How often the countdown starts over once completed. Either none, forever or a number of times.")
+		ownerFl  = flAddress(fl, "owner", "", "Optional address of the countdown owner. Defaults to the main signer.")
+		bountyFl = flCoin(fl, "bounty", "", "Optional bounty paid to the beneficiary once the countdown is completed.")
+		benefFl  = flAddress(fl, "beneficiary", "", "Address receiving the bounty. Required if a bounty is set.")
+		sponsFl  = flAddress(fl, "sponsor", "", "Optional address the bounty is taken from. Defaults to the main signer.")
+		deleteFl = flTime(fl, "delete-at", nil, "Optional expiration time of the countdown, in "+flagTimeFormat+" format.")
+		tagsFl   = flTags(fl, "tags", "Optional comma separated list of tags, for example \"synth pop,80s\".")
+		attachFl = flAttachments(fl, "attach", "Optional media file attached to a line, as LINE=PATH with a zero based line index. Can be repeated.")
+	)
+	fl.Parse(args)
+
+	var bounty []*coin.Coin
+	if !bountyFl.IsZero() {
+		if !bountyFl.IsPositive() {
+			flagDie("bounty must be positive")
+		}
+		if len(*benefFl) == 0 {
+			flagDie("beneficiary is required for a bounty")
+		}
+		bounty = []*coin.Coin{bountyFl}
+	}
+
+	policy, ok := missedRevealPolicies[*policyFl]
+	if !ok {
+		flagDie("unknown missed reveal policy %q", *policyFl)
+	}
+	repeat, repeatCount := repeatPolicy(*repeatFl)
+
+	lyrics, err := readLyrics(*lyricsFl)
+	if err != nil {
+		return fmt.Errorf("cannot read lyrics: %s", err)
+	}
+
+	attachments, err := readAttachments(*attachFl)
+	if err != nil {
+		return err
+	}
+
+	tx := &countdown.Tx{
+		Sum: &countdown.Tx_CdCreateCountdownMsg{
+			CdCreateCountdownMsg: &xcountdown.CreateCountdownMsg{
+				Metadata:           &weave.Metadata{Schema: 1},
+				Title:              norm.NFC.String(*titleFl),
+				Lyrics:             lyrics,
+				MissedRevealPolicy: policy,
+				Owner:              *ownerFl,
+				Bounty:             bounty,
+				Beneficiary:        *benefFl,
+				Sponsor:            *sponsFl,
+				DeleteAt:           expiration(deleteFl),
+				Attachments:        attachments,
+				RepeatPolicy:       repeat,
+				RepeatCount:        repeatCount,
+				Tags:               *tagsFl,
+			},
+		},
+	}
+	_, err = writeTx(output, tx)
+	return err
+}
+
+// readAttachments reads the attached media files and returns their
+// attachments, ordered by line. The media type is guessed from the file
+// extension, or from the content if the extension is not known.
+func readAttachments(files []attachmentFile) ([]*xcountdown.Attachment, error) {
+	var attachments []*xcountdown.Attachment
+	for _, f := range files {
+		content, err := ioutil.ReadFile(f.path)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read attachment: %s", err)
+		}
+		mimeType := mime.TypeByExtension(filepath.Ext(f.path))
+		if mimeType == "" {
+			mimeType = http.DetectContentType(content)
+		}
+		hash := sha256.Sum256(content)
+		attachments = append(attachments, &xcountdown.Attachment{
+			Line:     f.line,
+			Hash:     hash[:],
+			MimeType: mimeType,
+		})
+	}
+	sort.Slice(attachments, func(i, j int) bool {
+		return attachments[i].Line < attachments[j].Line
+	})
+	return attachments, nil
+}
+
+func cmdVerifyAttachment(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Verify that a media file downloaded from an off-chain source matches the
+attachment of a countdown line. The countdown is fetched from the node and the
+file content is compared with the attachment hash.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		tmAddrFl = fl.String("tm", env("COUNTDOWNCLI_TM_ADDR", "https://countdown.NETWORK.iov.one:443"),
+			"Tendermint node address. Use proper NETWORK name. You can use COUNTDOWNCLI_TM_ADDR environment variable to set it.")
+		idFl   = flSeq(fl, "id", "", "ID of the countdown.")
+		lineFl = fl.Int("line", 0, "Zero based index of the line the file is attached to.")
+		fileFl = fl.String("file", "", "Path to the media file.")
+	)
+	fl.Parse(args)
+
+	if len(*idFl) == 0 {
+		flagDie("countdown ID is required")
+	}
+	if *fileFl == "" {
+		flagDie("media file is required")
+	}
+
+	var cd xcountdown.Countdown
+	if err := xcountdown.NewCountdownBucket().One(tendermintStore(*tmAddrFl), *idFl, &cd); err != nil {
+		return fmt.Errorf("cannot fetch countdown: %s", err)
+	}
+	attachment := cd.LineAttachment(*lineFl)
+	if attachment == nil {
+		return fmt.Errorf("line %d has no attachment", *lineFl)
+	}
+
+	fd, err := os.Open(*fileFl)
+	if err != nil {
+		return fmt.Errorf("cannot open media file: %s", err)
+	}
+	defer fd.Close()
+	if err := attachment.Verify(fd); err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(output, "%s matches the %s attachment of line %d\n", *fileFl, attachment.MimeType, *lineFl)
+	return err
+}
+
+// expiration returns the expiration time set by the flag, zero if not set.
+func expiration(fl *flagTime) weave.UnixTime {
+	if fl.Time().IsZero() {
+		return 0
+	}
+	return fl.UnixTime()
+}
+
+// missedRevealPolicies maps command line names to missed reveal policies.
+var missedRevealPolicies = map[string]xcountdown.MissedRevealPolicy{
+	"catch-up": xcountdown.MissedRevealPolicy_CatchUp,
+	"shift":    xcountdown.MissedRevealPolicy_Shift,
+}
+
+// repeatPolicy parses the repeat flag value, either none, forever or the
+// number of times the countdown starts over.
+func repeatPolicy(s string) (xcountdown.RepeatPolicy, int32) {
+	switch s {
+	case "none":
+		return xcountdown.RepeatPolicy_None, 0
+	case "forever":
+		return xcountdown.RepeatPolicy_Forever, 0
+	}
+	n, err := strconv.ParseInt(s, 10, 32)
+	if err != nil || n <= 0 {
+		flagDie("invalid repeat %q, use none, forever or a positive number", s)
+	}
+	return xcountdown.RepeatPolicy_Times, int32(n)
+}
+
+// readLyrics reads a text file and returns its lines in the JSON encoded
+// format expected by the countdown messages.
+func readLyrics(path string) ([]byte, error) {
+	lines, err := readLyricsLines(path)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(lines)
+}
+
+// readLyricsLines reads a text file and returns its lines. Empty lines are
+// ignored and all lines are NFC normalized.
+func readLyricsLines(path string) ([]string, error) {
+	if path == "" {
+		return nil, errors.New("lyrics file is required")
+	}
+	fd, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+
+	var lines []string
+	sc := bufio.NewScanner(fd)
+	for sc.Scan() {
+		if line := norm.NFC.String(strings.TrimSpace(sc.Text())); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+func cmdCreateDraft(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for creating a countdown draft. Lyrics too long for a
+single transaction are appended to the draft in chunks, see the split-lyrics
+command, and the draft is then published as a countdown.
+
+The draft takes the same attributes as create-countdown, except for the lyrics.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		titleFl  = fl.String("title", "", "Title of the countdown.")
+		policyFl = fl.String("policy", "catch-up", "Policy for reveals missed during chain downtime. Either catch-up or shift.")
+		repeatFl = fl.String("repeat", "none", "How often the countdown starts over once completed. Either none, forever or a number of times.")
+		ownerFl  = flAddress(fl, "owner", "", "Optional address of the countdown owner. Defaults to the main signer.")
+		bountyFl = flCoin(fl, "bounty", "", "Optional bounty paid to the beneficiary once the countdown is completed.")
+		benefFl  = flAddress(fl, "beneficiary", "", "Address receiving the bounty. Required if a bounty is set.")
+		sponsFl  = flAddress(fl, "sponsor", "", "Optional address the bounty is taken from. Defaults to the main signer.")
+		deleteFl = flTime(fl, "delete-at", nil, "Optional expiration time of the countdown, in "+flagTimeFormat+" format.")
+		tagsFl   = flTags(fl, "tags", "Optional comma separated list of tags, for example \"synth pop,80s\".")
+	)
+	fl.Parse(args)
+
+	var bounty []*coin.Coin
+	if !bountyFl.IsZero() {
+		if !bountyFl.IsPositive() {
+			flagDie("bounty must be positive")
+		}
+		if len(*benefFl) == 0 {
+			flagDie("beneficiary is required for a bounty")
+		}
+		bounty = []*coin.Coin{bountyFl}
+	}
+
+	policy, ok := missedRevealPolicies[*policyFl]
+	if !ok {
+		flagDie("unknown missed reveal policy %q", *policyFl)
+	}
+	repeat, repeatCount := repeatPolicy(*repeatFl)
+
+	tx := &countdown.Tx{
+		Sum: &countdown.Tx_CdCreateCountdownDraftMsg{
+			CdCreateCountdownDraftMsg: &xcountdown.CreateCountdownDraftMsg{
+				Metadata:           &weave.Metadata{Schema: 1},
+				Title:              norm.NFC.String(*titleFl),
+				MissedRevealPolicy: policy,
+				Owner:              *ownerFl,
+				Bounty:             bounty,
+				Beneficiary:        *benefFl,
+				Sponsor:            *sponsFl,
+				DeleteAt:           expiration(deleteFl),
+				RepeatPolicy:       repeat,
+				RepeatCount:        repeatCount,
+				Tags:               *tagsFl,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdSplitLyrics(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Split lyrics read from a text file into chunks and create a transaction
+appending each chunk to a countdown draft, followed by a transaction publishing
+the draft. Each transaction is written to its own file in the given directory
+and the file names are printed in the order the transactions must be submitted.
+
+Each file can then be signed and submitted:
+
+  $ countdowncli sign < chunk-000.tx | countdowncli submit
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		draftFl  = flSeq(fl, "draft", "", "ID of the draft the lyrics are appended to.")
+		lyricsFl = fl.String("lyrics", "", "Path to a text file containing the lyrics.")
+		linesFl  = fl.Int("lines", 50, "Number of lines in each chunk.")
+		dirFl    = fl.String("dir", ".", "Directory the transaction files are written to.")
+		attachFl = flAttachments(fl, "attach", "Optional media file attached to a line, as LINE=PATH with a zero based line index. Can be repeated.")
+	)
+	fl.Parse(args)
+
+	if len(*draftFl) == 0 {
+		flagDie("draft ID is required")
+	}
+	if *linesFl < 1 {
+		flagDie("number of lines must be greater than zero")
+	}
+
+	lines, err := readLyricsLines(*lyricsFl)
+	if err != nil {
+		return fmt.Errorf("cannot read lyrics: %s", err)
+	}
+	if len(lines) == 0 {
+		return errors.New("lyrics file is empty")
+	}
+
+	attachments, err := readAttachments(*attachFl)
+	if err != nil {
+		return err
+	}
+
+	var lyricsHash []byte
+	for index := 0; index*(*linesFl) < len(lines); index++ {
+		end := (index + 1) * (*linesFl)
+		if end > len(lines) {
+			end = len(lines)
+		}
+		chunk, err := json.Marshal(lines[index*(*linesFl) : end])
+		if err != nil {
+			return fmt.Errorf("cannot serialize chunk %d: %s", index, err)
+		}
+		hash := sha256.Sum256(chunk)
+		lyricsHash = xcountdown.NextLyricsHash(lyricsHash, chunk)
+
+		tx := &countdown.Tx{
+			Sum: &countdown.Tx_CdAppendLyricsChunkMsg{
+				CdAppendLyricsChunkMsg: &xcountdown.AppendLyricsChunkMsg{
+					Metadata: &weave.Metadata{Schema: 1},
+					DraftID:  *draftFl,
+					Index:    int32(index),
+					Lyrics:   chunk,
+					Hash:     hash[:],
+				},
+			},
+		}
+		name := filepath.Join(*dirFl, fmt.Sprintf("chunk-%03d.tx", index))
+		if err := writeTxFile(name, tx); err != nil {
+			return err
+		}
+		fmt.Fprintln(output, name)
+	}
+
+	tx := &countdown.Tx{
+		Sum: &countdown.Tx_CdPublishCountdownMsg{
+			CdPublishCountdownMsg: &xcountdown.PublishCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				DraftID:     *draftFl,
+				LyricsHash:  lyricsHash,
+				Attachments: attachments,
+			},
+		},
+	}
+	name := filepath.Join(*dirFl, "publish.tx")
+	if err := writeTxFile(name, tx); err != nil {
+		return err
+	}
+	fmt.Fprintln(output, name)
+	return nil
+}
+
+// writeTxFile writes the serialized transaction into a new file.
+func writeTxFile(name string, tx *countdown.Tx) error {
+	fd, err := os.Create(name)
+	if err != nil {
+		return fmt.Errorf("cannot create transaction file: %s", err)
+	}
+	if _, err := writeTx(fd, tx); err != nil {
+		fd.Close()
+		return fmt.Errorf("cannot write transaction file: %s", err)
+	}
+	return fd.Close()
+}
+
+func cmdDeleteCountdown(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for deleting a countdown.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		idFl = flSeq(fl, "id", "", "ID of the countdown to delete.")
+	)
+	fl.Parse(args)
+
+	if len(*idFl) == 0 {
+		flagDie("countdown ID is required")
+	}
+
+	tx := &countdown.Tx{
+		Sum: &countdown.Tx_CdDeleteCountdownMsg{
+			CdDeleteCountdownMsg: &xcountdown.DeleteCountdownMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				ID:       *idFl,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdDeleteDraft(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for deleting a countdown draft that is not published. The
+storage deposit of the draft is refunded.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		draftFl = flSeq(fl, "draft", "", "ID of the draft to delete.")
+	)
+	fl.Parse(args)
+
+	if len(*draftFl) == 0 {
+		flagDie("draft ID is required")
+	}
+
+	tx := &countdown.Tx{
+		Sum: &countdown.Tx_CdDeleteCountdownDraftMsg{
+			CdDeleteCountdownDraftMsg: &xcountdown.DeleteCountdownDraftMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				DraftID:  *draftFl,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdTipCountdown(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for tipping the owner of a countdown. The tip is taken
+from the tipper account, which defaults to the main signer of the transaction.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		idFl     = flSeq(fl, "id", "", "ID of the countdown to tip.")
+		tipperFl = flAddress(fl, "tipper", "", "Optional address of the tipper. Defaults to the main signer.")
+		amountFl = flCoin(fl, "amount", "1 COUNTDOWN", "Amount of the tip.")
+	)
+	fl.Parse(args)
+
+	if len(*idFl) == 0 {
+		flagDie("countdown ID is required")
+	}
+	if !amountFl.IsPositive() {
+		flagDie("tip amount must be positive")
+	}
+
+	tx := &countdown.Tx{
+		Sum: &countdown.Tx_CdTipCountdownMsg{
+			CdTipCountdownMsg: &xcountdown.TipCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: *idFl,
+				Tipper:      *tipperFl,
+				Amount:      amountFl,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdSetUnlockPrice(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for setting the price of revealing the next line of a
+countdown ahead of schedule. Without a price early unlocks are disabled.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		idFl    = flSeq(fl, "id", "", "ID of the countdown.")
+		priceFl = flCoin(fl, "price", "", "Price of an early unlock, paid to the owner. Leave empty to disable early unlocks.")
+	)
+	fl.Parse(args)
+
+	if len(*idFl) == 0 {
+		flagDie("countdown ID is required")
+	}
+
+	var price *coin.Coin
+	if !priceFl.IsZero() {
+		if !priceFl.IsPositive() {
+			flagDie("price must be positive")
+		}
+		price = priceFl
+	}
+
+	tx := &countdown.Tx{
+		Sum: &countdown.Tx_CdSetUnlockPriceMsg{
+			CdSetUnlockPriceMsg: &xcountdown.SetUnlockPriceMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: *idFl,
+				Price:       price,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdUnlockNextLine(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for revealing the next line of a countdown ahead of
+schedule. The price set by the owner is taken from the payer account, which
+defaults to the main signer of the transaction.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		idFl    = flSeq(fl, "id", "", "ID of the countdown.")
+		payerFl = flAddress(fl, "payer", "", "Optional address of the payer. Defaults to the main signer.")
+	)
+	fl.Parse(args)
+
+	if len(*idFl) == 0 {
+		flagDie("countdown ID is required")
+	}
+
+	tx := &countdown.Tx{
+		Sum: &countdown.Tx_CdUnlockNextLineMsg{
+			CdUnlockNextLineMsg: &xcountdown.UnlockNextLineMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: *idFl,
+				Payer:       *payerFl,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdDeleteUser(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for deleting a user. The storage deposit is refunded to
+the user owner.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		idFl = flSeq(fl, "id", "", "ID of the user to delete.")
+	)
+	fl.Parse(args)
+
+	if len(*idFl) == 0 {
+		flagDie("user ID is required")
+	}
+
+	tx := &countdown.Tx{
+		Sum: &countdown.Tx_CdDeleteUserMsg{
+			CdDeleteUserMsg: &xcountdown.DeleteUserMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				ID:       *idFl,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdFlagCountdown(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for reporting a countdown to the moderators. Every
+address can flag a countdown once.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		idFl       = flSeq(fl, "id", "", "ID of the countdown to flag.")
+		reporterFl = flAddress(fl, "reporter", "", "Optional address of the reporter. Defaults to the main signer.")
+		reasonFl   = fl.String("reason", "", "Why the countdown should be reviewed.")
+	)
+	fl.Parse(args)
+
+	if len(*idFl) == 0 {
+		flagDie("countdown ID is required")
+	}
+	if *reasonFl == "" {
+		flagDie("reason is required")
+	}
+
+	tx := &countdown.Tx{
+		Sum: &countdown.Tx_CdFlagCountdownMsg{
+			CdFlagCountdownMsg: &xcountdown.FlagCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: *idFl,
+				Reporter:    *reporterFl,
+				Reason:      *reasonFl,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdModerateCountdown(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for moderating a countdown. The transaction must be
+signed by a moderator or the configuration owner. Hidden countdowns are
+excluded from the countdown queries, removed countdowns are deleted.
+Dismissing drops all flags of the countdown.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		idFl     = flSeq(fl, "id", "", "ID of the countdown to moderate.")
+		actionFl = fl.String("action", "", "The moderation action, one of hide, show, remove or dismiss.")
+		reasonFl = fl.String("reason", "", "Optional reason of the decision.")
+	)
+	fl.Parse(args)
+
+	if len(*idFl) == 0 {
+		flagDie("countdown ID is required")
+	}
+
+	var action xcountdown.ModerationAction
+	switch strings.ToLower(*actionFl) {
+	case "hide":
+		action = xcountdown.ModerationAction_Hide
+	case "show":
+		action = xcountdown.ModerationAction_Show
+	case "remove":
+		action = xcountdown.ModerationAction_Remove
+	case "dismiss":
+		action = xcountdown.ModerationAction_Dismiss
+	default:
+		flagDie("invalid action %q, use one of hide, show, remove or dismiss", *actionFl)
+	}
+
+	tx := &countdown.Tx{
+		Sum: &countdown.Tx_CdModerateCountdownMsg{
+			CdModerateCountdownMsg: &xcountdown.ModerateCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: *idFl,
+				Action:      action,
+				Reason:      *reasonFl,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdMigrateCountdowns(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for storing a range of countdowns again. Stored
+countdowns are migrated to the current schema and indexed by indexes added
+after they were created. The transaction must be signed by a moderator or the
+configuration owner.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		startFl = flSeq(fl, "start", "1", "ID of the first countdown to migrate.")
+		limitFl = fl.Int("limit", 100, "Number of consecutive countdown IDs to migrate.")
+	)
+	fl.Parse(args)
+
+	tx := &countdown.Tx{
+		Sum: &countdown.Tx_CdMigrateCountdownsMsg{
+			CdMigrateCountdownsMsg: &xcountdown.MigrateCountdownsMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				StartID:  *startFl,
+				Limit:    int32(*limitFl),
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdCreateSeries(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for creating a series grouping countdowns in order, for
+example the parts of a story or the songs of an album.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		titleFl = fl.String("title", "", "Title of the series.")
+		ownerFl = flAddress(fl, "owner", "", "Optional address of the series owner. Defaults to the main signer.")
+		idsFl   = flSeqs(fl, "countdowns", "", "Optional comma separated IDs of the countdowns in the series, in order.")
+	)
+	fl.Parse(args)
+
+	tx := &countdown.Tx{
+		Sum: &countdown.Tx_CdCreateSeriesMsg{
+			CdCreateSeriesMsg: &xcountdown.CreateSeriesMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				Title:        norm.NFC.String(*titleFl),
+				Owner:        *ownerFl,
+				CountdownIDs: *idsFl,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdAddSeriesCountdown(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for adding a countdown to a series. The transaction must
+be signed by the series owner.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		seriesFl    = flSeq(fl, "series", "", "ID of the series.")
+		countdownFl = flSeq(fl, "countdown", "", "ID of the countdown to add.")
+		positionFl  = fl.Int("position", math.MaxInt32, "Zero based position of the countdown in the series. Appended by default.")
+	)
+	fl.Parse(args)
+
+	if len(*seriesFl) == 0 {
+		flagDie("series ID is required")
+	}
+	if len(*countdownFl) == 0 {
+		flagDie("countdown ID is required")
+	}
+
+	tx := &countdown.Tx{
+		Sum: &countdown.Tx_CdAddSeriesCountdownMsg{
+			CdAddSeriesCountdownMsg: &xcountdown.AddSeriesCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				SeriesID:    *seriesFl,
+				CountdownID: *countdownFl,
+				Position:    int32(*positionFl),
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdRemoveSeriesCountdown(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for removing a countdown from a series. The transaction
+must be signed by the series owner.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		seriesFl    = flSeq(fl, "series", "", "ID of the series.")
+		countdownFl = flSeq(fl, "countdown", "", "ID of the countdown to remove.")
+	)
+	fl.Parse(args)
+
+	if len(*seriesFl) == 0 {
+		flagDie("series ID is required")
+	}
+	if len(*countdownFl) == 0 {
+		flagDie("countdown ID is required")
+	}
+
+	tx := &countdown.Tx{
+		Sum: &countdown.Tx_CdRemoveSeriesCountdownMsg{
+			CdRemoveSeriesCountdownMsg: &xcountdown.RemoveSeriesCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				SeriesID:    *seriesFl,
+				CountdownID: *countdownFl,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdReorderSeries(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for changing the order of the countdowns of a series. All
+countdowns of the series must be listed. The transaction must be signed by the
+series owner.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		seriesFl = flSeq(fl, "series", "", "ID of the series.")
+		idsFl    = flSeqs(fl, "countdowns", "", "Comma separated IDs of the countdowns in the series, in the new order.")
+	)
+	fl.Parse(args)
+
+	if len(*seriesFl) == 0 {
+		flagDie("series ID is required")
+	}
+
+	tx := &countdown.Tx{
+		Sum: &countdown.Tx_CdReorderSeriesMsg{
+			CdReorderSeriesMsg: &xcountdown.ReorderSeriesMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				SeriesID:     *seriesFl,
+				CountdownIDs: *idsFl,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdCountdownConf(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Print the current configuration of the countdown module.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		tmAddrFl = fl.String("tm", env("COUNTDOWNCLI_TM_ADDR", "https://countdown.NETWORK.iov.one:443"),
+			"Tendermint node address. Use proper NETWORK name. You can use COUNTDOWNCLI_TM_ADDR environment variable to set it.")
+	)
+	fl.Parse(args)
+
+	conf, err := countdownGconf(*tmAddrFl)
+	if err != nil {
+		return fmt.Errorf("cannot fetch countdown configuration: %s", err)
+	}
+	raw, err := json.MarshalIndent(conf, "", "\t")
+	if err != nil {
+		return fmt.Errorf("cannot serialize configuration: %s", err)
+	}
+	_, err = fmt.Fprintln(output, string(raw))
+	return err
+}
+
+func cmdUpdateCountdownConf(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for updating the configuration of the countdown module.
+Only provided values are changed. The transaction must be signed by the
+configuration owner.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		ownerFl            = flAddress(fl, "owner", "", "Address of the new configuration owner.")
+		userDepositFl      = flCoin(fl, "user-deposit", "", "Storage deposit taken per stored byte of a user.")
+		countdownDepositFl = flCoin(fl, "countdown-deposit", "", "Storage deposit taken per stored byte of a countdown.")
+		newUserCostFl      = fl.Int64("new-user-cost", 0, "Gas allocated for the creation of a user.")
+		newCountdownCostFl = fl.Int64("new-countdown-cost", 0, "Gas allocated for the creation of a countdown.")
+		costUnitFl         = fl.Int64("cost-unit", 0, "Number of lyrics bytes that are free, every following unit adds one to the countdown cost.")
+		minLineFl          = fl.Int("min-line-length", 0, "Minimal number of characters of a lyrics line.")
+		maxLineFl          = fl.Int("max-line-length", 0, "Maximal number of characters of a lyrics line.")
+		maxLineBytesFl     = fl.Int("max-line-bytes", 0, "Maximal size in bytes of a lyrics line.")
+		intervalFl         = fl.Duration("reveal-interval", 0, "Time between two consecutive lyrics reveals, for example 24h.")
+		moderatorsFl       = flAddresses(fl, "moderators", "", "Comma separated addresses of the moderators. Replaces the current moderators.")
+		maxActiveFl        = fl.Int("max-active", 0, "Maximal number of countdowns an owner can have that are not completed yet.")
+		maxPerWindowFl     = fl.Int("max-per-window", 0, "Maximal number of countdowns an owner can create within the creation window.")
+		windowFl           = fl.Duration("creation-window", 0, "Time window of the creation limit, for example 24h.")
+	)
+	fl.Parse(args)
+
+	if *intervalFl < 0 {
+		flagDie("reveal interval cannot be negative")
+	}
+	if *windowFl < 0 {
+		flagDie("creation window cannot be negative")
+	}
+
+	patch := &xcountdown.Configuration{
+		Metadata:               &weave.Metadata{Schema: 1},
+		Owner:                  *ownerFl,
+		UserDeposit:            *userDepositFl,
+		CountdownDeposit:       *countdownDepositFl,
+		NewUserCost:            *newUserCostFl,
+		NewCountdownCost:       *newCountdownCostFl,
+		CountdownCostUnit:      *costUnitFl,
+		MinLineLength:          int32(*minLineFl),
+		MaxLineLength:          int32(*maxLineFl),
+		MaxLineBytes:           int32(*maxLineBytesFl),
+		RevealInterval:         weave.AsUnixDuration(*intervalFl),
+		Moderators:             *moderatorsFl,
+		MaxActiveCountdowns:    int32(*maxActiveFl),
+		MaxCountdownsPerWindow: int32(*maxPerWindowFl),
+		CreationWindow:         weave.AsUnixDuration(*windowFl),
+	}
+
+	tx := &countdown.Tx{
+		Sum: &countdown.Tx_CdUpdateConfigurationMsg{
+			CdUpdateConfigurationMsg: &xcountdown.UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch:    patch,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func countdownGconf(nodeURL string) (*xcountdown.Configuration, error) {
+	store := tendermintStore(nodeURL)
+	var conf xcountdown.Configuration
+	if err := gconf.Load(store, "countdown", &conf); err != nil {
+		return nil, err
+	}
+	return &conf, nil
+}
+
+func cmdSetSuccessor(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for setting the successor of a countdown. The successor
+reveals its first line one reveal interval after the countdown completes.
+Without a successor ID the current successor is removed and starts right away.
+The transaction must be signed by a manager of both countdowns.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		idFl        = flSeq(fl, "id", "", "ID of the countdown.")
+		successorFl = flSeq(fl, "successor", "", "Optional ID of the successor countdown.")
+	)
+	fl.Parse(args)
+
+	if len(*idFl) == 0 {
+		flagDie("countdown ID is required")
+	}
+
+	tx := &countdown.Tx{
+		Sum: &countdown.Tx_CdSetSuccessorMsg{
+			CdSetSuccessorMsg: &xcountdown.SetSuccessorMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: *idFl,
+				SuccessorID: *successorFl,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdRestartCountdown(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for starting the next iteration of a completed countdown
+right away. The countdown's repeat policy must allow another iteration. The
+transaction must be signed by a countdown manager.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		idFl = flSeq(fl, "id", "", "ID of the countdown to restart.")
+	)
+	fl.Parse(args)
+
+	if len(*idFl) == 0 {
+		flagDie("countdown ID is required")
+	}
+
+	tx := &countdown.Tx{
+		Sum: &countdown.Tx_CdRestartCountdownMsg{
+			CdRestartCountdownMsg: &xcountdown.RestartCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: *idFl,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdForkCountdown(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for forking a countdown. The revealed lines of the source
+countdown are copied into a new countdown owned by the main signer, which
+records the source and its owner for attribution.
+
+Use the lines flag to copy a subset of the revealed lines, in the given order,
+and the edit flag, which can be repeated, to change the text of copied lines.
+Edits refer to the line indexes of the new countdown.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		sourceFl = flSeq(fl, "source", "", "ID of the countdown to fork.")
+		titleFl  = fl.String("title", "", "Title of the new countdown.")
+		policyFl = fl.String("policy", "catch-up", "Policy for reveals missed during chain downtime. Either catch-up or shift.")
+		linesFl  = flLines(fl, "lines", "Optional comma separated, zero based indexes of the source lines to copy. Defaults to all revealed lines.")
+		editFl   = flLineEdits(fl, "edit", "Optional new text of a line, as LINE=TEXT with a zero based line index. Can be repeated.")
+	)
+	fl.Parse(args)
+
+	if len(*sourceFl) == 0 {
+		flagDie("source countdown ID is required")
+	}
+	policy, ok := missedRevealPolicies[*policyFl]
+	if !ok {
+		flagDie("unknown missed reveal policy %q", *policyFl)
+	}
+
+	tx := &countdown.Tx{
+		Sum: &countdown.Tx_CdForkCountdownMsg{
+			CdForkCountdownMsg: &xcountdown.ForkCountdownMsg{
+				Metadata:           &weave.Metadata{Schema: 1},
+				SourceID:           *sourceFl,
+				Title:              norm.NFC.String(*titleFl),
+				MissedRevealPolicy: policy,
+				Lines:              *linesFl,
+				Edits:              *editFl,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
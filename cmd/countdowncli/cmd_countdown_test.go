@@ -0,0 +1,442 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/weavetest/assert"
+	xcountdown "github.com/ng2dev/countdown/x/countdown"
+)
+
+func TestCmdCreateCountdownHappyPath(t *testing.T) {
+	fd, err := ioutil.TempFile("", "lyrics")
+	if err != nil {
+		t.Fatalf("cannot create lyrics file: %s", err)
+	}
+	defer os.Remove(fd.Name())
+	if _, err := fd.WriteString("We are leaving together\n\nBut still it is farewell\n"); err != nil {
+		t.Fatalf("cannot write lyrics file: %s", err)
+	}
+	fd.Close()
+
+	owner, err := weave.ParseAddress("seq:multisig/usage/1")
+	assert.Nil(t, err)
+
+	var output bytes.Buffer
+	args := []string{
+		"-title", "final countdown",
+		"-lyrics", fd.Name(),
+		"-policy", "shift",
+		"-owner", "seq:multisig/usage/1",
+		"-repeat", "3",
+		"-tags", "Synth Pop, 80s,",
+	}
+	if err := cmdCreateCountdown(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a new countdown transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*xcountdown.CreateCountdownMsg)
+
+	var lines []string
+	assert.Nil(t, json.Unmarshal(msg.Lyrics, &lines))
+
+	assert.Equal(t, "final countdown", msg.Title)
+	assert.Equal(t, []string{"We are leaving together", "But still it is farewell"}, lines)
+	assert.Equal(t, xcountdown.MissedRevealPolicy_Shift, msg.MissedRevealPolicy)
+	assert.Equal(t, owner, msg.Owner)
+	assert.Equal(t, xcountdown.RepeatPolicy_Times, msg.RepeatPolicy)
+	assert.Equal(t, int32(3), msg.RepeatCount)
+	assert.Equal(t, []string{"synth-pop", "80s"}, msg.Tags)
+}
+
+func TestCmdCreateCountdownNormalizesText(t *testing.T) {
+	fd, err := ioutil.TempFile("", "lyrics")
+	if err != nil {
+		t.Fatalf("cannot create lyrics file: %s", err)
+	}
+	defer os.Remove(fd.Name())
+	if _, err := fd.WriteString("Nous partons ensemble, pre\u0301pare\u0301s\n"); err != nil {
+		t.Fatalf("cannot write lyrics file: %s", err)
+	}
+	fd.Close()
+
+	var output bytes.Buffer
+	args := []string{
+		"-title", "Cafe\u0301 countdown",
+		"-lyrics", fd.Name(),
+	}
+	if err := cmdCreateCountdown(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a new countdown transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*xcountdown.CreateCountdownMsg)
+
+	var lines []string
+	assert.Nil(t, json.Unmarshal(msg.Lyrics, &lines))
+
+	assert.Equal(t, "Caf\u00e9 countdown", msg.Title)
+	assert.Equal(t, []string{"Nous partons ensemble, pr\u00e9par\u00e9s"}, lines)
+}
+
+func TestCmdDeleteCountdownHappyPath(t *testing.T) {
+	var output bytes.Buffer
+	if err := cmdDeleteCountdown(nil, &output, []string{"-id", "5"}); err != nil {
+		t.Fatalf("cannot create a delete countdown transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*xcountdown.DeleteCountdownMsg)
+	assert.Equal(t, sequenceID(5), msg.ID)
+}
+
+func TestCmdDeleteDraftHappyPath(t *testing.T) {
+	var output bytes.Buffer
+	if err := cmdDeleteDraft(nil, &output, []string{"-draft", "4"}); err != nil {
+		t.Fatalf("cannot create a delete draft transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*xcountdown.DeleteCountdownDraftMsg)
+	assert.Equal(t, sequenceID(4), msg.DraftID)
+}
+
+func TestCmdTipCountdownHappyPath(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{
+		"-id", "5",
+		"-amount", "3 IOV",
+	}
+	if err := cmdTipCountdown(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a tip countdown transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*xcountdown.TipCountdownMsg)
+	assert.Equal(t, sequenceID(5), msg.CountdownID)
+	assert.Equal(t, 0, len(msg.Tipper))
+	assert.Equal(t, coin.NewCoinp(3, 0, "IOV"), msg.Amount)
+}
+
+func TestCmdSetUnlockPriceHappyPath(t *testing.T) {
+	var output bytes.Buffer
+	if err := cmdSetUnlockPrice(nil, &output, []string{"-id", "5", "-price", "2 IOV"}); err != nil {
+		t.Fatalf("cannot create a set unlock price transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*xcountdown.SetUnlockPriceMsg)
+	assert.Equal(t, sequenceID(5), msg.CountdownID)
+	assert.Equal(t, coin.NewCoinp(2, 0, "IOV"), msg.Price)
+}
+
+func TestCmdFlagCountdownHappyPath(t *testing.T) {
+	var output bytes.Buffer
+	if err := cmdFlagCountdown(nil, &output, []string{"-id", "5", "-reason", "spam"}); err != nil {
+		t.Fatalf("cannot create a flag countdown transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*xcountdown.FlagCountdownMsg)
+	assert.Equal(t, sequenceID(5), msg.CountdownID)
+	assert.Equal(t, "spam", msg.Reason)
+}
+
+func TestCmdModerateCountdownHappyPath(t *testing.T) {
+	var output bytes.Buffer
+	if err := cmdModerateCountdown(nil, &output, []string{"-id", "5", "-action", "Hide"}); err != nil {
+		t.Fatalf("cannot create a moderate countdown transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*xcountdown.ModerateCountdownMsg)
+	assert.Equal(t, sequenceID(5), msg.CountdownID)
+	assert.Equal(t, xcountdown.ModerationAction_Hide, msg.Action)
+}
+
+func TestCmdAddSeriesCountdownHappyPath(t *testing.T) {
+	var output bytes.Buffer
+	if err := cmdAddSeriesCountdown(nil, &output, []string{"-series", "2", "-countdown", "5"}); err != nil {
+		t.Fatalf("cannot create an add series countdown transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*xcountdown.AddSeriesCountdownMsg)
+	assert.Equal(t, sequenceID(2), msg.SeriesID)
+	assert.Equal(t, sequenceID(5), msg.CountdownID)
+	// appended by default
+	assert.Equal(t, int32(math.MaxInt32), msg.Position)
+}
+
+func TestCmdUpdateCountdownConfHappyPath(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{"-max-line-length", "200", "-reveal-interval", "1h", "-max-active", "3"}
+	if err := cmdUpdateCountdownConf(nil, &output, args); err != nil {
+		t.Fatalf("cannot create an update configuration transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*xcountdown.UpdateConfigurationMsg)
+	assert.Equal(t, int32(200), msg.Patch.MaxLineLength)
+	assert.Equal(t, int32(0), msg.Patch.MinLineLength)
+	assert.Equal(t, weave.AsUnixDuration(time.Hour), msg.Patch.RevealInterval)
+	assert.Equal(t, int32(3), msg.Patch.MaxActiveCountdowns)
+}
+
+func TestCmdSplitLyricsHappyPath(t *testing.T) {
+	dir, err := ioutil.TempDir("", "chunks")
+	if err != nil {
+		t.Fatalf("cannot create output directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	lyricsPath := filepath.Join(dir, "lyrics.txt")
+	content := "We are leaving together\nBut still it is farewell\n\nAnd maybe we'll come back\n"
+	if err := ioutil.WriteFile(lyricsPath, []byte(content), 0600); err != nil {
+		t.Fatalf("cannot write lyrics file: %s", err)
+	}
+
+	var output bytes.Buffer
+	args := []string{"-draft", "4", "-lyrics", lyricsPath, "-lines", "2", "-dir", dir}
+	if err := cmdSplitLyrics(nil, &output, args); err != nil {
+		t.Fatalf("cannot split lyrics: %s", err)
+	}
+
+	names := strings.Fields(output.String())
+	assert.Equal(t, []string{
+		filepath.Join(dir, "chunk-000.tx"),
+		filepath.Join(dir, "chunk-001.tx"),
+		filepath.Join(dir, "publish.tx"),
+	}, names)
+
+	var (
+		lines      []string
+		lyricsHash []byte
+	)
+	for i, name := range names[:2] {
+		msg := readTxFileMsg(t, name).(*xcountdown.AppendLyricsChunkMsg)
+		assert.Equal(t, sequenceID(4), msg.DraftID)
+		assert.Equal(t, int32(i), msg.Index)
+		assert.Nil(t, msg.Validate())
+
+		var chunk []string
+		assert.Nil(t, json.Unmarshal(msg.Lyrics, &chunk))
+		lines = append(lines, chunk...)
+		lyricsHash = xcountdown.NextLyricsHash(lyricsHash, msg.Lyrics)
+	}
+	assert.Equal(t, []string{
+		"We are leaving together",
+		"But still it is farewell",
+		"And maybe we'll come back",
+	}, lines)
+
+	publish := readTxFileMsg(t, names[2]).(*xcountdown.PublishCountdownMsg)
+	assert.Equal(t, sequenceID(4), publish.DraftID)
+	assert.Equal(t, lyricsHash, publish.LyricsHash)
+}
+
+func readTxFileMsg(t testing.TB, name string) weave.Msg {
+	t.Helper()
+	fd, err := os.Open(name)
+	if err != nil {
+		t.Fatalf("cannot open transaction file: %s", err)
+	}
+	defer fd.Close()
+	tx, _, err := readTx(fd)
+	if err != nil {
+		t.Fatalf("cannot unmarshal transaction: %s", err)
+	}
+	msg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	return msg
+}
+
+func TestCmdCreateCountdownAttachments(t *testing.T) {
+	dir, err := ioutil.TempDir("", "attachments")
+	if err != nil {
+		t.Fatalf("cannot create directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	lyricsPath := filepath.Join(dir, "lyrics.txt")
+	if err := ioutil.WriteFile(lyricsPath, []byte("We are leaving together\nBut still it is farewell\n"), 0600); err != nil {
+		t.Fatalf("cannot write lyrics file: %s", err)
+	}
+	cover := []byte("\x89PNG\r\n\x1a\ncover art")
+	coverPath := filepath.Join(dir, "cover.png")
+	if err := ioutil.WriteFile(coverPath, cover, 0600); err != nil {
+		t.Fatalf("cannot write cover file: %s", err)
+	}
+	sniffed := filepath.Join(dir, "sniffed")
+	if err := ioutil.WriteFile(sniffed, cover, 0600); err != nil {
+		t.Fatalf("cannot write sniffed file: %s", err)
+	}
+
+	var output bytes.Buffer
+	args := []string{
+		"-title", "final countdown",
+		"-lyrics", lyricsPath,
+		"-attach", "1=" + coverPath,
+		"-attach", "0=" + sniffed,
+	}
+	if err := cmdCreateCountdown(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a new countdown transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*xcountdown.CreateCountdownMsg)
+	assert.Nil(t, msg.Validate())
+
+	assert.Equal(t, 2, len(msg.Attachments))
+	for i, a := range msg.Attachments {
+		assert.Equal(t, int32(i), a.Line)
+		assert.Equal(t, "image/png", a.MimeType)
+		assert.Nil(t, a.Verify(bytes.NewReader(cover)))
+	}
+}
+
+func TestCmdSetSuccessorHappyPath(t *testing.T) {
+	var output bytes.Buffer
+	if err := cmdSetSuccessor(nil, &output, []string{"-id", "2", "-successor", "5"}); err != nil {
+		t.Fatalf("cannot create a set successor transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*xcountdown.SetSuccessorMsg)
+	assert.Equal(t, sequenceID(2), msg.CountdownID)
+	assert.Equal(t, sequenceID(5), msg.SuccessorID)
+}
+
+func TestCmdForkCountdownHappyPath(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{
+		"-source", "3",
+		"-title", "remixed countdown",
+		"-lines", "2,0",
+		"-edit", "1=a brand new line",
+	}
+	if err := cmdForkCountdown(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a fork countdown transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*xcountdown.ForkCountdownMsg)
+	assert.Equal(t, sequenceID(3), msg.SourceID)
+	assert.Equal(t, "remixed countdown", msg.Title)
+	assert.Equal(t, []int32{2, 0}, msg.Lines)
+	assert.Equal(t, []*xcountdown.LineEdit{{Line: 1, Text: "a brand new line"}}, msg.Edits)
+}
@@ -0,0 +1,286 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/gov"
+	"github.com/iov-one/weave/x/multisig"
+	"github.com/iov-one/weave/x/validators"
+	countdown "github.com/ng2dev/countdown/cmd/countdown/app"
+	xcountdown "github.com/ng2dev/countdown/x/countdown"
+)
+
+func cmdAsProposal(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Read any number of transactions from the stdin and extract messages from them.
+Create a proposal transaction executing the messages in a batch. Schema
+upgrades, countdown migrations and batches can only be proposed on their own.
+All attributes of the original transactions (ie signatures) are being dropped.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		titleFl = fl.String("title", "Transaction proposal.", "The proposal title.")
+		descFl  = fl.String("description", "Transaction proposal.", "The proposal description.")
+		ruleFl  = flSeq(fl, "electionrule", "", "The ID of the election rule that should be used for this proposal.")
+		startFl = flTime(fl, "start", inOneHour, "Start time as 'YYYY-MM-DD HH:MM' in UTC. If not provided, an arbitrary time in the future is used.")
+	)
+	fl.Parse(args)
+
+	if len(*ruleFl) == 0 {
+		flagDie("election rule ID is required")
+	}
+
+	var msgs []weave.Msg
+	for {
+		tx, _, err := readTx(input)
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return fmt.Errorf("cannot read transaction: %s", err)
+		}
+		msg, err := tx.GetMsg()
+		if err != nil {
+			return fmt.Errorf("cannot extract message from the transaction: %s", err)
+		}
+		msgs = append(msgs, msg)
+	}
+
+	var (
+		option *countdown.ProposalOptions
+		err    error
+	)
+	switch len(msgs) {
+	case 0:
+		return errors.New("no transaction to propose")
+	case 1:
+		option, err = proposalOptions(msgs[0])
+	default:
+		option, err = proposalBatchOptions(msgs)
+	}
+	if err != nil {
+		return err
+	}
+	rawOption, err := option.Marshal()
+	if err != nil {
+		return fmt.Errorf("cannot serialize %T option: %s", option, err)
+	}
+
+	propTx := &countdown.Tx{
+		Sum: &countdown.Tx_GovCreateProposalMsg{
+			GovCreateProposalMsg: &gov.CreateProposalMsg{
+				Metadata:       &weave.Metadata{Schema: 1},
+				Title:          *titleFl,
+				RawOption:      rawOption,
+				Description:    *descFl,
+				ElectionRuleID: *ruleFl,
+				StartTime:      startFl.UnixTime(),
+			},
+		},
+	}
+	_, err = writeTx(output, propTx)
+	return err
+}
+
+// proposalOptions returns the proposal options executing given message.
+// Messages that can only be proposed on their own and batches are options of
+// their own, any other message is proposed as a batch of one.
+func proposalOptions(msg weave.Msg) (*countdown.ProposalOptions, error) {
+	switch msg := msg.(type) {
+	case *migration.UpgradeSchemaMsg:
+		return &countdown.ProposalOptions{
+			Option: &countdown.ProposalOptions_MigrationUpgradeSchemaMsg{MigrationUpgradeSchemaMsg: msg},
+		}, nil
+	case *xcountdown.MigrateCountdownsMsg:
+		return &countdown.ProposalOptions{
+			Option: &countdown.ProposalOptions_CdMigrateCountdownsMsg{CdMigrateCountdownsMsg: msg},
+		}, nil
+	case *countdown.ExecuteBatchMsg:
+		msgs, err := msg.MsgList()
+		if err != nil {
+			return nil, fmt.Errorf("cannot extract messages from the batch: %s", err)
+		}
+		return proposalBatchOptions(msgs)
+	default:
+		return proposalBatchOptions([]weave.Msg{msg})
+	}
+}
+
+// proposalBatchOptions returns the proposal options executing all given
+// messages in a single batch. Only messages that are part of the
+// ExecuteProposalBatchMsg can be proposed in a batch.
+func proposalBatchOptions(msgs []weave.Msg) (*countdown.ProposalOptions, error) {
+	var batch countdown.ExecuteProposalBatchMsg
+	for _, msg := range msgs {
+		var union countdown.ExecuteProposalBatchMsg_Union
+		switch msg := msg.(type) {
+		case *cash.SendMsg:
+			union.Sum = &countdown.ExecuteProposalBatchMsg_Union_CashSendMsg{CashSendMsg: msg}
+		case *multisig.UpdateMsg:
+			union.Sum = &countdown.ExecuteProposalBatchMsg_Union_MultisigUpdateMsg{MultisigUpdateMsg: msg}
+		case *validators.ApplyDiffMsg:
+			union.Sum = &countdown.ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg{ValidatorsApplyDiffMsg: msg}
+		case *gov.UpdateElectorateMsg:
+			union.Sum = &countdown.ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg{GovUpdateElectorateMsg: msg}
+		case *gov.UpdateElectionRuleMsg:
+			union.Sum = &countdown.ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg{GovUpdateElectionRuleMsg: msg}
+		case *gov.CreateTextResolutionMsg:
+			union.Sum = &countdown.ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg{GovCreateTextResolutionMsg: msg}
+		case *xcountdown.UpdateConfigurationMsg:
+			union.Sum = &countdown.ExecuteProposalBatchMsg_Union_CdUpdateConfigurationMsg{CdUpdateConfigurationMsg: msg}
+		case *xcountdown.ModerateCountdownMsg:
+			union.Sum = &countdown.ExecuteProposalBatchMsg_Union_CdModerateCountdownMsg{CdModerateCountdownMsg: msg}
+		default:
+			return nil, fmt.Errorf("message type not supported in a proposal batch: %T", msg)
+		}
+		batch.Messages = append(batch.Messages, union)
+	}
+	return &countdown.ProposalOptions{
+		Option: &countdown.ProposalOptions_ExecuteProposalBatchMsg{ExecuteProposalBatchMsg: &batch},
+	}, nil
+}
+
+// inOneHour returns the default start time of a proposal.
+func inOneHour() time.Time {
+	return time.Now().Add(time.Hour).UTC()
+}
+
+func cmdTextResolution(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a proposal transaction for a text resolution. Accepted text resolutions
+are stored on chain.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		textFl  = fl.String("text", "", "The resolution text.")
+		titleFl = fl.String("title", "Text resolution.", "The proposal title.")
+		descFl  = fl.String("description", "Text resolution.", "The proposal description.")
+		ruleFl  = flSeq(fl, "electionrule", "", "The ID of the election rule that should be used for this proposal.")
+		startFl = flTime(fl, "start", inOneHour, "Start time as 'YYYY-MM-DD HH:MM' in UTC. If not provided, an arbitrary time in the future is used.")
+	)
+	fl.Parse(args)
+
+	if *textFl == "" {
+		flagDie("resolution text is required")
+	}
+	if len(*ruleFl) == 0 {
+		flagDie("election rule ID is required")
+	}
+
+	option := countdown.ProposalOptions{
+		Option: &countdown.ProposalOptions_GovCreateTextResolutionMsg{
+			GovCreateTextResolutionMsg: &gov.CreateTextResolutionMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				Resolution: *textFl,
+			},
+		},
+	}
+	rawOption, err := option.Marshal()
+	if err != nil {
+		return fmt.Errorf("cannot serialize %T option: %s", option, err)
+	}
+
+	tx := &countdown.Tx{
+		Sum: &countdown.Tx_GovCreateProposalMsg{
+			GovCreateProposalMsg: &gov.CreateProposalMsg{
+				Metadata:       &weave.Metadata{Schema: 1},
+				Title:          *titleFl,
+				RawOption:      rawOption,
+				Description:    *descFl,
+				ElectionRuleID: *ruleFl,
+				StartTime:      startFl.UnixTime(),
+			},
+		},
+	}
+	_, err = writeTx(output, tx)
+	return err
+}
+
+func cmdVote(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for casting a vote on a proposal.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		proposalFl = flSeq(fl, "proposal", "", "The ID of the proposal that is voted for.")
+		selectFl   = fl.String("select", "", "The vote, one of yes, no or abstain.")
+		voterFl    = flAddress(fl, "voter", "", "Optional address of the voter. If not provided the main signer is used.")
+	)
+	fl.Parse(args)
+
+	if len(*proposalFl) == 0 {
+		flagDie("proposal ID is required")
+	}
+
+	var selected gov.VoteOption
+	switch strings.ToLower(*selectFl) {
+	case "yes":
+		selected = gov.VoteOption_Yes
+	case "no":
+		selected = gov.VoteOption_No
+	case "abstain":
+		selected = gov.VoteOption_Abstain
+	default:
+		flagDie("invalid vote %q, use one of yes, no or abstain", *selectFl)
+	}
+
+	tx := &countdown.Tx{
+		Sum: &countdown.Tx_GovVoteMsg{
+			GovVoteMsg: &gov.VoteMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ProposalID: *proposalFl,
+				Voter:      *voterFl,
+				Selected:   selected,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdDelProposal(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for deleting a proposal before its voting period starts.
+Only the proposal author can delete it.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		proposalFl = flSeq(fl, "proposal", "", "The ID of the proposal to delete.")
+	)
+	fl.Parse(args)
+
+	if len(*proposalFl) == 0 {
+		flagDie("proposal ID is required")
+	}
+
+	tx := &countdown.Tx{
+		Sum: &countdown.Tx_GovDeleteProposalMsg{
+			GovDeleteProposalMsg: &gov.DeleteProposalMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ProposalID: *proposalFl,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
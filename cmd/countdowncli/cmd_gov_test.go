@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/gov"
+	countdown "github.com/ng2dev/countdown/cmd/countdown/app"
+	xcountdown "github.com/ng2dev/countdown/x/countdown"
+)
+
+func TestCmdAsProposalHappyPath(t *testing.T) {
+	confTx := &countdown.Tx{
+		Sum: &countdown.Tx_CdUpdateConfigurationMsg{
+			CdUpdateConfigurationMsg: &xcountdown.UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch: &xcountdown.Configuration{
+					MaxLineLength: 200,
+				},
+			},
+		},
+	}
+	var input bytes.Buffer
+	if _, err := writeTx(&input, confTx); err != nil {
+		t.Fatalf("cannot serialize transaction: %s", err)
+	}
+
+	var output bytes.Buffer
+	args := []string{
+		"-title", "Shorter lines",
+		"-electionrule", "1",
+		"-start", "2030-01-01 10:00",
+	}
+	if err := cmdAsProposal(&input, &output, args); err != nil {
+		t.Fatalf("cannot create a proposal transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*gov.CreateProposalMsg)
+	assert.Equal(t, "Shorter lines", msg.Title)
+	assert.Equal(t, sequenceID(1), msg.ElectionRuleID)
+
+	var options countdown.ProposalOptions
+	if err := options.Unmarshal(msg.RawOption); err != nil {
+		t.Fatalf("cannot unmarshal proposal options: %s", err)
+	}
+	option, err := weave.ExtractMsgFromSum(options.GetOption())
+	if err != nil {
+		t.Fatalf("cannot extract proposal message: %s", err)
+	}
+	// a single message is proposed as a batch of one
+	msgs, err := option.(*countdown.ExecuteProposalBatchMsg).MsgList()
+	if err != nil {
+		t.Fatalf("cannot extract batch messages: %s", err)
+	}
+	assert.Equal(t, 1, len(msgs))
+	conf := msgs[0].(*xcountdown.UpdateConfigurationMsg)
+	assert.Equal(t, int32(200), conf.Patch.MaxLineLength)
+}
+
+func TestCmdAsProposalBatch(t *testing.T) {
+	txs := []*countdown.Tx{
+		{
+			Sum: &countdown.Tx_CdUpdateConfigurationMsg{
+				CdUpdateConfigurationMsg: &xcountdown.UpdateConfigurationMsg{
+					Metadata: &weave.Metadata{Schema: 1},
+					Patch: &xcountdown.Configuration{
+						MaxLineLength: 200,
+					},
+				},
+			},
+		},
+		{
+			Sum: &countdown.Tx_CdModerateCountdownMsg{
+				CdModerateCountdownMsg: &xcountdown.ModerateCountdownMsg{
+					Metadata:    &weave.Metadata{Schema: 1},
+					CountdownID: sequenceID(5),
+					Action:      xcountdown.ModerationAction_Hide,
+				},
+			},
+		},
+	}
+	var input bytes.Buffer
+	for _, tx := range txs {
+		if _, err := writeTx(&input, tx); err != nil {
+			t.Fatalf("cannot serialize transaction: %s", err)
+		}
+	}
+
+	var output bytes.Buffer
+	args := []string{
+		"-title", "Moderation",
+		"-electionrule", "1",
+	}
+	if err := cmdAsProposal(&input, &output, args); err != nil {
+		t.Fatalf("cannot create a proposal transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*gov.CreateProposalMsg)
+
+	var options countdown.ProposalOptions
+	if err := options.Unmarshal(msg.RawOption); err != nil {
+		t.Fatalf("cannot unmarshal proposal options: %s", err)
+	}
+	option, err := weave.ExtractMsgFromSum(options.GetOption())
+	if err != nil {
+		t.Fatalf("cannot extract proposal message: %s", err)
+	}
+	msgs, err := option.(*countdown.ExecuteProposalBatchMsg).MsgList()
+	if err != nil {
+		t.Fatalf("cannot extract batch messages: %s", err)
+	}
+	assert.Equal(t, 2, len(msgs))
+	assert.Equal(t, int32(200), msgs[0].(*xcountdown.UpdateConfigurationMsg).Patch.MaxLineLength)
+	assert.Equal(t, sequenceID(5), msgs[1].(*xcountdown.ModerateCountdownMsg).CountdownID)
+}
+
+func TestCmdVoteHappyPath(t *testing.T) {
+	var output bytes.Buffer
+	if err := cmdVote(nil, &output, []string{"-proposal", "3", "-select", "Yes"}); err != nil {
+		t.Fatalf("cannot create a vote transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*gov.VoteMsg)
+	assert.Equal(t, sequenceID(3), msg.ProposalID)
+	assert.Equal(t, gov.VoteOption_Yes, msg.Selected)
+}
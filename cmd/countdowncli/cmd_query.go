@@ -11,12 +11,14 @@ import (
 	"io"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ng2dev/countdown/cmd/countdown/client"
 	"github.com/ng2dev/countdown/x/countdown"
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/gov"
 	"github.com/iov-one/weave/x/multisig"
 )
 
@@ -113,6 +115,111 @@ var queries = map[string]struct {
 		decKey: sequenceKey,
 		encID:  numericID,
 	},
+	"/countdowns/editor": {
+		newObj: func() model { return &countdown.Countdown{} },
+		decKey: sequenceKey,
+		encID:  addressID,
+	},
+	"/countdowns/status": {
+		newObj: func() model { return &countdown.Countdown{} },
+		decKey: sequenceKey,
+		encID:  statusID,
+	},
+	"/countdowns/forks": {
+		newObj: func() model { return &countdown.Countdown{} },
+		decKey: sequenceKey,
+		encID:  numericID,
+	},
+	"/countdowns/tag": {
+		newObj: func() model { return &countdown.Countdown{} },
+		decKey: sequenceKey,
+		encID:  tagID,
+	},
+	"/countdownProgress": {
+		newObj: func() model { return &countdown.CountdownProgress{} },
+		decKey: sequenceKey,
+		encID:  numericID,
+	},
+	"/countdownProgress/editor": {
+		newObj: func() model { return &countdown.CountdownProgress{} },
+		decKey: sequenceKey,
+		encID:  addressID,
+	},
+	"/countdownProgress/status": {
+		newObj: func() model { return &countdown.CountdownProgress{} },
+		decKey: sequenceKey,
+		encID:  statusID,
+	},
+	"/countdownProgress/forks": {
+		newObj: func() model { return &countdown.CountdownProgress{} },
+		decKey: sequenceKey,
+		encID:  numericID,
+	},
+	"/countdownProgress/tag": {
+		newObj: func() model { return &countdown.CountdownProgress{} },
+		decKey: sequenceKey,
+		encID:  tagID,
+	},
+	"/revealingCountdowns": {
+		newObj: func() model { return &countdown.Countdown{} },
+		decKey: sequenceKey,
+		encID:  timeRangeID,
+	},
+	"/completedCountdowns": {
+		newObj: func() model { return &countdown.Countdown{} },
+		decKey: sequenceKey,
+		encID:  timeRangeID,
+	},
+	"/countdownTippers/countdown": {
+		newObj: func() model { return &countdown.Tipper{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
+	"/countdownFlags/countdown": {
+		newObj: func() model { return &countdown.Flag{} },
+		decKey: rawKey,
+		encID:  numericID,
+	},
+	"/countdownDrafts": {
+		newObj: func() model { return &countdown.Draft{} },
+		decKey: sequenceKey,
+		encID:  numericID,
+	},
+	"/countdownDrafts/owner": {
+		newObj: func() model { return &countdown.Draft{} },
+		decKey: sequenceKey,
+		encID:  addressID,
+	},
+	"/countdownSeries": {
+		newObj: func() model { return &countdown.Series{} },
+		decKey: sequenceKey,
+		encID:  numericID,
+	},
+	"/countdownSeries/owner": {
+		newObj: func() model { return &countdown.Series{} },
+		decKey: sequenceKey,
+		encID:  addressID,
+	},
+	"/countdownSeries/countdown": {
+		newObj: func() model { return &countdown.Series{} },
+		decKey: sequenceKey,
+		encID:  numericID,
+	},
+	"/countdownCreations": {
+		newObj: func() model { return &countdown.CreationLog{} },
+		decKey: addressKey,
+		encID:  addressID,
+	},
+	"/seriesCountdowns": {
+		newObj: func() model { return &countdown.Countdown{} },
+		decKey: sequenceKey,
+		encID:  numericID,
+	},
+	"/hiddenCountdowns": {
+		newObj: func() model { return &countdown.Countdown{} },
+		decKey: sequenceKey,
+		encID:  numericID,
+	},
 	"/wallets": {
 		newObj: func() model { return &cash.Set{} },
 		decKey: rawKey,
@@ -123,6 +230,21 @@ var queries = map[string]struct {
 		decKey: sequenceKey,
 		encID:  numericID,
 	},
+	"/proposals": {
+		newObj: func() model { return &gov.Proposal{} },
+		decKey: sequenceKey,
+		encID:  numericID,
+	},
+	"/electorates": {
+		newObj: func() model { return &gov.Electorate{} },
+		decKey: refKey,
+		encID:  refID,
+	},
+	"/electionRules": {
+		newObj: func() model { return &gov.ElectionRule{} },
+		decKey: refKey,
+		encID:  refID,
+	},
 }
 
 // model is an entity used by weave to store data. This interface is
@@ -166,10 +288,62 @@ func refID(s string) ([]byte, error) {
 	return orm.MarshalVersionedID(ref), nil
 }
 
+// statusID expects a countdown status name, for example active.
+func statusID(s string) ([]byte, error) {
+	n, ok := countdown.CountdownStatus_value["COUNTDOWN_STATUS_"+strings.ToUpper(s)]
+	if !ok || n == int32(countdown.CountdownStatus_Invalid) {
+		return nil, errors.New("unknown status, use one of scheduled, active, paused or completed")
+	}
+	return countdown.StatusQuery(countdown.CountdownStatus(n)), nil
+}
+
+// tagID expects a countdown tag. The tag is normalized, so that for example
+// "Synth Pop" matches countdowns tagged with synth-pop.
+func tagID(s string) ([]byte, error) {
+	tag := countdown.NormalizeTag(s)
+	if tag == "" {
+		return nil, errors.New("empty tag")
+	}
+	return []byte(tag), nil
+}
+
+// timeRangeID expects a `from/to` pair of times. Each time is either in the
+// 'YYYY-MM-DD HH:MM' format in UTC or a duration relative to now, for example
+// '0s/1h' for the next hour.
+func timeRangeID(s string) ([]byte, error) {
+	tokens := strings.Split(s, "/")
+	if len(tokens) != 2 {
+		return nil, errors.New("invalid time range format, use 'from/to'")
+	}
+	now := time.Now()
+	var times [2]weave.UnixTime
+	for i, token := range tokens {
+		if d, err := time.ParseDuration(token); err == nil {
+			times[i] = weave.AsUnixTime(now.Add(d))
+			continue
+		}
+		t, err := time.Parse(flagTimeFormat, token)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse time %q: %s", token, err)
+		}
+		times[i] = weave.AsUnixTime(t)
+	}
+	return countdown.TimeRangeQuery(times[0], times[1]), nil
+}
+
 func addressID(s string) ([]byte, error) {
 	return weave.ParseAddress(s)
 }
 
+func addressKey(raw []byte) (string, error) {
+	// Skip the prefix, being the characters before : (including separator)
+	addr := weave.Address(raw[bytes.Index(raw, []byte(":"))+1:])
+	if err := addr.Validate(); err != nil {
+		return "", fmt.Errorf("invalid address key: %s", err)
+	}
+	return addr.String(), nil
+}
+
 func refKey(raw []byte) (string, error) {
 	// Skip the prefix, being the characters before : (including separator)
 	val := raw[bytes.Index(raw, []byte(":"))+1:]
@@ -8,6 +8,7 @@ import (
 	"github.com/ng2dev/countdown/cmd/countdown/client"
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/x/batch"
+	"github.com/iov-one/weave/x/gov"
 )
 
 func cmdSubmitTransaction(input io.Reader, output io.Writer, args []string) error {
@@ -110,9 +111,9 @@ func extractResponse(tx weave.Tx, respData []byte, fmts map[string]func([]byte)
 // Do not register a message if you want response returned after its submission
 // to be ignored (not printed to the user).
 var formatters = map[string]func([]byte) (string, error){
+	gov.CreateProposalMsg{}.Path(): fmtSequence,
 	// add desired format as :
-	// gov.CreateTextResolutionMsg{}.Path(): fmtSequence,
-	// escrow.CreateMsg{}.Path():            fmtSequence,
+	// escrow.CreateMsg{}.Path(): fmtSequence,
 }
 
 func fmtSequence(raw []byte) (string, error) {
@@ -13,6 +13,8 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/x/gov"
+	xcountdown "github.com/ng2dev/countdown/x/countdown"
+	"golang.org/x/text/unicode/norm"
 )
 
 // flAddress returns a value that is being initialized with given default value
@@ -268,3 +270,207 @@ func (f *flagfraction) Fraction() *gov.Fraction {
 	frac := *f.frac
 	return &frac
 }
+
+// flAddresses returns a list of addresses that is being initialized with
+// given default value and optionally overwritten by a command line argument
+// if provided. Addresses are separated by commas.
+// If given value cannot be deserialized to required type, process is
+// terminated.
+func flAddresses(fl *flag.FlagSet, name, defaultVal, usage string) *flagaddresses {
+	var fa flagaddresses
+	if defaultVal != "" {
+		if err := fa.Set(defaultVal); err != nil {
+			flagDie("Cannot parse %q address list flag value. %s", name, err)
+		}
+	}
+	fl.Var(&fa, name, usage)
+	return &fa
+}
+
+type flagaddresses []weave.Address
+
+func (a flagaddresses) String() string {
+	strs := make([]string, len(a))
+	for i, addr := range a {
+		strs[i] = addr.String()
+	}
+	return strings.Join(strs, ",")
+}
+
+func (a *flagaddresses) Set(raw string) error {
+	var addrs []weave.Address
+	for _, s := range strings.Split(raw, ",") {
+		addr, err := weave.ParseAddress(strings.TrimSpace(s))
+		if err != nil {
+			return fmt.Errorf("cannot parse address %q: %s", s, err)
+		}
+		addrs = append(addrs, addr)
+	}
+	*a = addrs
+	return nil
+}
+
+// flSeqs returns a list of sequence values that is being initialized with
+// given default value and optionally overwritten by a command line argument
+// if provided. Values are separated by commas.
+// If given value cannot be deserialized to required type, process is
+// terminated.
+func flSeqs(fl *flag.FlagSet, name, defaultVal, usage string) *flagseqs {
+	var fs flagseqs
+	if defaultVal != "" {
+		if err := fs.Set(defaultVal); err != nil {
+			flagDie("Cannot parse %q sequence list flag value. %s", name, err)
+		}
+	}
+	fl.Var(&fs, name, usage)
+	return &fs
+}
+
+type flagseqs [][]byte
+
+func (s flagseqs) String() string {
+	strs := make([]string, len(s))
+	for i, seq := range s {
+		strs[i] = flagseq(seq).String()
+	}
+	return strings.Join(strs, ",")
+}
+
+func (s *flagseqs) Set(raw string) error {
+	var seqs [][]byte
+	for _, v := range strings.Split(raw, ",") {
+		seq, err := unpackSequence(strings.TrimSpace(v))
+		if err != nil {
+			return fmt.Errorf("cannot parse sequence %q: %s", v, err)
+		}
+		seqs = append(seqs, seq)
+	}
+	*s = seqs
+	return nil
+}
+
+// flAttachments returns a list of lyrics line attachments. The flag can be
+// repeated, each value is a line index and a file path separated by an equal
+// sign.
+func flAttachments(fl *flag.FlagSet, name, usage string) *flagattachments {
+	var fa flagattachments
+	fl.Var(&fa, name, usage)
+	return &fa
+}
+
+// attachmentFile is a media file attached to a lyrics line.
+type attachmentFile struct {
+	line int32
+	path string
+}
+
+type flagattachments []attachmentFile
+
+func (a flagattachments) String() string {
+	strs := make([]string, len(a))
+	for i, f := range a {
+		strs[i] = fmt.Sprintf("%d=%s", f.line, f.path)
+	}
+	return strings.Join(strs, ",")
+}
+
+func (a *flagattachments) Set(raw string) error {
+	chunks := strings.SplitN(raw, "=", 2)
+	if len(chunks) != 2 || chunks[1] == "" {
+		return fmt.Errorf("invalid attachment %q, expected LINE=PATH", raw)
+	}
+	line, err := strconv.ParseInt(chunks[0], 10, 32)
+	if err != nil || line < 0 {
+		return fmt.Errorf("invalid attachment line %q", chunks[0])
+	}
+	*a = append(*a, attachmentFile{line: int32(line), path: chunks[1]})
+	return nil
+}
+
+// flLines returns a list of zero based lyrics line indexes. Values are
+// separated by commas.
+func flLines(fl *flag.FlagSet, name, usage string) *flaglines {
+	var lines flaglines
+	fl.Var(&lines, name, usage)
+	return &lines
+}
+
+type flaglines []int32
+
+func (l flaglines) String() string {
+	strs := make([]string, len(l))
+	for i, line := range l {
+		strs[i] = strconv.Itoa(int(line))
+	}
+	return strings.Join(strs, ",")
+}
+
+func (l *flaglines) Set(raw string) error {
+	var lines []int32
+	for _, v := range strings.Split(raw, ",") {
+		line, err := strconv.ParseInt(strings.TrimSpace(v), 10, 32)
+		if err != nil || line < 0 {
+			return fmt.Errorf("invalid line %q", v)
+		}
+		lines = append(lines, int32(line))
+	}
+	*l = lines
+	return nil
+}
+
+// flLineEdits returns a list of lyrics line edits. The flag can be repeated,
+// each value is a line index and the new text of the line separated by an
+// equal sign. The text is NFC normalized.
+func flLineEdits(fl *flag.FlagSet, name, usage string) *flagedits {
+	var fe flagedits
+	fl.Var(&fe, name, usage)
+	return &fe
+}
+
+type flagedits []*xcountdown.LineEdit
+
+func (e flagedits) String() string {
+	strs := make([]string, len(e))
+	for i, edit := range e {
+		strs[i] = fmt.Sprintf("%d=%s", edit.Line, edit.Text)
+	}
+	return strings.Join(strs, ",")
+}
+
+func (e *flagedits) Set(raw string) error {
+	chunks := strings.SplitN(raw, "=", 2)
+	if len(chunks) != 2 || chunks[1] == "" {
+		return fmt.Errorf("invalid edit %q, expected LINE=TEXT", raw)
+	}
+	line, err := strconv.ParseInt(chunks[0], 10, 32)
+	if err != nil || line < 0 {
+		return fmt.Errorf("invalid edit line %q", chunks[0])
+	}
+	*e = append(*e, &xcountdown.LineEdit{Line: int32(line), Text: norm.NFC.String(chunks[1])})
+	return nil
+}
+
+// flTags returns a list of countdown tags. Values are separated by commas and
+// normalized, so that "Synth Pop" becomes synth-pop. Empty values are ignored.
+func flTags(fl *flag.FlagSet, name, usage string) *flagtags {
+	var tags flagtags
+	fl.Var(&tags, name, usage)
+	return &tags
+}
+
+type flagtags []string
+
+func (t flagtags) String() string {
+	return strings.Join(t, ",")
+}
+
+func (t *flagtags) Set(raw string) error {
+	var tags []string
+	for _, v := range strings.Split(raw, ",") {
+		if tag := xcountdown.NormalizeTag(v); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	*t = tags
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/weavetest/assert"
 	"github.com/iov-one/weave/x/gov"
+	xcountdown "github.com/ng2dev/countdown/x/countdown"
 )
 
 func TestSeqFlag(t *testing.T) {
@@ -320,6 +321,175 @@ func TestAddressFlag(t *testing.T) {
 	}
 }
 
+func TestAddressesFlag(t *testing.T) {
+	cases := map[string]struct {
+		args      []string
+		wantError bool
+		wantVal   []weave.Address
+	}{
+		"no value": {
+			args: []string{},
+		},
+		"single address": {
+			args:    []string{"-x", "8d0d55645f1241a7a16d84fc9561a51d518c0d36"},
+			wantVal: []weave.Address{fromHex(t, "8d0d55645f1241a7a16d84fc9561a51d518c0d36")},
+		},
+		"comma separated addresses": {
+			args: []string{"-x", "8d0d55645f1241a7a16d84fc9561a51d518c0d36, aaaaaaa45f1241a7a16d84fc9561a51d518c0d36"},
+			wantVal: []weave.Address{
+				fromHex(t, "8d0d55645f1241a7a16d84fc9561a51d518c0d36"),
+				fromHex(t, "aaaaaaa45f1241a7a16d84fc9561a51d518c0d36"),
+			},
+		},
+		"invalid address": {
+			args:      []string{"-x", "8d0d55645f1241a7a16d84fc9561a51d518c0d36,zzzz"},
+			wantError: true,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			fl := flag.NewFlagSet("", flag.ContinueOnError)
+			fl.SetOutput(ioutil.Discard)
+			addrs := flAddresses(fl, "x", "", "")
+			err := fl.Parse(tc.args)
+			if !tc.wantError {
+				assert.Nil(t, err)
+			} else if err == nil {
+				t.Fatal("Expected error but got none")
+			}
+			if !tc.wantError {
+				assert.Equal(t, tc.wantVal, []weave.Address(*addrs))
+			}
+		})
+	}
+}
+
+func TestSeqsFlag(t *testing.T) {
+	cases := map[string]struct {
+		args      []string
+		wantError bool
+		wantVal   [][]byte
+	}{
+		"no value": {
+			args: []string{},
+		},
+		"comma separated sequences": {
+			args:    []string{"-x", "1, 3,2"},
+			wantVal: [][]byte{sequenceID(1), sequenceID(3), sequenceID(2)},
+		},
+		"invalid sequence": {
+			args:      []string{"-x", "1,zero"},
+			wantError: true,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			fl := flag.NewFlagSet("", flag.ContinueOnError)
+			fl.SetOutput(ioutil.Discard)
+			seqs := flSeqs(fl, "x", "", "")
+			err := fl.Parse(tc.args)
+			if !tc.wantError {
+				assert.Nil(t, err)
+			} else if err == nil {
+				t.Fatal("Expected error but got none")
+			}
+			if !tc.wantError {
+				assert.Equal(t, tc.wantVal, [][]byte(*seqs))
+			}
+		})
+	}
+}
+
+func TestAttachmentsFlag(t *testing.T) {
+	cases := map[string]struct {
+		args      []string
+		wantError bool
+		wantVal   []attachmentFile
+	}{
+		"no value": {
+			args: []string{},
+		},
+		"repeated attachments": {
+			args: []string{"-x", "0=cover.png", "-x", "12=media/clip.mp3"},
+			wantVal: []attachmentFile{
+				{line: 0, path: "cover.png"},
+				{line: 12, path: "media/clip.mp3"},
+			},
+		},
+		"missing path": {
+			args:      []string{"-x", "3="},
+			wantError: true,
+		},
+		"invalid line": {
+			args:      []string{"-x", "-1=cover.png"},
+			wantError: true,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			fl := flag.NewFlagSet("", flag.ContinueOnError)
+			fl.SetOutput(ioutil.Discard)
+			files := flAttachments(fl, "x", "")
+			err := fl.Parse(tc.args)
+			if !tc.wantError {
+				assert.Nil(t, err)
+			} else if err == nil {
+				t.Fatal("Expected error but got none")
+			}
+			if !tc.wantError {
+				assert.Equal(t, tc.wantVal, []attachmentFile(*files))
+			}
+		})
+	}
+}
+
+func TestLineEditsFlag(t *testing.T) {
+	cases := map[string]struct {
+		args      []string
+		wantError bool
+		wantVal   []*xcountdown.LineEdit
+	}{
+		"no value": {
+			args: []string{},
+		},
+		"repeated edits": {
+			args: []string{"-x", "0=Caf\u0065\u0301 = bar", "-x", "4=the end"},
+			wantVal: []*xcountdown.LineEdit{
+				{Line: 0, Text: "Caf\u00e9 = bar"},
+				{Line: 4, Text: "the end"},
+			},
+		},
+		"missing text": {
+			args:      []string{"-x", "3="},
+			wantError: true,
+		},
+		"invalid line": {
+			args:      []string{"-x", "first=text"},
+			wantError: true,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			fl := flag.NewFlagSet("", flag.ContinueOnError)
+			fl.SetOutput(ioutil.Discard)
+			edits := flLineEdits(fl, "x", "")
+			err := fl.Parse(tc.args)
+			if !tc.wantError {
+				assert.Nil(t, err)
+			} else if err == nil {
+				t.Fatal("Expected error but got none")
+			}
+			if !tc.wantError {
+				assert.Equal(t, tc.wantVal, []*xcountdown.LineEdit(*edits))
+			}
+		})
+	}
+}
+
 // observeFlagDie returns a pointer to the counter of how many times flagDie
 // was called. Until the cleanup function is called, flagDie execution does not
 // terminate the program.
@@ -428,3 +598,32 @@ func TestFractionFlag(t *testing.T) {
 		})
 	}
 }
+
+func TestTagsFlag(t *testing.T) {
+	cases := map[string]struct {
+		args    []string
+		wantVal []string
+	}{
+		"no value": {
+			args: []string{},
+		},
+		"normalized tags": {
+			args:    []string{"-x", "Synth Pop,  Électro_Swing ,80s"},
+			wantVal: []string{"synth-pop", "électro-swing", "80s"},
+		},
+		"empty tags are ignored": {
+			args:    []string{"-x", "rock,, ,"},
+			wantVal: []string{"rock"},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			fl := flag.NewFlagSet("", flag.ContinueOnError)
+			fl.SetOutput(ioutil.Discard)
+			tags := flTags(fl, "x", "")
+			assert.Nil(t, fl.Parse(tc.args))
+			assert.Equal(t, tc.wantVal, []string(*tags))
+		})
+	}
+}
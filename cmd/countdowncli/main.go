@@ -34,20 +34,46 @@ import (
 //       | countdowncli submit
 //
 var commands = map[string]func(input io.Reader, output io.Writer, args []string) error{
+	"add-series-countdown":      cmdAddSeriesCountdown,
 	"as-batch":                  cmdAsBatch,
+	"as-proposal":               cmdAsProposal,
 	"as-sequence":               cmdAsSequence,
+	"countdown-conf":            cmdCountdownConf,
+	"create-countdown":          cmdCreateCountdown,
+	"create-draft":              cmdCreateDraft,
+	"create-series":             cmdCreateSeries,
+	"del-proposal":              cmdDelProposal,
+	"delete-countdown":          cmdDeleteCountdown,
+	"delete-draft":              cmdDeleteDraft,
+	"delete-user":               cmdDeleteUser,
+	"flag-countdown":            cmdFlagCountdown,
+	"fork-countdown":            cmdForkCountdown,
 	"from-sequence":             cmdFromSequence,
 	"keyaddr":                   cmdKeyaddr,
 	"keygen":                    cmdKeygen,
+	"migrate-countdowns":        cmdMigrateCountdowns,
 	"mnemonic":                  cmdMnemonic,
+	"moderate-countdown":        cmdModerateCountdown,
 	"multisig":                  cmdMultisig,
 	"query":                     cmdQuery,
+	"remove-series-countdown":   cmdRemoveSeriesCountdown,
+	"reorder-series":            cmdReorderSeries,
+	"restart-countdown":         cmdRestartCountdown,
 	"send-tokens":               cmdSendTokens,
+	"set-successor":             cmdSetSuccessor,
+	"set-unlock-price":          cmdSetUnlockPrice,
 	"set-validators":            cmdSetValidators,
 	"sign":                      cmdSignTransaction,
+	"split-lyrics":              cmdSplitLyrics,
 	"submit":                    cmdSubmitTransaction,
+	"text-resolution":           cmdTextResolution,
+	"tip-countdown":             cmdTipCountdown,
+	"unlock-next-line":          cmdUnlockNextLine,
+	"update-countdown-conf":     cmdUpdateCountdownConf,
+	"verify-attachment":         cmdVerifyAttachment,
 	"version":                   cmdVersion,
 	"view":                      cmdTransactionView,
+	"vote":                      cmdVote,
 	"with-fee":                  cmdWithFee,
 	"with-multisig":             cmdWithMultisig,
 	"with-multisig-participant": cmdWithMultisigParticipant,
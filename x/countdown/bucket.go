@@ -1,7 +1,10 @@
 package countdown
 
 import (
+	"encoding/binary"
+
 	"github.com/iov-one/blog-tutorial/morm"
+	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/orm"
 )
@@ -25,10 +28,34 @@ type CountdownBucket struct {
 func NewCountdownBucket() *CountdownBucket {
 	return &CountdownBucket{
 		morm.NewModelBucket("countdown", &Countdown{},
-			morm.WithIndex("user", countdownUserIDIndexer, false)),
+			morm.WithIndex("user", countdownUserIDIndexer, false),
+			morm.WithMultiKeyIndex("editor", countdownEditorIndexer, false),
+			morm.WithIndex("status", countdownStatusIndexer, false),
+			morm.WithIndex("nextreveal", countdownNextRevealIndexer, false),
+			morm.WithIndex("completed", countdownCompletedIndexer, false),
+			morm.WithIndex("forks", countdownSourceIndexer, false),
+			morm.WithMultiKeyIndex("tag", countdownTagIndexer, false)),
 	}
 }
 
+// countdownIndexPrefix returns the prefix of the keys an index of the
+// countdown bucket is stored under. Index entries reference countdown IDs
+// with an orm.MultiRef. The orm does not expose range scans over an index, so
+// the prefix mirrors its key layout, TestCountdownIndexPrefix fails if the
+// layout changes.
+func countdownIndexPrefix(index string) []byte {
+	return []byte("_i.countdown_" + index + ":")
+}
+
+// One loads the countdown with the given ID and migrates it to the current
+// schema.
+func (b *CountdownBucket) One(db weave.ReadOnlyKVStore, key []byte, dest *Countdown) error {
+	if err := b.ModelBucket.One(db, key, dest); err != nil {
+		return err
+	}
+	return migrateCountdown(db, dest)
+}
+
 // countdownUserIDIndexer enables querying countdowns by user ids
 func countdownUserIDIndexer(obj orm.Object) ([]byte, error) {
 	if obj == nil || obj.Value() == nil {
@@ -41,6 +68,235 @@ func countdownUserIDIndexer(obj orm.Object) ([]byte, error) {
 	return cd.Owner, nil
 }
 
+// countdownEditorIndexer enables querying countdowns an address has a role on
+func countdownEditorIndexer(obj orm.Object) ([][]byte, error) {
+	if obj == nil || obj.Value() == nil {
+		return nil, nil
+	}
+	cd, ok := obj.Value().(*Countdown)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "expected countdown, got %T", obj.Value())
+	}
+	keys := make([][]byte, 0, len(cd.Editors))
+	for _, e := range cd.Editors {
+		keys = append(keys, e.Address)
+	}
+	return keys, nil
+}
+
+// countdownStatusIndexer enables querying countdowns by lifecycle status
+func countdownStatusIndexer(obj orm.Object) ([]byte, error) {
+	if obj == nil || obj.Value() == nil {
+		return nil, nil
+	}
+	cd, ok := obj.Value().(*Countdown)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "expected countdown, got %T", obj.Value())
+	}
+	return StatusQuery(cd.Status()), nil
+}
+
+// countdownNextRevealIndexer enables querying countdowns by the time their
+// next line is revealed. Countdowns without a pending reveal are not indexed.
+func countdownNextRevealIndexer(obj orm.Object) ([]byte, error) {
+	if obj == nil || obj.Value() == nil {
+		return nil, nil
+	}
+	cd, ok := obj.Value().(*Countdown)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "expected countdown, got %T", obj.Value())
+	}
+	return timeKey(cd.NextRevealAt), nil
+}
+
+// countdownCompletedIndexer enables querying countdowns by completion time.
+// Countdowns that are not completed are not indexed.
+func countdownCompletedIndexer(obj orm.Object) ([]byte, error) {
+	if obj == nil || obj.Value() == nil {
+		return nil, nil
+	}
+	cd, ok := obj.Value().(*Countdown)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "expected countdown, got %T", obj.Value())
+	}
+	return timeKey(cd.CompletedAt), nil
+}
+
+// countdownSourceIndexer enables querying the forks of a countdown. Countdowns
+// that are not forks are not indexed.
+func countdownSourceIndexer(obj orm.Object) ([]byte, error) {
+	if obj == nil || obj.Value() == nil {
+		return nil, nil
+	}
+	cd, ok := obj.Value().(*Countdown)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "expected countdown, got %T", obj.Value())
+	}
+	return cd.SourceID, nil
+}
+
+// countdownTagIndexer enables querying countdowns by tag
+func countdownTagIndexer(obj orm.Object) ([][]byte, error) {
+	if obj == nil || obj.Value() == nil {
+		return nil, nil
+	}
+	cd, ok := obj.Value().(*Countdown)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "expected countdown, got %T", obj.Value())
+	}
+	keys := make([][]byte, 0, len(cd.Tags))
+	for _, tag := range cd.Tags {
+		keys = append(keys, []byte(tag))
+	}
+	return keys, nil
+}
+
+// StatusQuery returns the data of a /countdowns/status query matching the
+// countdowns with the given status.
+func StatusQuery(status CountdownStatus) []byte {
+	return []byte{byte(status)}
+}
+
+// TimeRangeQuery returns the data of a /revealingCountdowns or
+// /completedCountdowns query matching the countdowns with a time in [from, to).
+func TimeRangeQuery(from, to weave.UnixTime) []byte {
+	data := make([]byte, 16)
+	binary.BigEndian.PutUint64(data, uint64(from))
+	binary.BigEndian.PutUint64(data[8:], uint64(to))
+	return data
+}
+
+// timeKey encodes t as an index key sorting in time order. Unset times are not
+// indexed.
+func timeKey(t weave.UnixTime) []byte {
+	if t <= 0 {
+		return nil
+	}
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(t))
+	return key
+}
+
+type TipperBucket struct {
+	morm.ModelBucket
+}
+
+// NewTipperBucket returns a new tipper bucket
+func NewTipperBucket() *TipperBucket {
+	return &TipperBucket{
+		morm.NewModelBucket("tipper", &Tipper{},
+			morm.WithIndex("countdown", tipperCountdownIDIndexer, false)),
+	}
+}
+
+// tipperCountdownIDIndexer enables querying tippers by countdown ids
+func tipperCountdownIDIndexer(obj orm.Object) ([]byte, error) {
+	if obj == nil || obj.Value() == nil {
+		return nil, nil
+	}
+	t, ok := obj.Value().(*Tipper)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "expected tipper, got %T", obj.Value())
+	}
+	return t.CountdownID, nil
+}
+
+type FlagBucket struct {
+	morm.ModelBucket
+}
+
+// NewFlagBucket returns a new flag bucket
+func NewFlagBucket() *FlagBucket {
+	return &FlagBucket{
+		morm.NewModelBucket("flag", &Flag{},
+			morm.WithIndex("countdown", flagCountdownIDIndexer, false)),
+	}
+}
+
+// flagCountdownIDIndexer enables querying flags by countdown ids
+func flagCountdownIDIndexer(obj orm.Object) ([]byte, error) {
+	if obj == nil || obj.Value() == nil {
+		return nil, nil
+	}
+	f, ok := obj.Value().(*Flag)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "expected flag, got %T", obj.Value())
+	}
+	return f.CountdownID, nil
+}
+
+type DraftBucket struct {
+	morm.ModelBucket
+}
+
+// NewDraftBucket returns a new draft bucket
+func NewDraftBucket() *DraftBucket {
+	return &DraftBucket{
+		morm.NewModelBucket("draft", &Draft{},
+			morm.WithIndex("owner", draftOwnerIndexer, false)),
+	}
+}
+
+// draftOwnerIndexer enables querying drafts by owner
+func draftOwnerIndexer(obj orm.Object) ([]byte, error) {
+	if obj == nil || obj.Value() == nil {
+		return nil, nil
+	}
+	d, ok := obj.Value().(*Draft)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "expected draft, got %T", obj.Value())
+	}
+	return d.Owner, nil
+}
+
+type SeriesBucket struct {
+	morm.ModelBucket
+}
+
+// NewSeriesBucket returns a new series bucket
+func NewSeriesBucket() *SeriesBucket {
+	return &SeriesBucket{
+		morm.NewModelBucket("series", &Series{},
+			morm.WithIndex("owner", seriesOwnerIndexer, false),
+			morm.WithMultiKeyIndex("countdown", seriesCountdownIndexer, false)),
+	}
+}
+
+// seriesOwnerIndexer enables querying series by owner
+func seriesOwnerIndexer(obj orm.Object) ([]byte, error) {
+	if obj == nil || obj.Value() == nil {
+		return nil, nil
+	}
+	s, ok := obj.Value().(*Series)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "expected series, got %T", obj.Value())
+	}
+	return s.Owner, nil
+}
+
+// seriesCountdownIndexer enables querying the series a countdown is part of
+func seriesCountdownIndexer(obj orm.Object) ([][]byte, error) {
+	if obj == nil || obj.Value() == nil {
+		return nil, nil
+	}
+	s, ok := obj.Value().(*Series)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "expected series, got %T", obj.Value())
+	}
+	return s.CountdownIDs, nil
+}
+
+type CreationLogBucket struct {
+	morm.ModelBucket
+}
+
+// NewCreationLogBucket returns a new creation log bucket
+func NewCreationLogBucket() *CreationLogBucket {
+	return &CreationLogBucket{
+		morm.NewModelBucket("creations", &CreationLog{}),
+	}
+}
+
 type CountdownTaskBucket struct {
 	morm.ModelBucket
 }
@@ -1,12 +1,15 @@
 package countdown
 
 import (
+	"bytes"
+	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/weavetest/assert"
 )
@@ -57,3 +60,214 @@ func TestCountdownUserIDIndexer(t *testing.T) {
 		})
 	}
 }
+
+func TestCountdownEditorIndexer(t *testing.T) {
+	now := weave.AsUnixTime(time.Now())
+
+	editor := weavetest.NewCondition().Address()
+	manager := weavetest.NewCondition().Address()
+
+	cd := &Countdown{
+		Metadata:  &weave.Metadata{Schema: 1},
+		ID:        weavetest.SequenceID(1),
+		Owner:     weavetest.NewCondition().Address(),
+		Title:     "Final Countdown",
+		CreatedAt: now,
+		Editors: []*Editor{
+			{Address: editor, Role: EditorRole_Editor},
+			{Address: manager, Role: EditorRole_Manager},
+		},
+	}
+
+	cases := map[string]struct {
+		obj      orm.Object
+		expected [][]byte
+		wantErr  *errors.Error
+	}{
+		"success": {
+			obj:      orm.NewSimpleObj(nil, cd),
+			expected: [][]byte{editor, manager},
+			wantErr:  nil,
+		},
+		"success, no editors": {
+			obj:      orm.NewSimpleObj(nil, &Countdown{}),
+			expected: [][]byte{},
+			wantErr:  nil,
+		},
+		"failure, obj is nil": {
+			obj:      nil,
+			expected: nil,
+			wantErr:  nil,
+		},
+		"not countdown": {
+			obj:      orm.NewSimpleObj(nil, new(User)),
+			expected: nil,
+			wantErr:  errors.ErrState,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			index, err := countdownEditorIndexer(tc.obj)
+
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			assert.Equal(t, tc.expected, index)
+		})
+	}
+}
+
+func TestCountdownStatusIndexer(t *testing.T) {
+	now := weave.AsUnixTime(time.Now())
+
+	cases := map[string]struct {
+		obj      orm.Object
+		expected []byte
+		wantErr  *errors.Error
+	}{
+		"scheduled": {
+			obj:      orm.NewSimpleObj(nil, &Countdown{}),
+			expected: []byte{byte(CountdownStatus_Scheduled)},
+		},
+		"active": {
+			obj:      orm.NewSimpleObj(nil, &Countdown{RevealedCount: 2}),
+			expected: []byte{byte(CountdownStatus_Active)},
+		},
+		"paused": {
+			obj:      orm.NewSimpleObj(nil, &Countdown{RevealedCount: 2, PausedAt: now}),
+			expected: []byte{byte(CountdownStatus_Paused)},
+		},
+		"completed": {
+			obj:      orm.NewSimpleObj(nil, &Countdown{RevealedCount: 2, CompletedAt: now}),
+			expected: []byte{byte(CountdownStatus_Completed)},
+		},
+		"failure, obj is nil": {
+			obj:      nil,
+			expected: nil,
+		},
+		"not countdown": {
+			obj:      orm.NewSimpleObj(nil, new(User)),
+			expected: nil,
+			wantErr:  errors.ErrState,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			index, err := countdownStatusIndexer(tc.obj)
+
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			assert.Equal(t, tc.expected, index)
+		})
+	}
+}
+
+func TestCountdownTimeIndexers(t *testing.T) {
+	at := weave.UnixTime(0x0102030405)
+
+	cases := map[string]struct {
+		obj            orm.Object
+		wantNextReveal []byte
+		wantCompleted  []byte
+	}{
+		"pending reveal": {
+			obj:            orm.NewSimpleObj(nil, &Countdown{NextRevealAt: at}),
+			wantNextReveal: []byte{0, 0, 0, 1, 2, 3, 4, 5},
+		},
+		"completed": {
+			obj:           orm.NewSimpleObj(nil, &Countdown{CompletedAt: at}),
+			wantCompleted: []byte{0, 0, 0, 1, 2, 3, 4, 5},
+		},
+		"paused countdowns are not indexed": {
+			obj: orm.NewSimpleObj(nil, &Countdown{PausedAt: at}),
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			index, err := countdownNextRevealIndexer(tc.obj)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantNextReveal, index)
+
+			index, err = countdownCompletedIndexer(tc.obj)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantCompleted, index)
+		})
+	}
+}
+
+// TestCountdownIndexPrefix ensures the keys the range queries iterate match
+// the keys the orm writes for the countdown indexes.
+func TestCountdownIndexPrefix(t *testing.T) {
+	b, err := json.Marshal(lyrics)
+	assert.Nil(t, err)
+
+	now := weave.AsUnixTime(time.Now().Round(time.Second))
+
+	cases := map[string]struct {
+		index string
+		cd    *Countdown
+		key   weave.UnixTime
+	}{
+		"nextreveal": {
+			index: "nextreveal",
+			cd:    &Countdown{NextRevealAt: now.Add(time.Hour)},
+			key:   now.Add(time.Hour),
+		},
+		"completed": {
+			index: "completed",
+			cd:    &Countdown{CompletedAt: now},
+			key:   now,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			kv := store.MemStore()
+			bucket := NewCountdownBucket()
+
+			tc.cd.Metadata = &weave.Metadata{Schema: 1}
+			tc.cd.ID = weavetest.SequenceID(1)
+			tc.cd.Owner = weavetest.NewCondition().Address()
+			tc.cd.Title = "final countdown"
+			tc.cd.Lyrics = b
+			tc.cd.CreatedAt = now
+			tc.cd.MissedRevealPolicy = MissedRevealPolicy_CatchUp
+			tc.cd.ScheduleStart = now
+			err := bucket.Put(kv, tc.cd)
+			assert.Nil(t, err)
+
+			// the orm must have indexed the countdown
+			var indexed []*Countdown
+			err = bucket.ByIndex(kv, tc.index, timeKey(tc.key), &indexed)
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(indexed))
+
+			prefix := countdownIndexPrefix(tc.index)
+			end := append(append([]byte{}, prefix[:len(prefix)-1]...), prefix[len(prefix)-1]+1)
+			it, err := kv.Iterator(prefix, end)
+			assert.Nil(t, err)
+			defer it.Release()
+
+			var keys [][]byte
+			for {
+				key, value, err := it.Next()
+				if errors.ErrIteratorDone.Is(err) {
+					break
+				}
+				assert.Nil(t, err)
+				keys = append(keys, key)
+
+				var refs orm.MultiRef
+				assert.Nil(t, refs.Unmarshal(value))
+				assert.Equal(t, [][]byte{tc.cd.ID}, refs.Refs)
+			}
+			want := append(append([]byte{}, prefix...), timeKey(tc.key)...)
+			if len(keys) != 1 || !bytes.Equal(keys[0], want) {
+				t.Fatalf("want the %s index entry under %q, got keys %q", tc.index, want, keys)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_iov_one_weave "github.com/iov-one/weave"
 	weave "github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
 	io "io"
 	math "math"
 )
@@ -24,6 +25,275 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+// EditorRole defines what an editor is allowed to do with a countdown.
+// Every role includes the permissions of the roles declared before it.
+type EditorRole int32
+
+const (
+	EditorRole_Invalid EditorRole = 0
+	// Editor can update lines of the lyrics that are not revealed yet
+	EditorRole_Editor EditorRole = 1
+	// Manager can additionally pause, resume and delete the countdown
+	EditorRole_Manager EditorRole = 2
+)
+
+var EditorRole_name = map[int32]string{
+	0: "EDITOR_ROLE_INVALID",
+	1: "EDITOR_ROLE_EDITOR",
+	2: "EDITOR_ROLE_MANAGER",
+}
+
+var EditorRole_value = map[string]int32{
+	"EDITOR_ROLE_INVALID": 0,
+	"EDITOR_ROLE_EDITOR":  1,
+	"EDITOR_ROLE_MANAGER": 2,
+}
+
+func (x EditorRole) String() string {
+	return proto.EnumName(EditorRole_name, int32(x))
+}
+
+func (EditorRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{0}
+}
+
+// MissedRevealPolicy defines what happens with lyrics reveals that were due
+// while the chain was not producing blocks.
+type MissedRevealPolicy int32
+
+const (
+	MissedRevealPolicy_Invalid MissedRevealPolicy = 0
+	// Catch up reveals all lines that should have been revealed by the current
+	// block time, keeping the original schedule.
+	MissedRevealPolicy_CatchUp MissedRevealPolicy = 1
+	// Shift reveals a single line and shifts the rest of the schedule by the
+	// length of the downtime.
+	MissedRevealPolicy_Shift MissedRevealPolicy = 2
+)
+
+var MissedRevealPolicy_name = map[int32]string{
+	0: "MISSED_REVEAL_POLICY_INVALID",
+	1: "MISSED_REVEAL_POLICY_CATCH_UP",
+	2: "MISSED_REVEAL_POLICY_SHIFT",
+}
+
+var MissedRevealPolicy_value = map[string]int32{
+	"MISSED_REVEAL_POLICY_INVALID":  0,
+	"MISSED_REVEAL_POLICY_CATCH_UP": 1,
+	"MISSED_REVEAL_POLICY_SHIFT":    2,
+}
+
+func (x MissedRevealPolicy) String() string {
+	return proto.EnumName(MissedRevealPolicy_name, int32(x))
+}
+
+func (MissedRevealPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{1}
+}
+
+// RepeatPolicy defines whether a countdown starts over once it completes. The
+// next iteration reveals its first line one reveal interval after the
+// completion.
+type RepeatPolicy int32
+
+const (
+	// None countdowns complete once
+	RepeatPolicy_None RepeatPolicy = 0
+	// Times countdowns start over RepeatCount times
+	RepeatPolicy_Times RepeatPolicy = 1
+	// Forever countdowns start over every time they complete
+	RepeatPolicy_Forever RepeatPolicy = 2
+)
+
+var RepeatPolicy_name = map[int32]string{
+	0: "REPEAT_POLICY_NONE",
+	1: "REPEAT_POLICY_TIMES",
+	2: "REPEAT_POLICY_FOREVER",
+}
+
+var RepeatPolicy_value = map[string]int32{
+	"REPEAT_POLICY_NONE":    0,
+	"REPEAT_POLICY_TIMES":   1,
+	"REPEAT_POLICY_FOREVER": 2,
+}
+
+func (x RepeatPolicy) String() string {
+	return proto.EnumName(RepeatPolicy_name, int32(x))
+}
+
+func (RepeatPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{2}
+}
+
+// CountdownStatus is the lifecycle status of a countdown. It is derived from
+// the countdown state and only stored as an index key.
+type CountdownStatus int32
+
+const (
+	CountdownStatus_Invalid CountdownStatus = 0
+	// Scheduled countdowns did not reveal any line yet
+	CountdownStatus_Scheduled CountdownStatus = 1
+	// Active countdowns revealed some of their lines
+	CountdownStatus_Active CountdownStatus = 2
+	// Paused countdowns do not reveal lines until they are resumed
+	CountdownStatus_Paused CountdownStatus = 3
+	// Completed countdowns revealed all of their lines
+	CountdownStatus_Completed CountdownStatus = 4
+)
+
+var CountdownStatus_name = map[int32]string{
+	0: "COUNTDOWN_STATUS_INVALID",
+	1: "COUNTDOWN_STATUS_SCHEDULED",
+	2: "COUNTDOWN_STATUS_ACTIVE",
+	3: "COUNTDOWN_STATUS_PAUSED",
+	4: "COUNTDOWN_STATUS_COMPLETED",
+}
+
+var CountdownStatus_value = map[string]int32{
+	"COUNTDOWN_STATUS_INVALID":   0,
+	"COUNTDOWN_STATUS_SCHEDULED": 1,
+	"COUNTDOWN_STATUS_ACTIVE":    2,
+	"COUNTDOWN_STATUS_PAUSED":    3,
+	"COUNTDOWN_STATUS_COMPLETED": 4,
+}
+
+func (x CountdownStatus) String() string {
+	return proto.EnumName(CountdownStatus_name, int32(x))
+}
+
+func (CountdownStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{3}
+}
+
+// ModerationAction defines what a moderator does with a countdown.
+type ModerationAction int32
+
+const (
+	ModerationAction_Invalid ModerationAction = 0
+	// Hide excludes the countdown from the countdown queries
+	ModerationAction_Hide ModerationAction = 1
+	// Show makes a hidden countdown visible again
+	ModerationAction_Show ModerationAction = 2
+	// Remove deletes the countdown
+	ModerationAction_Remove ModerationAction = 3
+	// Dismiss drops all flags of the countdown
+	ModerationAction_Dismiss ModerationAction = 4
+)
+
+var ModerationAction_name = map[int32]string{
+	0: "MODERATION_ACTION_INVALID",
+	1: "MODERATION_ACTION_HIDE",
+	2: "MODERATION_ACTION_SHOW",
+	3: "MODERATION_ACTION_REMOVE",
+	4: "MODERATION_ACTION_DISMISS",
+}
+
+var ModerationAction_value = map[string]int32{
+	"MODERATION_ACTION_INVALID": 0,
+	"MODERATION_ACTION_HIDE":    1,
+	"MODERATION_ACTION_SHOW":    2,
+	"MODERATION_ACTION_REMOVE":  3,
+	"MODERATION_ACTION_DISMISS": 4,
+}
+
+func (x ModerationAction) String() string {
+	return proto.EnumName(ModerationAction_name, int32(x))
+}
+
+func (ModerationAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{4}
+}
+
+type User struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// ID is the user's identifier
+	ID []byte `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Username is the user's unique name
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// RegisteredAt defines registration time of the user
+	RegisteredAt github_com_iov_one_weave.UnixTime `protobuf:"varint,4,opt,name=registered_at,json=registeredAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"registered_at,omitempty"`
+	// Owner is the address that registered the user
+	Owner github_com_iov_one_weave.Address `protobuf:"bytes,5,opt,name=owner,proto3,casttype=github.com/iov-one/weave.Address" json:"owner,omitempty"`
+	// Deposit is the storage deposit taken from the owner at registration and
+	// refunded when the user is deleted
+	Deposit *coin.Coin `protobuf:"bytes,6,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (m *User) Reset()         { *m = User{} }
+func (m *User) String() string { return proto.CompactTextString(m) }
+func (*User) ProtoMessage()    {}
+func (*User) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{0}
+}
+func (m *User) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *User) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_User.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *User) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_User.Merge(m, src)
+}
+func (m *User) XXX_Size() int {
+	return m.Size()
+}
+func (m *User) XXX_DiscardUnknown() {
+	xxx_messageInfo_User.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_User proto.InternalMessageInfo
+
+func (m *User) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *User) GetID() []byte {
+	if m != nil {
+		return m.ID
+	}
+	return nil
+}
+
+func (m *User) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *User) GetRegisteredAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.RegisteredAt
+	}
+	return 0
+}
+
+func (m *User) GetOwner() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *User) GetDeposit() *coin.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
 type Countdown struct {
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// ID is the countdown's identifier
@@ -32,9 +302,11 @@ type Countdown struct {
 	Owner github_com_iov_one_weave.Address `protobuf:"bytes,4,opt,name=owner,proto3,casttype=github.com/iov-one/weave.Address" json:"owner,omitempty"`
 	// Title is title of the countdown
 	Title string `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
-	// Lyrics of the title
+	// Lyrics are the JSON encoded lyrics of schema 1 countdowns. They are
+	// replaced by CompressedLyrics when the countdown is migrated
 	Lyrics []byte `protobuf:"bytes,6,opt,name=lyrics,proto3" json:"lyrics,omitempty"`
-	// Lyrics of the countdown
+	// Countdown are the JSON encoded revealed lines of schema 1 countdowns. They
+	// are replaced by RevealedCount when the countdown is migrated
 	Countdown []byte `protobuf:"bytes,7,opt,name=countdown,proto3" json:"countdown,omitempty"`
 	// CreatedAt defines creation time of the countdown
 	CreatedAt github_com_iov_one_weave.UnixTime `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"created_at,omitempty"`
@@ -43,13 +315,93 @@ type Countdown struct {
 	// DeleteAt defines deletion time of the countdown.
 	// Could be nil if no time of deletion is given
 	DeleteAt github_com_iov_one_weave.UnixTime `protobuf:"varint,10,opt,name=delete_at,json=deleteAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"delete_at,omitempty"`
+	// MissedRevealPolicy defines how reveals missed during chain downtime are
+	// handled.
+	MissedRevealPolicy MissedRevealPolicy `protobuf:"varint,11,opt,name=missed_reveal_policy,json=missedRevealPolicy,proto3,enum=countdown.MissedRevealPolicy" json:"missed_reveal_policy,omitempty"`
+	// Reveals records when each revealed line was published, in reveal order.
+	Reveals []*Reveal `protobuf:"bytes,12,rep,name=reveals,proto3" json:"reveals,omitempty"`
+	// Editors is the list of addresses allowed to co-manage the countdown
+	Editors []*Editor `protobuf:"bytes,13,rep,name=editors,proto3" json:"editors,omitempty"`
+	// TaskID is the ID of the scheduled task revealing the next line.
+	// Empty if no reveal is scheduled
+	TaskID []byte `protobuf:"bytes,14,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// PausedAt defines the time the countdown was paused at.
+	// Zero if the countdown is not paused
+	PausedAt github_com_iov_one_weave.UnixTime `protobuf:"varint,15,opt,name=paused_at,json=pausedAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"paused_at,omitempty"`
+	// ScheduleStart is the time the reveal schedule is counted from. It is the
+	// creation time moved forward by the time spent paused
+	ScheduleStart github_com_iov_one_weave.UnixTime `protobuf:"varint,16,opt,name=schedule_start,json=scheduleStart,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"schedule_start,omitempty"`
+	// NextRevealAt is the execution time of the scheduled task.
+	// Zero if no reveal is scheduled
+	NextRevealAt github_com_iov_one_weave.UnixTime `protobuf:"varint,17,opt,name=next_reveal_at,json=nextRevealAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"next_reveal_at,omitempty"`
+	// PendingOwner is the address a transfer of the countdown was offered to.
+	// The transfer completes once the pending owner accepts it
+	PendingOwner github_com_iov_one_weave.Address `protobuf:"bytes,18,opt,name=pending_owner,json=pendingOwner,proto3,casttype=github.com/iov-one/weave.Address" json:"pending_owner,omitempty"`
+	// TipTotal is the sum of all tips the countdown received, per currency
+	TipTotal []*coin.Coin `protobuf:"bytes,19,rep,name=tip_total,json=tipTotal,proto3" json:"tip_total,omitempty"`
+	// TopTippers are the addresses that tipped the most, per currency
+	TopTippers []*TopTipper `protobuf:"bytes,20,rep,name=top_tippers,json=topTippers,proto3" json:"top_tippers,omitempty"`
+	// Bounty is the amount locked at creation and paid to the beneficiary
+	// once the countdown is completed. The funds are held by the bounty
+	// address of the countdown
+	Bounty []*coin.Coin `protobuf:"bytes,21,rep,name=bounty,proto3" json:"bounty,omitempty"`
+	// Beneficiary receives the bounty once the countdown is completed
+	Beneficiary github_com_iov_one_weave.Address `protobuf:"bytes,22,opt,name=beneficiary,proto3,casttype=github.com/iov-one/weave.Address" json:"beneficiary,omitempty"`
+	// Sponsor paid the bounty and is refunded if the countdown is deleted
+	// before it is completed
+	Sponsor github_com_iov_one_weave.Address `protobuf:"bytes,23,opt,name=sponsor,proto3,casttype=github.com/iov-one/weave.Address" json:"sponsor,omitempty"`
+	// UnlockPrice is the price set by the owner for revealing the next line
+	// ahead of schedule. Early unlocks are disabled if not set
+	UnlockPrice *coin.Coin `protobuf:"bytes,24,opt,name=unlock_price,json=unlockPrice,proto3" json:"unlock_price,omitempty"`
+	// UnlockedRevealAt is the scheduled time of a reveal that was unlocked
+	// early. Reveals that follow are scheduled as if it happened on time
+	UnlockedRevealAt github_com_iov_one_weave.UnixTime `protobuf:"varint,25,opt,name=unlocked_reveal_at,json=unlockedRevealAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"unlocked_reveal_at,omitempty"`
+	// Deposit is the storage deposit taken at creation and refunded to the
+	// depositor when the countdown is deleted or expires
+	Deposit *coin.Coin `protobuf:"bytes,26,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	// Depositor paid the storage deposit
+	Depositor github_com_iov_one_weave.Address `protobuf:"bytes,27,opt,name=depositor,proto3,casttype=github.com/iov-one/weave.Address" json:"depositor,omitempty"`
+	// FlagCount is the number of reports that were not reviewed by a moderator
+	FlagCount int32 `protobuf:"varint,28,opt,name=flag_count,json=flagCount,proto3" json:"flag_count,omitempty"`
+	// HiddenAt is set when a moderator hides the countdown. Hidden countdowns
+	// are excluded from the countdown queries
+	HiddenAt github_com_iov_one_weave.UnixTime `protobuf:"varint,29,opt,name=hidden_at,json=hiddenAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"hidden_at,omitempty"`
+	// Attachments reference off-chain media revealed together with their line,
+	// ordered by line
+	Attachments []*Attachment `protobuf:"bytes,30,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// CompressedLyrics are the JSON encoded lyrics compressed with DEFLATE
+	CompressedLyrics []byte `protobuf:"bytes,31,opt,name=compressed_lyrics,json=compressedLyrics,proto3" json:"compressed_lyrics,omitempty"`
+	// RevealedCount is the number of revealed lines, the index of the next line
+	// to reveal
+	RevealedCount int32 `protobuf:"varint,32,opt,name=revealed_count,json=revealedCount,proto3" json:"revealed_count,omitempty"`
+	// SuccessorID is the countdown whose schedule starts when this one completes
+	SuccessorID []byte `protobuf:"bytes,33,opt,name=successor_id,json=successorId,proto3" json:"successor_id,omitempty"`
+	// PredecessorID is set while the countdown waits for its predecessor to
+	// complete. The countdown has no pending reveal until then
+	PredecessorID []byte `protobuf:"bytes,34,opt,name=predecessor_id,json=predecessorId,proto3" json:"predecessor_id,omitempty"`
+	// RepeatPolicy defines whether the countdown starts over once it completes
+	RepeatPolicy RepeatPolicy `protobuf:"varint,35,opt,name=repeat_policy,json=repeatPolicy,proto3,enum=countdown.RepeatPolicy" json:"repeat_policy,omitempty"`
+	// RepeatCount is the number of times a countdown with the times repeat
+	// policy starts over
+	RepeatCount int32 `protobuf:"varint,36,opt,name=repeat_count,json=repeatCount,proto3" json:"repeat_count,omitempty"`
+	// Iteration is the number of times the countdown started over
+	Iteration int32 `protobuf:"varint,37,opt,name=iteration,proto3" json:"iteration,omitempty"`
+	// SourceID is the countdown this one was forked from, if any
+	SourceID []byte `protobuf:"bytes,38,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	// SourceOwner is the owner of the source countdown at the time of the fork.
+	// It credits the original author even if the source is transferred or
+	// deleted later
+	SourceOwner github_com_iov_one_weave.Address `protobuf:"bytes,39,opt,name=source_owner,json=sourceOwner,proto3,casttype=github.com/iov-one/weave.Address" json:"source_owner,omitempty"`
+	// Tags are normalized labels, for example genres, the countdown can be
+	// listed by
+	Tags []string `protobuf:"bytes,40,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
 func (m *Countdown) Reset()         { *m = Countdown{} }
 func (m *Countdown) String() string { return proto.CompactTextString(m) }
 func (*Countdown) ProtoMessage()    {}
 func (*Countdown) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2611f682f9384d74, []int{0}
+	return fileDescriptor_2611f682f9384d74, []int{1}
 }
 func (m *Countdown) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -141,149 +493,239 @@ func (m *Countdown) GetDeleteAt() github_com_iov_one_weave.UnixTime {
 	return 0
 }
 
-// CountdownTask is used for representing scheduled task id. Used when adding a new line of lyrics to a countdown
-type CountdownTask struct {
-	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// ID is the unique identifier of the task
-	ID []byte `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	// CountdownID is the unique identifier of the task
-	CountdownID []byte `protobuf:"bytes,3,opt,name=countdown_id,json=countdownId,proto3" json:"countdown_id,omitempty"`
-	// TaskOwner is the creator of the task
-	TaskOwner github_com_iov_one_weave.Address `protobuf:"bytes,4,opt,name=task_owner,json=taskOwner,proto3,casttype=github.com/iov-one/weave.Address" json:"task_owner,omitempty"`
+func (m *Countdown) GetMissedRevealPolicy() MissedRevealPolicy {
+	if m != nil {
+		return m.MissedRevealPolicy
+	}
+	return MissedRevealPolicy_Invalid
 }
 
-func (m *CountdownTask) Reset()         { *m = CountdownTask{} }
-func (m *CountdownTask) String() string { return proto.CompactTextString(m) }
-func (*CountdownTask) ProtoMessage()    {}
-func (*CountdownTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2611f682f9384d74, []int{1}
-}
-func (m *CountdownTask) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *Countdown) GetReveals() []*Reveal {
+	if m != nil {
+		return m.Reveals
+	}
+	return nil
 }
-func (m *CountdownTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_CountdownTask.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *Countdown) GetEditors() []*Editor {
+	if m != nil {
+		return m.Editors
 	}
+	return nil
 }
-func (m *CountdownTask) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CountdownTask.Merge(m, src)
+
+func (m *Countdown) GetTaskID() []byte {
+	if m != nil {
+		return m.TaskID
+	}
+	return nil
 }
-func (m *CountdownTask) XXX_Size() int {
-	return m.Size()
+
+func (m *Countdown) GetPausedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.PausedAt
+	}
+	return 0
 }
-func (m *CountdownTask) XXX_DiscardUnknown() {
-	xxx_messageInfo_CountdownTask.DiscardUnknown(m)
+
+func (m *Countdown) GetScheduleStart() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ScheduleStart
+	}
+	return 0
 }
 
-var xxx_messageInfo_CountdownTask proto.InternalMessageInfo
+func (m *Countdown) GetNextRevealAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.NextRevealAt
+	}
+	return 0
+}
 
-func (m *CountdownTask) GetMetadata() *weave.Metadata {
+func (m *Countdown) GetPendingOwner() github_com_iov_one_weave.Address {
 	if m != nil {
-		return m.Metadata
+		return m.PendingOwner
 	}
 	return nil
 }
 
-func (m *CountdownTask) GetID() []byte {
+func (m *Countdown) GetTipTotal() []*coin.Coin {
 	if m != nil {
-		return m.ID
+		return m.TipTotal
 	}
 	return nil
 }
 
-func (m *CountdownTask) GetCountdownID() []byte {
+func (m *Countdown) GetTopTippers() []*TopTipper {
 	if m != nil {
-		return m.CountdownID
+		return m.TopTippers
 	}
 	return nil
 }
 
-func (m *CountdownTask) GetTaskOwner() github_com_iov_one_weave.Address {
+func (m *Countdown) GetBounty() []*coin.Coin {
 	if m != nil {
-		return m.TaskOwner
+		return m.Bounty
 	}
 	return nil
 }
 
-type CreateUserMsg struct {
-	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Username string          `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+func (m *Countdown) GetBeneficiary() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return nil
 }
 
-func (m *CreateUserMsg) Reset()         { *m = CreateUserMsg{} }
-func (m *CreateUserMsg) String() string { return proto.CompactTextString(m) }
-func (*CreateUserMsg) ProtoMessage()    {}
-func (*CreateUserMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2611f682f9384d74, []int{2}
+func (m *Countdown) GetSponsor() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Sponsor
+	}
+	return nil
 }
-func (m *CreateUserMsg) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *Countdown) GetUnlockPrice() *coin.Coin {
+	if m != nil {
+		return m.UnlockPrice
+	}
+	return nil
 }
-func (m *CreateUserMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_CreateUserMsg.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *Countdown) GetUnlockedRevealAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.UnlockedRevealAt
 	}
+	return 0
 }
-func (m *CreateUserMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateUserMsg.Merge(m, src)
+
+func (m *Countdown) GetDeposit() *coin.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
 }
-func (m *CreateUserMsg) XXX_Size() int {
-	return m.Size()
+
+func (m *Countdown) GetDepositor() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Depositor
+	}
+	return nil
 }
-func (m *CreateUserMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateUserMsg.DiscardUnknown(m)
+
+func (m *Countdown) GetFlagCount() int32 {
+	if m != nil {
+		return m.FlagCount
+	}
+	return 0
 }
 
-var xxx_messageInfo_CreateUserMsg proto.InternalMessageInfo
+func (m *Countdown) GetHiddenAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.HiddenAt
+	}
+	return 0
+}
 
-func (m *CreateUserMsg) GetMetadata() *weave.Metadata {
+func (m *Countdown) GetAttachments() []*Attachment {
 	if m != nil {
-		return m.Metadata
+		return m.Attachments
 	}
 	return nil
 }
 
-func (m *CreateUserMsg) GetUsername() string {
+func (m *Countdown) GetCompressedLyrics() []byte {
 	if m != nil {
-		return m.Username
+		return m.CompressedLyrics
 	}
-	return ""
+	return nil
 }
 
-type CreateCountdownMsg struct {
-	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Title    string          `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	// lyrics of the countdown
-	Lyrics []byte `protobuf:"bytes,3,opt,name=lyrics,proto3" json:"lyrics,omitempty"`
+func (m *Countdown) GetRevealedCount() int32 {
+	if m != nil {
+		return m.RevealedCount
+	}
+	return 0
 }
 
-func (m *CreateCountdownMsg) Reset()         { *m = CreateCountdownMsg{} }
-func (m *CreateCountdownMsg) String() string { return proto.CompactTextString(m) }
-func (*CreateCountdownMsg) ProtoMessage()    {}
-func (*CreateCountdownMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2611f682f9384d74, []int{3}
+func (m *Countdown) GetSuccessorID() []byte {
+	if m != nil {
+		return m.SuccessorID
+	}
+	return nil
 }
-func (m *CreateCountdownMsg) XXX_Unmarshal(b []byte) error {
+
+func (m *Countdown) GetPredecessorID() []byte {
+	if m != nil {
+		return m.PredecessorID
+	}
+	return nil
+}
+
+func (m *Countdown) GetRepeatPolicy() RepeatPolicy {
+	if m != nil {
+		return m.RepeatPolicy
+	}
+	return RepeatPolicy_None
+}
+
+func (m *Countdown) GetRepeatCount() int32 {
+	if m != nil {
+		return m.RepeatCount
+	}
+	return 0
+}
+
+func (m *Countdown) GetIteration() int32 {
+	if m != nil {
+		return m.Iteration
+	}
+	return 0
+}
+
+func (m *Countdown) GetSourceID() []byte {
+	if m != nil {
+		return m.SourceID
+	}
+	return nil
+}
+
+func (m *Countdown) GetSourceOwner() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.SourceOwner
+	}
+	return nil
+}
+
+func (m *Countdown) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+// Attachment references an off-chain media file, for example an image or an
+// audio clip, by its content hash. The media itself is not stored on chain
+type Attachment struct {
+	// Line is the zero based index of the lyrics line the attachment belongs to
+	Line int32 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	// Hash is the sha256 hash of the media content
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// MimeType is the media type of the content, an image or an audio type
+	MimeType string `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+}
+
+func (m *Attachment) Reset()         { *m = Attachment{} }
+func (m *Attachment) String() string { return proto.CompactTextString(m) }
+func (*Attachment) ProtoMessage()    {}
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{2}
+}
+func (m *Attachment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CreateCountdownMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Attachment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CreateCountdownMsg.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Attachment.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -293,58 +735,114 @@ func (m *CreateCountdownMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *CreateCountdownMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateCountdownMsg.Merge(m, src)
+func (m *Attachment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Attachment.Merge(m, src)
 }
-func (m *CreateCountdownMsg) XXX_Size() int {
+func (m *Attachment) XXX_Size() int {
 	return m.Size()
 }
-func (m *CreateCountdownMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateCountdownMsg.DiscardUnknown(m)
+func (m *Attachment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Attachment.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateCountdownMsg proto.InternalMessageInfo
+var xxx_messageInfo_Attachment proto.InternalMessageInfo
 
-func (m *CreateCountdownMsg) GetMetadata() *weave.Metadata {
+func (m *Attachment) GetLine() int32 {
 	if m != nil {
-		return m.Metadata
+		return m.Line
+	}
+	return 0
+}
+
+func (m *Attachment) GetHash() []byte {
+	if m != nil {
+		return m.Hash
 	}
 	return nil
 }
 
-func (m *CreateCountdownMsg) GetTitle() string {
+func (m *Attachment) GetMimeType() string {
 	if m != nil {
-		return m.Title
+		return m.MimeType
 	}
 	return ""
 }
 
-func (m *CreateCountdownMsg) GetLyrics() []byte {
+// TopTipper is the amount of a single currency an address tipped on a countdown
+type TopTipper struct {
+	Address github_com_iov_one_weave.Address `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	Total   *coin.Coin                       `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (m *TopTipper) Reset()         { *m = TopTipper{} }
+func (m *TopTipper) String() string { return proto.CompactTextString(m) }
+func (*TopTipper) ProtoMessage()    {}
+func (*TopTipper) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{3}
+}
+func (m *TopTipper) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopTipper) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopTipper.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopTipper) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopTipper.Merge(m, src)
+}
+func (m *TopTipper) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopTipper) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopTipper.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopTipper proto.InternalMessageInfo
+
+func (m *TopTipper) GetAddress() github_com_iov_one_weave.Address {
 	if m != nil {
-		return m.Lyrics
+		return m.Address
 	}
 	return nil
 }
 
-// DeleteCountdownMsg message deletes a countdown
-type DeleteCountdownMsg struct {
+func (m *TopTipper) GetTotal() *coin.Coin {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+// Tipper is the sum of all tips an address gave on a countdown
+type Tipper struct {
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// ID is the unique identifier of the task
-	ID []byte `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// ID is the countdown ID followed by the tipper address
+	ID          []byte                           `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	CountdownID []byte                           `protobuf:"bytes,3,opt,name=countdown_id,json=countdownId,proto3" json:"countdown_id,omitempty"`
+	Address     github_com_iov_one_weave.Address `protobuf:"bytes,4,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	Total       []*coin.Coin                     `protobuf:"bytes,5,rep,name=total,proto3" json:"total,omitempty"`
 }
 
-func (m *DeleteCountdownMsg) Reset()         { *m = DeleteCountdownMsg{} }
-func (m *DeleteCountdownMsg) String() string { return proto.CompactTextString(m) }
-func (*DeleteCountdownMsg) ProtoMessage()    {}
-func (*DeleteCountdownMsg) Descriptor() ([]byte, []int) {
+func (m *Tipper) Reset()         { *m = Tipper{} }
+func (m *Tipper) String() string { return proto.CompactTextString(m) }
+func (*Tipper) ProtoMessage()    {}
+func (*Tipper) Descriptor() ([]byte, []int) {
 	return fileDescriptor_2611f682f9384d74, []int{4}
 }
-func (m *DeleteCountdownMsg) XXX_Unmarshal(b []byte) error {
+func (m *Tipper) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteCountdownMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Tipper) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteCountdownMsg.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Tipper.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -354,447 +852,15847 @@ func (m *DeleteCountdownMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DeleteCountdownMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteCountdownMsg.Merge(m, src)
+func (m *Tipper) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tipper.Merge(m, src)
 }
-func (m *DeleteCountdownMsg) XXX_Size() int {
+func (m *Tipper) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteCountdownMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteCountdownMsg.DiscardUnknown(m)
+func (m *Tipper) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tipper.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteCountdownMsg proto.InternalMessageInfo
+var xxx_messageInfo_Tipper proto.InternalMessageInfo
 
-func (m *DeleteCountdownMsg) GetMetadata() *weave.Metadata {
+func (m *Tipper) GetMetadata() *weave.Metadata {
 	if m != nil {
 		return m.Metadata
 	}
 	return nil
 }
 
-func (m *DeleteCountdownMsg) GetID() []byte {
+func (m *Tipper) GetID() []byte {
 	if m != nil {
 		return m.ID
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Countdown)(nil), "countdown.Countdown")
-	proto.RegisterType((*CountdownTask)(nil), "countdown.CountdownTask")
-	proto.RegisterType((*CreateUserMsg)(nil), "countdown.CreateUserMsg")
-	proto.RegisterType((*CreateCountdownMsg)(nil), "countdown.CreateCountdownMsg")
-	proto.RegisterType((*DeleteCountdownMsg)(nil), "countdown.DeleteCountdownMsg")
+func (m *Tipper) GetCountdownID() []byte {
+	if m != nil {
+		return m.CountdownID
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("x/countdown/codec.proto", fileDescriptor_2611f682f9384d74) }
-
-var fileDescriptor_2611f682f9384d74 = []byte{
-	// 464 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x4f, 0x6b, 0xdb, 0x30,
-	0x1c, 0x8d, 0x9c, 0xc5, 0x8b, 0x7f, 0x69, 0x29, 0x88, 0xd2, 0x89, 0x30, 0x6c, 0xcf, 0x6c, 0x10,
-	0x18, 0x8b, 0xa1, 0xbb, 0xed, 0x96, 0x3f, 0x87, 0x15, 0x56, 0x06, 0xa6, 0x85, 0xed, 0x14, 0x54,
-	0x4b, 0x64, 0xa2, 0xb1, 0x55, 0x6c, 0xa5, 0xe9, 0xd8, 0x97, 0xd8, 0x07, 0xd9, 0x07, 0xd9, 0x65,
-	0xd0, 0xe3, 0x4e, 0x66, 0x38, 0xdf, 0xa2, 0xa7, 0x61, 0xd9, 0x51, 0x7b, 0xe9, 0xc1, 0x63, 0x37,
-	0xbd, 0x27, 0xbd, 0x27, 0x3f, 0xbd, 0x9f, 0xe1, 0xd9, 0x4d, 0x18, 0xcb, 0x75, 0xaa, 0x98, 0xdc,
-	0xa4, 0x61, 0x2c, 0x19, 0x8f, 0xc7, 0x57, 0x99, 0x54, 0x12, 0x3b, 0x86, 0x1e, 0x0e, 0x1e, 0xf0,
-	0xc3, 0xc3, 0xa5, 0x5c, 0x4a, 0xbd, 0x0c, 0xab, 0x55, 0xcd, 0x06, 0x3f, 0xba, 0xe0, 0xcc, 0x76,
-	0x02, 0xfc, 0x1a, 0xfa, 0x09, 0x57, 0x94, 0x51, 0x45, 0x09, 0xf2, 0xd1, 0x68, 0x70, 0x7c, 0x30,
-	0xde, 0x70, 0x7a, 0xcd, 0xc7, 0xa7, 0x0d, 0x1d, 0x99, 0x03, 0xf8, 0x08, 0x2c, 0xc1, 0x88, 0xe5,
-	0xa3, 0xd1, 0xde, 0xd4, 0x2e, 0x0b, 0xcf, 0x3a, 0x99, 0x47, 0x96, 0x60, 0xf8, 0x1d, 0xf4, 0xe4,
-	0x26, 0xe5, 0x19, 0x79, 0xa2, 0xb7, 0x5e, 0xde, 0x15, 0x9e, 0xbf, 0x14, 0xea, 0xcb, 0xfa, 0x62,
-	0x1c, 0xcb, 0x24, 0x14, 0xf2, 0xfa, 0x8d, 0x4c, 0x79, 0x58, 0xfb, 0x4e, 0x18, 0xcb, 0x78, 0x9e,
-	0x47, 0xb5, 0x04, 0x1f, 0x42, 0x4f, 0x09, 0xb5, 0xe2, 0xa4, 0xe7, 0xa3, 0x91, 0x13, 0xd5, 0x00,
-	0x1f, 0x81, 0xbd, 0xfa, 0x9a, 0x89, 0x38, 0x27, 0x76, 0x65, 0x19, 0x35, 0x08, 0x3f, 0x87, 0xfb,
-	0xb0, 0xe4, 0xa9, 0xde, 0xba, 0x27, 0xf0, 0x1c, 0x20, 0xce, 0x38, 0x55, 0x9c, 0x2d, 0xa8, 0x22,
-	0x7d, 0x1f, 0x8d, 0xba, 0xd3, 0x57, 0x77, 0x85, 0xf7, 0xe2, 0xd1, 0x8f, 0x39, 0x4f, 0xc5, 0xcd,
-	0x99, 0x48, 0x78, 0xe4, 0x34, 0xc2, 0x89, 0xc2, 0xef, 0x61, 0x2f, 0x96, 0xc9, 0xd5, 0x8a, 0x37,
-	0x3e, 0x4e, 0x1b, 0x9f, 0x81, 0x91, 0x4e, 0x14, 0x9e, 0x82, 0xc3, 0x78, 0x05, 0x2a, 0x1b, 0x68,
-	0x63, 0xd3, 0xaf, 0x75, 0x13, 0x15, 0xfc, 0x42, 0xb0, 0x6f, 0xea, 0x3a, 0xa3, 0xf9, 0xe5, 0xff,
-	0xa9, 0xec, 0xb8, 0x0a, 0xd9, 0xb8, 0x2e, 0x04, 0x23, 0x5d, 0x7d, 0xe2, 0xa0, 0x2c, 0xbc, 0x81,
-	0xb9, 0xed, 0x64, 0x5e, 0xc5, 0xd9, 0x01, 0x86, 0x67, 0x00, 0x8a, 0xe6, 0x97, 0x8b, 0xf6, 0x5d,
-	0x3b, 0x95, 0xee, 0x63, 0x25, 0x0b, 0x3e, 0xc1, 0xfe, 0x4c, 0x3f, 0xf5, 0x79, 0xce, 0xb3, 0xd3,
-	0x7c, 0xd9, 0x2e, 0xce, 0x10, 0xfa, 0xeb, 0x9c, 0x67, 0x29, 0x4d, 0xb8, 0x0e, 0xe5, 0x44, 0x06,
-	0x07, 0xdf, 0x00, 0xd7, 0xce, 0x26, 0x40, 0x6b, 0x7b, 0x33, 0x8c, 0xd6, 0xc3, 0x61, 0x0c, 0xcc,
-	0x30, 0xd6, 0xaf, 0x04, 0x65, 0xe1, 0xd9, 0x1f, 0x34, 0xb3, 0x1b, 0xcc, 0xe0, 0x33, 0xe0, 0xb9,
-	0xae, 0xec, 0xdf, 0x2f, 0x7f, 0xa4, 0xaa, 0x29, 0xf9, 0x59, 0xba, 0xe8, 0xb6, 0x74, 0xd1, 0x9f,
-	0xd2, 0x45, 0xdf, 0xb7, 0x6e, 0xe7, 0x76, 0xeb, 0x76, 0x7e, 0x6f, 0xdd, 0xce, 0x85, 0xad, 0xff,
-	0xe8, 0xb7, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x78, 0x5f, 0x67, 0x1a, 0x04, 0x00, 0x00,
+func (m *Tipper) GetAddress() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
 }
 
-func (m *Countdown) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Tipper) GetTotal() []*coin.Coin {
+	if m != nil {
+		return m.Total
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Countdown) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n1, err := m.Metadata.MarshalTo(dAtA[i:])
+// Flag is a report of a countdown by an address, waiting for a moderator
+// review
+type Flag struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// ID is the countdown ID followed by the reporter address
+	ID          []byte                            `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	CountdownID []byte                            `protobuf:"bytes,3,opt,name=countdown_id,json=countdownId,proto3" json:"countdown_id,omitempty"`
+	Reporter    github_com_iov_one_weave.Address  `protobuf:"bytes,4,opt,name=reporter,proto3,casttype=github.com/iov-one/weave.Address" json:"reporter,omitempty"`
+	Reason      string                            `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	FlaggedAt   github_com_iov_one_weave.UnixTime `protobuf:"varint,6,opt,name=flagged_at,json=flaggedAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"flagged_at,omitempty"`
+}
+
+func (m *Flag) Reset()         { *m = Flag{} }
+func (m *Flag) String() string { return proto.CompactTextString(m) }
+func (*Flag) ProtoMessage()    {}
+func (*Flag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{5}
+}
+func (m *Flag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Flag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Flag.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n1
-	}
-	if len(m.ID) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
-	}
-	if len(m.Owner) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Owner)))
-		i += copy(dAtA[i:], m.Owner)
-	}
-	if len(m.Title) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
-		i += copy(dAtA[i:], m.Title)
-	}
-	if len(m.Lyrics) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Lyrics)))
-		i += copy(dAtA[i:], m.Lyrics)
+		return b[:n], nil
 	}
-	if len(m.Countdown) > 0 {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Countdown)))
-		i += copy(dAtA[i:], m.Countdown)
+}
+func (m *Flag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Flag.Merge(m, src)
+}
+func (m *Flag) XXX_Size() int {
+	return m.Size()
+}
+func (m *Flag) XXX_DiscardUnknown() {
+	xxx_messageInfo_Flag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Flag proto.InternalMessageInfo
+
+func (m *Flag) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	if m.CreatedAt != 0 {
-		dAtA[i] = 0x40
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.CreatedAt))
+	return nil
+}
+
+func (m *Flag) GetID() []byte {
+	if m != nil {
+		return m.ID
 	}
-	if m.CompletedAt != 0 {
-		dAtA[i] = 0x48
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.CompletedAt))
+	return nil
+}
+
+func (m *Flag) GetCountdownID() []byte {
+	if m != nil {
+		return m.CountdownID
 	}
-	if m.DeleteAt != 0 {
-		dAtA[i] = 0x50
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.DeleteAt))
+	return nil
+}
+
+func (m *Flag) GetReporter() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Reporter
 	}
-	return i, nil
+	return nil
 }
 
-func (m *CountdownTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Flag) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CountdownTask) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n2, err := m.Metadata.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
+func (m *Flag) GetFlaggedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.FlaggedAt
 	}
-	if len(m.ID) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
-	}
-	if len(m.CountdownID) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.CountdownID)))
-		i += copy(dAtA[i:], m.CountdownID)
-	}
-	if len(m.TaskOwner) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.TaskOwner)))
-		i += copy(dAtA[i:], m.TaskOwner)
-	}
-	return i, nil
+	return 0
 }
 
-func (m *CreateUserMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Draft is a countdown whose lyrics are uploaded in chunks. It is not
+// scheduled until it is published
+type Draft struct {
+	Metadata           *weave.Metadata                   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ID                 []byte                            `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Owner              github_com_iov_one_weave.Address  `protobuf:"bytes,3,opt,name=owner,proto3,casttype=github.com/iov-one/weave.Address" json:"owner,omitempty"`
+	Title              string                            `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	MissedRevealPolicy MissedRevealPolicy                `protobuf:"varint,5,opt,name=missed_reveal_policy,json=missedRevealPolicy,proto3,enum=countdown.MissedRevealPolicy" json:"missed_reveal_policy,omitempty"`
+	Bounty             []*coin.Coin                      `protobuf:"bytes,6,rep,name=bounty,proto3" json:"bounty,omitempty"`
+	Beneficiary        github_com_iov_one_weave.Address  `protobuf:"bytes,7,opt,name=beneficiary,proto3,casttype=github.com/iov-one/weave.Address" json:"beneficiary,omitempty"`
+	Sponsor            github_com_iov_one_weave.Address  `protobuf:"bytes,8,opt,name=sponsor,proto3,casttype=github.com/iov-one/weave.Address" json:"sponsor,omitempty"`
+	DeleteAt           github_com_iov_one_weave.UnixTime `protobuf:"varint,9,opt,name=delete_at,json=deleteAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"delete_at,omitempty"`
+	CreatedAt          github_com_iov_one_weave.UnixTime `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"created_at,omitempty"`
+	// Lyrics are the lines of all appended chunks, JSON encoded
+	Lyrics []byte `protobuf:"bytes,11,opt,name=lyrics,proto3" json:"lyrics,omitempty"`
+	// Chunks is the number of appended chunks
+	Chunks int32 `protobuf:"varint,12,opt,name=chunks,proto3" json:"chunks,omitempty"`
+	// LyricsHash chains the hashes of all appended chunks, in order
+	LyricsHash   []byte       `protobuf:"bytes,13,opt,name=lyrics_hash,json=lyricsHash,proto3" json:"lyrics_hash,omitempty"`
+	RepeatPolicy RepeatPolicy `protobuf:"varint,14,opt,name=repeat_policy,json=repeatPolicy,proto3,enum=countdown.RepeatPolicy" json:"repeat_policy,omitempty"`
+	RepeatCount  int32        `protobuf:"varint,15,opt,name=repeat_count,json=repeatCount,proto3" json:"repeat_count,omitempty"`
+	Tags         []string     `protobuf:"bytes,16,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Deposit is the storage deposit of the draft, topped up with every
+	// appended chunk and refunded to the depositor when the draft is published or
+	// deleted
+	Deposit *coin.Coin `protobuf:"bytes,17,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	// Depositor paid the storage deposit
+	Depositor github_com_iov_one_weave.Address `protobuf:"bytes,18,opt,name=depositor,proto3,casttype=github.com/iov-one/weave.Address" json:"depositor,omitempty"`
 }
 
-func (m *CreateUserMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n3, err := m.Metadata.MarshalTo(dAtA[i:])
+func (m *Draft) Reset()         { *m = Draft{} }
+func (m *Draft) String() string { return proto.CompactTextString(m) }
+func (*Draft) ProtoMessage()    {}
+func (*Draft) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{6}
+}
+func (m *Draft) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Draft) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Draft.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n3
-	}
-	if len(m.Username) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Username)))
-		i += copy(dAtA[i:], m.Username)
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *Draft) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Draft.Merge(m, src)
+}
+func (m *Draft) XXX_Size() int {
+	return m.Size()
+}
+func (m *Draft) XXX_DiscardUnknown() {
+	xxx_messageInfo_Draft.DiscardUnknown(m)
 }
 
-func (m *CreateCountdownMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Draft proto.InternalMessageInfo
+
+func (m *Draft) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CreateCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n4, err := m.Metadata.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
-	}
-	if len(m.Title) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
-		i += copy(dAtA[i:], m.Title)
-	}
-	if len(m.Lyrics) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Lyrics)))
-		i += copy(dAtA[i:], m.Lyrics)
+func (m *Draft) GetID() []byte {
+	if m != nil {
+		return m.ID
 	}
-	return i, nil
+	return nil
 }
 
-func (m *DeleteCountdownMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Draft) GetOwner() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DeleteCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n5, err := m.Metadata.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n5
-	}
-	if len(m.ID) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+func (m *Draft) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	return i, nil
+	return ""
 }
 
-func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Draft) GetMissedRevealPolicy() MissedRevealPolicy {
+	if m != nil {
+		return m.MissedRevealPolicy
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return MissedRevealPolicy_Invalid
 }
-func (m *Countdown) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+
+func (m *Draft) GetBounty() []*coin.Coin {
+	if m != nil {
+		return m.Bounty
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *Draft) GetBeneficiary() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Beneficiary
 	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *Draft) GetSponsor() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Sponsor
 	}
-	l = len(m.Lyrics)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *Draft) GetDeleteAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.DeleteAt
 	}
-	l = len(m.Countdown)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return 0
+}
+
+func (m *Draft) GetCreatedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovCodec(uint64(m.CreatedAt))
+	return 0
+}
+
+func (m *Draft) GetLyrics() []byte {
+	if m != nil {
+		return m.Lyrics
 	}
-	if m.CompletedAt != 0 {
-		n += 1 + sovCodec(uint64(m.CompletedAt))
+	return nil
+}
+
+func (m *Draft) GetChunks() int32 {
+	if m != nil {
+		return m.Chunks
 	}
-	if m.DeleteAt != 0 {
-		n += 1 + sovCodec(uint64(m.DeleteAt))
+	return 0
+}
+
+func (m *Draft) GetLyricsHash() []byte {
+	if m != nil {
+		return m.LyricsHash
 	}
-	return n
+	return nil
 }
 
-func (m *CountdownTask) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Draft) GetRepeatPolicy() RepeatPolicy {
+	if m != nil {
+		return m.RepeatPolicy
 	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	return RepeatPolicy_None
+}
+
+func (m *Draft) GetRepeatCount() int32 {
+	if m != nil {
+		return m.RepeatCount
 	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return 0
+}
+
+func (m *Draft) GetTags() []string {
+	if m != nil {
+		return m.Tags
 	}
-	l = len(m.CountdownID)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *Draft) GetDeposit() *coin.Coin {
+	if m != nil {
+		return m.Deposit
 	}
-	l = len(m.TaskOwner)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *Draft) GetDepositor() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Depositor
 	}
-	return n
+	return nil
 }
 
-func (m *CreateUserMsg) Size() (n int) {
-	if m == nil {
-		return 0
+// Series groups countdowns in order under one owner, for example the parts of
+// a story or the songs of an album
+type Series struct {
+	Metadata *weave.Metadata                  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ID       []byte                           `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Owner    github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=owner,proto3,casttype=github.com/iov-one/weave.Address" json:"owner,omitempty"`
+	Title    string                           `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	// CountdownIDs are the members of the series, in presentation order
+	CountdownIDs [][]byte                          `protobuf:"bytes,5,rep,name=countdown_ids,json=countdownIds,proto3" json:"countdown_ids,omitempty"`
+	CreatedAt    github_com_iov_one_weave.UnixTime `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"created_at,omitempty"`
+}
+
+func (m *Series) Reset()         { *m = Series{} }
+func (m *Series) String() string { return proto.CompactTextString(m) }
+func (*Series) ProtoMessage()    {}
+func (*Series) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{7}
+}
+func (m *Series) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Series) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Series.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
+}
+func (m *Series) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Series.Merge(m, src)
+}
+func (m *Series) XXX_Size() int {
+	return m.Size()
+}
+func (m *Series) XXX_DiscardUnknown() {
+	xxx_messageInfo_Series.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Series proto.InternalMessageInfo
+
+func (m *Series) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Series) GetID() []byte {
+	if m != nil {
+		return m.ID
+	}
+	return nil
+}
+
+func (m *Series) GetOwner() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *Series) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Series) GetCountdownIDs() [][]byte {
+	if m != nil {
+		return m.CountdownIDs
+	}
+	return nil
+}
+
+func (m *Series) GetCreatedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+// CreationLog records when an owner created countdowns within the creation
+// window of the configuration. Deleting or transferring a countdown does not
+// remove its creation from the log.
+type CreationLog struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// ID is the address of the owner
+	ID        []byte                              `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt []github_com_iov_one_weave.UnixTime `protobuf:"varint,3,rep,packed,name=created_at,json=createdAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"created_at,omitempty"`
+}
+
+func (m *CreationLog) Reset()         { *m = CreationLog{} }
+func (m *CreationLog) String() string { return proto.CompactTextString(m) }
+func (*CreationLog) ProtoMessage()    {}
+func (*CreationLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{8}
+}
+func (m *CreationLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreationLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreationLog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreationLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreationLog.Merge(m, src)
+}
+func (m *CreationLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreationLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreationLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreationLog proto.InternalMessageInfo
+
+func (m *CreationLog) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CreationLog) GetID() []byte {
+	if m != nil {
+		return m.ID
+	}
+	return nil
+}
+
+func (m *CreationLog) GetCreatedAt() []github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+// Editor is an address that is granted a role on a countdown.
+type Editor struct {
+	Address github_com_iov_one_weave.Address `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	Role    EditorRole                       `protobuf:"varint,2,opt,name=role,proto3,enum=countdown.EditorRole" json:"role,omitempty"`
+}
+
+func (m *Editor) Reset()         { *m = Editor{} }
+func (m *Editor) String() string { return proto.CompactTextString(m) }
+func (*Editor) ProtoMessage()    {}
+func (*Editor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{9}
+}
+func (m *Editor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Editor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Editor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Editor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Editor.Merge(m, src)
+}
+func (m *Editor) XXX_Size() int {
+	return m.Size()
+}
+func (m *Editor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Editor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Editor proto.InternalMessageInfo
+
+func (m *Editor) GetAddress() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *Editor) GetRole() EditorRole {
+	if m != nil {
+		return m.Role
+	}
+	return EditorRole_Invalid
+}
+
+// Reveal records the block at which a line of the lyrics was revealed.
+type Reveal struct {
+	// Line is the zero based index of the revealed line in the lyrics
+	Line int32 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	// Height is the block height of the reveal
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// RevealedAt is the block time of the reveal
+	RevealedAt github_com_iov_one_weave.UnixTime `protobuf:"varint,3,opt,name=revealed_at,json=revealedAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"revealed_at,omitempty"`
+}
+
+func (m *Reveal) Reset()         { *m = Reveal{} }
+func (m *Reveal) String() string { return proto.CompactTextString(m) }
+func (*Reveal) ProtoMessage()    {}
+func (*Reveal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{10}
+}
+func (m *Reveal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Reveal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Reveal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Reveal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reveal.Merge(m, src)
+}
+func (m *Reveal) XXX_Size() int {
+	return m.Size()
+}
+func (m *Reveal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reveal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Reveal proto.InternalMessageInfo
+
+func (m *Reveal) GetLine() int32 {
+	if m != nil {
+		return m.Line
+	}
+	return 0
+}
+
+func (m *Reveal) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Reveal) GetRevealedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.RevealedAt
+	}
+	return 0
+}
+
+// CountdownProgress is computed by the progress queries from a countdown and
+// its pending reveal task. It is never stored.
+type CountdownProgress struct {
+	CountdownID   []byte          `protobuf:"bytes,1,opt,name=countdown_id,json=countdownId,proto3" json:"countdown_id,omitempty"`
+	Status        CountdownStatus `protobuf:"varint,2,opt,name=status,proto3,enum=countdown.CountdownStatus" json:"status,omitempty"`
+	RevealedCount int32           `protobuf:"varint,3,opt,name=revealed_count,json=revealedCount,proto3" json:"revealed_count,omitempty"`
+	TotalLines    int32           `protobuf:"varint,4,opt,name=total_lines,json=totalLines,proto3" json:"total_lines,omitempty"`
+	// PercentComplete is the share of revealed lines, rounded down
+	PercentComplete int32 `protobuf:"varint,5,opt,name=percent_complete,json=percentComplete,proto3" json:"percent_complete,omitempty"`
+	// NextRevealAt is the run time of the pending reveal task, if any
+	NextRevealAt github_com_iov_one_weave.UnixTime `protobuf:"varint,6,opt,name=next_reveal_at,json=nextRevealAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"next_reveal_at,omitempty"`
+	// EstimatedCompletionAt is the completion time of a completed countdown. For
+	// a countdown with a pending reveal it is the time its last line is revealed
+	// if the schedule continues as planned. Paused countdowns have no estimate.
+	EstimatedCompletionAt github_com_iov_one_weave.UnixTime `protobuf:"varint,7,opt,name=estimated_completion_at,json=estimatedCompletionAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"estimated_completion_at,omitempty"`
+	// Iteration is the number of times the countdown started over
+	Iteration int32 `protobuf:"varint,8,opt,name=iteration,proto3" json:"iteration,omitempty"`
+}
+
+func (m *CountdownProgress) Reset()         { *m = CountdownProgress{} }
+func (m *CountdownProgress) String() string { return proto.CompactTextString(m) }
+func (*CountdownProgress) ProtoMessage()    {}
+func (*CountdownProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{11}
+}
+func (m *CountdownProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CountdownProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CountdownProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CountdownProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountdownProgress.Merge(m, src)
+}
+func (m *CountdownProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *CountdownProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountdownProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountdownProgress proto.InternalMessageInfo
+
+func (m *CountdownProgress) GetCountdownID() []byte {
+	if m != nil {
+		return m.CountdownID
+	}
+	return nil
+}
+
+func (m *CountdownProgress) GetStatus() CountdownStatus {
+	if m != nil {
+		return m.Status
+	}
+	return CountdownStatus_Invalid
+}
+
+func (m *CountdownProgress) GetRevealedCount() int32 {
+	if m != nil {
+		return m.RevealedCount
+	}
+	return 0
+}
+
+func (m *CountdownProgress) GetTotalLines() int32 {
+	if m != nil {
+		return m.TotalLines
+	}
+	return 0
+}
+
+func (m *CountdownProgress) GetPercentComplete() int32 {
+	if m != nil {
+		return m.PercentComplete
+	}
+	return 0
+}
+
+func (m *CountdownProgress) GetNextRevealAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.NextRevealAt
+	}
+	return 0
+}
+
+func (m *CountdownProgress) GetEstimatedCompletionAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.EstimatedCompletionAt
+	}
+	return 0
+}
+
+func (m *CountdownProgress) GetIteration() int32 {
+	if m != nil {
+		return m.Iteration
+	}
+	return 0
+}
+
+// CountdownTask is used for representing scheduled task id. Used when adding a new line of lyrics to a countdown
+type CountdownTask struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// ID is the unique identifier of the task
+	ID []byte `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// CountdownID is the unique identifier of the task
+	CountdownID []byte `protobuf:"bytes,3,opt,name=countdown_id,json=countdownId,proto3" json:"countdown_id,omitempty"`
+	// TaskOwner is the creator of the task
+	TaskOwner github_com_iov_one_weave.Address `protobuf:"bytes,4,opt,name=task_owner,json=taskOwner,proto3,casttype=github.com/iov-one/weave.Address" json:"task_owner,omitempty"`
+}
+
+func (m *CountdownTask) Reset()         { *m = CountdownTask{} }
+func (m *CountdownTask) String() string { return proto.CompactTextString(m) }
+func (*CountdownTask) ProtoMessage()    {}
+func (*CountdownTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{12}
+}
+func (m *CountdownTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CountdownTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CountdownTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CountdownTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountdownTask.Merge(m, src)
+}
+func (m *CountdownTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *CountdownTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountdownTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountdownTask proto.InternalMessageInfo
+
+func (m *CountdownTask) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CountdownTask) GetID() []byte {
+	if m != nil {
+		return m.ID
+	}
+	return nil
+}
+
+func (m *CountdownTask) GetCountdownID() []byte {
+	if m != nil {
+		return m.CountdownID
+	}
+	return nil
+}
+
+func (m *CountdownTask) GetTaskOwner() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.TaskOwner
+	}
+	return nil
+}
+
+// Configuration is the countdown module configuration, stored with gconf
+type Configuration struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Owner is present to implement gconf.OwnedConfig interface
+	// This defines the Address that is allowed to update the Configuration object
+	Owner github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/iov-one/weave.Address" json:"owner,omitempty"`
+	// UserDeposit is the storage deposit taken per stored byte of a user
+	UserDeposit coin.Coin `protobuf:"bytes,3,opt,name=user_deposit,json=userDeposit,proto3" json:"user_deposit"`
+	// CountdownDeposit is the storage deposit taken per stored byte of a
+	// countdown
+	CountdownDeposit coin.Coin `protobuf:"bytes,4,opt,name=countdown_deposit,json=countdownDeposit,proto3" json:"countdown_deposit"`
+	// NewUserCost is the gas allocated for the creation of a user
+	NewUserCost int64 `protobuf:"varint,5,opt,name=new_user_cost,json=newUserCost,proto3" json:"new_user_cost,omitempty"`
+	// NewCountdownCost is the gas allocated for the creation of a countdown
+	NewCountdownCost int64 `protobuf:"varint,6,opt,name=new_countdown_cost,json=newCountdownCost,proto3" json:"new_countdown_cost,omitempty"`
+	// CountdownCostUnit is the number of lyrics bytes that are free of charge,
+	// every following unit adds one to the countdown creation cost. Zero
+	// disables the size based cost.
+	CountdownCostUnit int64 `protobuf:"varint,7,opt,name=countdown_cost_unit,json=countdownCostUnit,proto3" json:"countdown_cost_unit,omitempty"`
+	// MinLineLength is the minimal number of characters of a lyrics line
+	MinLineLength int32 `protobuf:"varint,8,opt,name=min_line_length,json=minLineLength,proto3" json:"min_line_length,omitempty"`
+	// MaxLineLength is the maximal number of characters of a lyrics line
+	MaxLineLength int32 `protobuf:"varint,9,opt,name=max_line_length,json=maxLineLength,proto3" json:"max_line_length,omitempty"`
+	// RevealInterval is the time between two consecutive lyrics reveals
+	RevealInterval github_com_iov_one_weave.UnixDuration `protobuf:"varint,10,opt,name=reveal_interval,json=revealInterval,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"reveal_interval,omitempty"`
+	// Moderators are allowed to hide and remove countdowns. The owner is
+	// always allowed to moderate
+	Moderators []github_com_iov_one_weave.Address `protobuf:"bytes,11,rep,name=moderators,proto3,casttype=github.com/iov-one/weave.Address" json:"moderators,omitempty"`
+	// MaxActiveCountdowns is the number of countdowns an owner can have that
+	// are not completed yet. Zero disables the limit
+	MaxActiveCountdowns int32 `protobuf:"varint,12,opt,name=max_active_countdowns,json=maxActiveCountdowns,proto3" json:"max_active_countdowns,omitempty"`
+	// MaxCountdownsPerWindow is the number of countdowns an owner can create
+	// within the creation window. Zero disables the limit
+	MaxCountdownsPerWindow int32                                 `protobuf:"varint,13,opt,name=max_countdowns_per_window,json=maxCountdownsPerWindow,proto3" json:"max_countdowns_per_window,omitempty"`
+	CreationWindow         github_com_iov_one_weave.UnixDuration `protobuf:"varint,14,opt,name=creation_window,json=creationWindow,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"creation_window,omitempty"`
+	// MaxLineBytes is the maximal size in bytes of a lyrics line. Zero limits
+	// a line to four bytes per character of the maximal line length
+	MaxLineBytes int32 `protobuf:"varint,15,opt,name=max_line_bytes,json=maxLineBytes,proto3" json:"max_line_bytes,omitempty"`
+}
+
+func (m *Configuration) Reset()         { *m = Configuration{} }
+func (m *Configuration) String() string { return proto.CompactTextString(m) }
+func (*Configuration) ProtoMessage()    {}
+func (*Configuration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{13}
+}
+func (m *Configuration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Configuration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Configuration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Configuration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Configuration.Merge(m, src)
+}
+func (m *Configuration) XXX_Size() int {
+	return m.Size()
+}
+func (m *Configuration) XXX_DiscardUnknown() {
+	xxx_messageInfo_Configuration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Configuration proto.InternalMessageInfo
+
+func (m *Configuration) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Configuration) GetOwner() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *Configuration) GetUserDeposit() coin.Coin {
+	if m != nil {
+		return m.UserDeposit
+	}
+	return coin.Coin{}
+}
+
+func (m *Configuration) GetCountdownDeposit() coin.Coin {
+	if m != nil {
+		return m.CountdownDeposit
+	}
+	return coin.Coin{}
+}
+
+func (m *Configuration) GetNewUserCost() int64 {
+	if m != nil {
+		return m.NewUserCost
+	}
+	return 0
+}
+
+func (m *Configuration) GetNewCountdownCost() int64 {
+	if m != nil {
+		return m.NewCountdownCost
+	}
+	return 0
+}
+
+func (m *Configuration) GetCountdownCostUnit() int64 {
+	if m != nil {
+		return m.CountdownCostUnit
+	}
+	return 0
+}
+
+func (m *Configuration) GetMinLineLength() int32 {
+	if m != nil {
+		return m.MinLineLength
+	}
+	return 0
+}
+
+func (m *Configuration) GetMaxLineLength() int32 {
+	if m != nil {
+		return m.MaxLineLength
+	}
+	return 0
+}
+
+func (m *Configuration) GetRevealInterval() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.RevealInterval
+	}
+	return 0
+}
+
+func (m *Configuration) GetModerators() []github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Moderators
+	}
+	return nil
+}
+
+func (m *Configuration) GetMaxActiveCountdowns() int32 {
+	if m != nil {
+		return m.MaxActiveCountdowns
+	}
+	return 0
+}
+
+func (m *Configuration) GetMaxCountdownsPerWindow() int32 {
+	if m != nil {
+		return m.MaxCountdownsPerWindow
+	}
+	return 0
+}
+
+func (m *Configuration) GetCreationWindow() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.CreationWindow
+	}
+	return 0
+}
+
+func (m *Configuration) GetMaxLineBytes() int32 {
+	if m != nil {
+		return m.MaxLineBytes
+	}
+	return 0
+}
+
+// UpdateConfigurationMsg is used by the gconf extension to update the
+// configuration.
+type UpdateConfigurationMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Patch    *Configuration  `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
+}
+
+func (m *UpdateConfigurationMsg) Reset()         { *m = UpdateConfigurationMsg{} }
+func (m *UpdateConfigurationMsg) String() string { return proto.CompactTextString(m) }
+func (*UpdateConfigurationMsg) ProtoMessage()    {}
+func (*UpdateConfigurationMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{14}
+}
+func (m *UpdateConfigurationMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateConfigurationMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateConfigurationMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateConfigurationMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateConfigurationMsg.Merge(m, src)
+}
+func (m *UpdateConfigurationMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateConfigurationMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateConfigurationMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateConfigurationMsg proto.InternalMessageInfo
+
+func (m *UpdateConfigurationMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *UpdateConfigurationMsg) GetPatch() *Configuration {
+	if m != nil {
+		return m.Patch
+	}
+	return nil
+}
+
+type CreateUserMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Username string          `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (m *CreateUserMsg) Reset()         { *m = CreateUserMsg{} }
+func (m *CreateUserMsg) String() string { return proto.CompactTextString(m) }
+func (*CreateUserMsg) ProtoMessage()    {}
+func (*CreateUserMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{15}
+}
+func (m *CreateUserMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateUserMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateUserMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateUserMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateUserMsg.Merge(m, src)
+}
+func (m *CreateUserMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateUserMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateUserMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateUserMsg proto.InternalMessageInfo
+
+func (m *CreateUserMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CreateUserMsg) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type CreateCountdownMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Title    string          `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// lyrics of the countdown
+	Lyrics []byte `protobuf:"bytes,3,opt,name=lyrics,proto3" json:"lyrics,omitempty"`
+	// MissedRevealPolicy defines how reveals missed during chain downtime are
+	// handled.
+	MissedRevealPolicy MissedRevealPolicy `protobuf:"varint,4,opt,name=missed_reveal_policy,json=missedRevealPolicy,proto3,enum=countdown.MissedRevealPolicy" json:"missed_reveal_policy,omitempty"`
+	// Owner is an optional owner address of the countdown, for example a
+	// multisig contract address. It defaults to the main signer
+	Owner github_com_iov_one_weave.Address `protobuf:"bytes,5,opt,name=owner,proto3,casttype=github.com/iov-one/weave.Address" json:"owner,omitempty"`
+	// Bounty is an optional amount locked until the countdown is completed and
+	// then paid to the beneficiary
+	Bounty []*coin.Coin `protobuf:"bytes,6,rep,name=bounty,proto3" json:"bounty,omitempty"`
+	// Beneficiary receives the bounty. Required if a bounty is set
+	Beneficiary github_com_iov_one_weave.Address `protobuf:"bytes,7,opt,name=beneficiary,proto3,casttype=github.com/iov-one/weave.Address" json:"beneficiary,omitempty"`
+	// Sponsor is an optional address the bounty is taken from. It defaults to
+	// the main signer
+	Sponsor github_com_iov_one_weave.Address `protobuf:"bytes,8,opt,name=sponsor,proto3,casttype=github.com/iov-one/weave.Address" json:"sponsor,omitempty"`
+	// DeleteAt is an optional expiration time. The countdown is deleted and its
+	// deposit refunded once it expires
+	DeleteAt github_com_iov_one_weave.UnixTime `protobuf:"varint,9,opt,name=delete_at,json=deleteAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"delete_at,omitempty"`
+	// Attachments optionally reference off-chain media for lyrics lines
+	Attachments []*Attachment `protobuf:"bytes,10,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// RepeatPolicy defines whether the countdown starts over once it completes
+	RepeatPolicy RepeatPolicy `protobuf:"varint,11,opt,name=repeat_policy,json=repeatPolicy,proto3,enum=countdown.RepeatPolicy" json:"repeat_policy,omitempty"`
+	// RepeatCount is required by the times repeat policy
+	RepeatCount int32 `protobuf:"varint,12,opt,name=repeat_count,json=repeatCount,proto3" json:"repeat_count,omitempty"`
+	// Tags are optional normalized labels, see NormalizeTag
+	Tags []string `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (m *CreateCountdownMsg) Reset()         { *m = CreateCountdownMsg{} }
+func (m *CreateCountdownMsg) String() string { return proto.CompactTextString(m) }
+func (*CreateCountdownMsg) ProtoMessage()    {}
+func (*CreateCountdownMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{16}
+}
+func (m *CreateCountdownMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateCountdownMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateCountdownMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateCountdownMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCountdownMsg.Merge(m, src)
+}
+func (m *CreateCountdownMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateCountdownMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCountdownMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCountdownMsg proto.InternalMessageInfo
+
+func (m *CreateCountdownMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CreateCountdownMsg) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *CreateCountdownMsg) GetLyrics() []byte {
+	if m != nil {
+		return m.Lyrics
+	}
+	return nil
+}
+
+func (m *CreateCountdownMsg) GetMissedRevealPolicy() MissedRevealPolicy {
+	if m != nil {
+		return m.MissedRevealPolicy
+	}
+	return MissedRevealPolicy_Invalid
+}
+
+func (m *CreateCountdownMsg) GetOwner() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *CreateCountdownMsg) GetBounty() []*coin.Coin {
+	if m != nil {
+		return m.Bounty
+	}
+	return nil
+}
+
+func (m *CreateCountdownMsg) GetBeneficiary() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return nil
+}
+
+func (m *CreateCountdownMsg) GetSponsor() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Sponsor
+	}
+	return nil
+}
+
+func (m *CreateCountdownMsg) GetDeleteAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.DeleteAt
+	}
+	return 0
+}
+
+func (m *CreateCountdownMsg) GetAttachments() []*Attachment {
+	if m != nil {
+		return m.Attachments
+	}
+	return nil
+}
+
+func (m *CreateCountdownMsg) GetRepeatPolicy() RepeatPolicy {
+	if m != nil {
+		return m.RepeatPolicy
+	}
+	return RepeatPolicy_None
+}
+
+func (m *CreateCountdownMsg) GetRepeatCount() int32 {
+	if m != nil {
+		return m.RepeatCount
+	}
+	return 0
+}
+
+func (m *CreateCountdownMsg) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+// DeleteCountdownMsg message deletes a countdown
+type DeleteCountdownMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// ID is the unique identifier of the task
+	ID []byte `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *DeleteCountdownMsg) Reset()         { *m = DeleteCountdownMsg{} }
+func (m *DeleteCountdownMsg) String() string { return proto.CompactTextString(m) }
+func (*DeleteCountdownMsg) ProtoMessage()    {}
+func (*DeleteCountdownMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{17}
+}
+func (m *DeleteCountdownMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteCountdownMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteCountdownMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteCountdownMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCountdownMsg.Merge(m, src)
+}
+func (m *DeleteCountdownMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteCountdownMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCountdownMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCountdownMsg proto.InternalMessageInfo
+
+func (m *DeleteCountdownMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *DeleteCountdownMsg) GetID() []byte {
+	if m != nil {
+		return m.ID
+	}
+	return nil
+}
+
+// GrantRoleMsg grants a role on a countdown to an address. If the address
+// already has a role, it is replaced
+type GrantRoleMsg struct {
+	Metadata    *weave.Metadata                  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	CountdownID []byte                           `protobuf:"bytes,2,opt,name=countdown_id,json=countdownId,proto3" json:"countdown_id,omitempty"`
+	Address     github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	Role        EditorRole                       `protobuf:"varint,4,opt,name=role,proto3,enum=countdown.EditorRole" json:"role,omitempty"`
+}
+
+func (m *GrantRoleMsg) Reset()         { *m = GrantRoleMsg{} }
+func (m *GrantRoleMsg) String() string { return proto.CompactTextString(m) }
+func (*GrantRoleMsg) ProtoMessage()    {}
+func (*GrantRoleMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{18}
+}
+func (m *GrantRoleMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GrantRoleMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GrantRoleMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GrantRoleMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantRoleMsg.Merge(m, src)
+}
+func (m *GrantRoleMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *GrantRoleMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantRoleMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantRoleMsg proto.InternalMessageInfo
+
+func (m *GrantRoleMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *GrantRoleMsg) GetCountdownID() []byte {
+	if m != nil {
+		return m.CountdownID
+	}
+	return nil
+}
+
+func (m *GrantRoleMsg) GetAddress() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *GrantRoleMsg) GetRole() EditorRole {
+	if m != nil {
+		return m.Role
+	}
+	return EditorRole_Invalid
+}
+
+// RevokeRoleMsg revokes the role of an address on a countdown
+type RevokeRoleMsg struct {
+	Metadata    *weave.Metadata                  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	CountdownID []byte                           `protobuf:"bytes,2,opt,name=countdown_id,json=countdownId,proto3" json:"countdown_id,omitempty"`
+	Address     github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+}
+
+func (m *RevokeRoleMsg) Reset()         { *m = RevokeRoleMsg{} }
+func (m *RevokeRoleMsg) String() string { return proto.CompactTextString(m) }
+func (*RevokeRoleMsg) ProtoMessage()    {}
+func (*RevokeRoleMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{19}
+}
+func (m *RevokeRoleMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevokeRoleMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevokeRoleMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevokeRoleMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeRoleMsg.Merge(m, src)
+}
+func (m *RevokeRoleMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevokeRoleMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeRoleMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeRoleMsg proto.InternalMessageInfo
+
+func (m *RevokeRoleMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *RevokeRoleMsg) GetCountdownID() []byte {
+	if m != nil {
+		return m.CountdownID
+	}
+	return nil
+}
+
+func (m *RevokeRoleMsg) GetAddress() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+// UpdateLyricsMsg replaces the lyrics of a countdown. Lines that are already
+// revealed cannot be changed
+type UpdateLyricsMsg struct {
+	Metadata    *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	CountdownID []byte          `protobuf:"bytes,2,opt,name=countdown_id,json=countdownId,proto3" json:"countdown_id,omitempty"`
+	Lyrics      []byte          `protobuf:"bytes,3,opt,name=lyrics,proto3" json:"lyrics,omitempty"`
+	// Attachments replace the attachments of the lines that are not revealed
+	// yet. Attachments of revealed lines are kept
+	Attachments []*Attachment `protobuf:"bytes,4,rep,name=attachments,proto3" json:"attachments,omitempty"`
+}
+
+func (m *UpdateLyricsMsg) Reset()         { *m = UpdateLyricsMsg{} }
+func (m *UpdateLyricsMsg) String() string { return proto.CompactTextString(m) }
+func (*UpdateLyricsMsg) ProtoMessage()    {}
+func (*UpdateLyricsMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{20}
+}
+func (m *UpdateLyricsMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateLyricsMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateLyricsMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateLyricsMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateLyricsMsg.Merge(m, src)
+}
+func (m *UpdateLyricsMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateLyricsMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateLyricsMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateLyricsMsg proto.InternalMessageInfo
+
+func (m *UpdateLyricsMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *UpdateLyricsMsg) GetCountdownID() []byte {
+	if m != nil {
+		return m.CountdownID
+	}
+	return nil
+}
+
+func (m *UpdateLyricsMsg) GetLyrics() []byte {
+	if m != nil {
+		return m.Lyrics
+	}
+	return nil
+}
+
+func (m *UpdateLyricsMsg) GetAttachments() []*Attachment {
+	if m != nil {
+		return m.Attachments
+	}
+	return nil
+}
+
+// PauseCountdownMsg stops revealing lines of a countdown until it is resumed
+type PauseCountdownMsg struct {
+	Metadata    *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	CountdownID []byte          `protobuf:"bytes,2,opt,name=countdown_id,json=countdownId,proto3" json:"countdown_id,omitempty"`
+}
+
+func (m *PauseCountdownMsg) Reset()         { *m = PauseCountdownMsg{} }
+func (m *PauseCountdownMsg) String() string { return proto.CompactTextString(m) }
+func (*PauseCountdownMsg) ProtoMessage()    {}
+func (*PauseCountdownMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{21}
+}
+func (m *PauseCountdownMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseCountdownMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseCountdownMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PauseCountdownMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseCountdownMsg.Merge(m, src)
+}
+func (m *PauseCountdownMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseCountdownMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseCountdownMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseCountdownMsg proto.InternalMessageInfo
+
+func (m *PauseCountdownMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *PauseCountdownMsg) GetCountdownID() []byte {
+	if m != nil {
+		return m.CountdownID
+	}
+	return nil
+}
+
+// ResumeCountdownMsg continues revealing lines of a paused countdown
+type ResumeCountdownMsg struct {
+	Metadata    *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	CountdownID []byte          `protobuf:"bytes,2,opt,name=countdown_id,json=countdownId,proto3" json:"countdown_id,omitempty"`
+}
+
+func (m *ResumeCountdownMsg) Reset()         { *m = ResumeCountdownMsg{} }
+func (m *ResumeCountdownMsg) String() string { return proto.CompactTextString(m) }
+func (*ResumeCountdownMsg) ProtoMessage()    {}
+func (*ResumeCountdownMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{22}
+}
+func (m *ResumeCountdownMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResumeCountdownMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResumeCountdownMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResumeCountdownMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeCountdownMsg.Merge(m, src)
+}
+func (m *ResumeCountdownMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResumeCountdownMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeCountdownMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeCountdownMsg proto.InternalMessageInfo
+
+func (m *ResumeCountdownMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ResumeCountdownMsg) GetCountdownID() []byte {
+	if m != nil {
+		return m.CountdownID
+	}
+	return nil
+}
+
+// TransferCountdownMsg transfers the ownership of a countdown to a new owner.
+// If require_accept is set, the transfer completes only once the new owner
+// accepts it with AcceptCountdownTransferMsg
+type TransferCountdownMsg struct {
+	Metadata      *weave.Metadata                  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	CountdownID   []byte                           `protobuf:"bytes,2,opt,name=countdown_id,json=countdownId,proto3" json:"countdown_id,omitempty"`
+	NewOwner      github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3,casttype=github.com/iov-one/weave.Address" json:"new_owner,omitempty"`
+	RequireAccept bool                             `protobuf:"varint,4,opt,name=require_accept,json=requireAccept,proto3" json:"require_accept,omitempty"`
+}
+
+func (m *TransferCountdownMsg) Reset()         { *m = TransferCountdownMsg{} }
+func (m *TransferCountdownMsg) String() string { return proto.CompactTextString(m) }
+func (*TransferCountdownMsg) ProtoMessage()    {}
+func (*TransferCountdownMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{23}
+}
+func (m *TransferCountdownMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferCountdownMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferCountdownMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferCountdownMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferCountdownMsg.Merge(m, src)
+}
+func (m *TransferCountdownMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferCountdownMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferCountdownMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferCountdownMsg proto.InternalMessageInfo
+
+func (m *TransferCountdownMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *TransferCountdownMsg) GetCountdownID() []byte {
+	if m != nil {
+		return m.CountdownID
+	}
+	return nil
+}
+
+func (m *TransferCountdownMsg) GetNewOwner() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.NewOwner
+	}
+	return nil
+}
+
+func (m *TransferCountdownMsg) GetRequireAccept() bool {
+	if m != nil {
+		return m.RequireAccept
+	}
+	return false
+}
+
+// AcceptCountdownTransferMsg completes a pending transfer of a countdown
+type AcceptCountdownTransferMsg struct {
+	Metadata    *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	CountdownID []byte          `protobuf:"bytes,2,opt,name=countdown_id,json=countdownId,proto3" json:"countdown_id,omitempty"`
+}
+
+func (m *AcceptCountdownTransferMsg) Reset()         { *m = AcceptCountdownTransferMsg{} }
+func (m *AcceptCountdownTransferMsg) String() string { return proto.CompactTextString(m) }
+func (*AcceptCountdownTransferMsg) ProtoMessage()    {}
+func (*AcceptCountdownTransferMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{24}
+}
+func (m *AcceptCountdownTransferMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AcceptCountdownTransferMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AcceptCountdownTransferMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AcceptCountdownTransferMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptCountdownTransferMsg.Merge(m, src)
+}
+func (m *AcceptCountdownTransferMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *AcceptCountdownTransferMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptCountdownTransferMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptCountdownTransferMsg proto.InternalMessageInfo
+
+func (m *AcceptCountdownTransferMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *AcceptCountdownTransferMsg) GetCountdownID() []byte {
+	if m != nil {
+		return m.CountdownID
+	}
+	return nil
+}
+
+// TipCountdownMsg moves coins from the tipper to the owner of a countdown
+type TipCountdownMsg struct {
+	Metadata    *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	CountdownID []byte          `protobuf:"bytes,2,opt,name=countdown_id,json=countdownId,proto3" json:"countdown_id,omitempty"`
+	// Tipper is the address the tip is taken from. Defaults to the main signer
+	Tipper github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=tipper,proto3,casttype=github.com/iov-one/weave.Address" json:"tipper,omitempty"`
+	Amount *coin.Coin                       `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *TipCountdownMsg) Reset()         { *m = TipCountdownMsg{} }
+func (m *TipCountdownMsg) String() string { return proto.CompactTextString(m) }
+func (*TipCountdownMsg) ProtoMessage()    {}
+func (*TipCountdownMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{25}
+}
+func (m *TipCountdownMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TipCountdownMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TipCountdownMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TipCountdownMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TipCountdownMsg.Merge(m, src)
+}
+func (m *TipCountdownMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *TipCountdownMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_TipCountdownMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TipCountdownMsg proto.InternalMessageInfo
+
+func (m *TipCountdownMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *TipCountdownMsg) GetCountdownID() []byte {
+	if m != nil {
+		return m.CountdownID
+	}
+	return nil
+}
+
+func (m *TipCountdownMsg) GetTipper() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Tipper
+	}
+	return nil
+}
+
+func (m *TipCountdownMsg) GetAmount() *coin.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// SetUnlockPriceMsg sets the price of revealing the next line of a countdown
+// ahead of schedule. An empty price disables early unlocks
+type SetUnlockPriceMsg struct {
+	Metadata    *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	CountdownID []byte          `protobuf:"bytes,2,opt,name=countdown_id,json=countdownId,proto3" json:"countdown_id,omitempty"`
+	Price       *coin.Coin      `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (m *SetUnlockPriceMsg) Reset()         { *m = SetUnlockPriceMsg{} }
+func (m *SetUnlockPriceMsg) String() string { return proto.CompactTextString(m) }
+func (*SetUnlockPriceMsg) ProtoMessage()    {}
+func (*SetUnlockPriceMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{26}
+}
+func (m *SetUnlockPriceMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetUnlockPriceMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetUnlockPriceMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetUnlockPriceMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetUnlockPriceMsg.Merge(m, src)
+}
+func (m *SetUnlockPriceMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetUnlockPriceMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetUnlockPriceMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetUnlockPriceMsg proto.InternalMessageInfo
+
+func (m *SetUnlockPriceMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *SetUnlockPriceMsg) GetCountdownID() []byte {
+	if m != nil {
+		return m.CountdownID
+	}
+	return nil
+}
+
+func (m *SetUnlockPriceMsg) GetPrice() *coin.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+// UnlockNextLineMsg pays the countdown owner to reveal the next line ahead of
+// schedule
+type UnlockNextLineMsg struct {
+	Metadata    *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	CountdownID []byte          `protobuf:"bytes,2,opt,name=countdown_id,json=countdownId,proto3" json:"countdown_id,omitempty"`
+	// Payer is the address the price is taken from. Defaults to the main signer
+	Payer github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=payer,proto3,casttype=github.com/iov-one/weave.Address" json:"payer,omitempty"`
+}
+
+func (m *UnlockNextLineMsg) Reset()         { *m = UnlockNextLineMsg{} }
+func (m *UnlockNextLineMsg) String() string { return proto.CompactTextString(m) }
+func (*UnlockNextLineMsg) ProtoMessage()    {}
+func (*UnlockNextLineMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{27}
+}
+func (m *UnlockNextLineMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnlockNextLineMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnlockNextLineMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnlockNextLineMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockNextLineMsg.Merge(m, src)
+}
+func (m *UnlockNextLineMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnlockNextLineMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockNextLineMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlockNextLineMsg proto.InternalMessageInfo
+
+func (m *UnlockNextLineMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *UnlockNextLineMsg) GetCountdownID() []byte {
+	if m != nil {
+		return m.CountdownID
+	}
+	return nil
+}
+
+func (m *UnlockNextLineMsg) GetPayer() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Payer
+	}
+	return nil
+}
+
+// DeleteUserMsg deletes a user and refunds its deposit
+type DeleteUserMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ID       []byte          `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *DeleteUserMsg) Reset()         { *m = DeleteUserMsg{} }
+func (m *DeleteUserMsg) String() string { return proto.CompactTextString(m) }
+func (*DeleteUserMsg) ProtoMessage()    {}
+func (*DeleteUserMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{28}
+}
+func (m *DeleteUserMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteUserMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteUserMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteUserMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteUserMsg.Merge(m, src)
+}
+func (m *DeleteUserMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteUserMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteUserMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteUserMsg proto.InternalMessageInfo
+
+func (m *DeleteUserMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *DeleteUserMsg) GetID() []byte {
+	if m != nil {
+		return m.ID
+	}
+	return nil
+}
+
+// FlagCountdownMsg reports a countdown to the moderators
+type FlagCountdownMsg struct {
+	Metadata    *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	CountdownID []byte          `protobuf:"bytes,2,opt,name=countdown_id,json=countdownId,proto3" json:"countdown_id,omitempty"`
+	// Reporter is the address flagging the countdown. Defaults to the main
+	// signer
+	Reporter github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=reporter,proto3,casttype=github.com/iov-one/weave.Address" json:"reporter,omitempty"`
+	Reason   string                           `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *FlagCountdownMsg) Reset()         { *m = FlagCountdownMsg{} }
+func (m *FlagCountdownMsg) String() string { return proto.CompactTextString(m) }
+func (*FlagCountdownMsg) ProtoMessage()    {}
+func (*FlagCountdownMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{29}
+}
+func (m *FlagCountdownMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlagCountdownMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlagCountdownMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FlagCountdownMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlagCountdownMsg.Merge(m, src)
+}
+func (m *FlagCountdownMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlagCountdownMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlagCountdownMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlagCountdownMsg proto.InternalMessageInfo
+
+func (m *FlagCountdownMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *FlagCountdownMsg) GetCountdownID() []byte {
+	if m != nil {
+		return m.CountdownID
+	}
+	return nil
+}
+
+func (m *FlagCountdownMsg) GetReporter() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Reporter
+	}
+	return nil
+}
+
+func (m *FlagCountdownMsg) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// ModerateCountdownMsg applies a moderator decision to a countdown
+type ModerateCountdownMsg struct {
+	Metadata    *weave.Metadata  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	CountdownID []byte           `protobuf:"bytes,2,opt,name=countdown_id,json=countdownId,proto3" json:"countdown_id,omitempty"`
+	Action      ModerationAction `protobuf:"varint,3,opt,name=action,proto3,enum=countdown.ModerationAction" json:"action,omitempty"`
+	Reason      string           `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *ModerateCountdownMsg) Reset()         { *m = ModerateCountdownMsg{} }
+func (m *ModerateCountdownMsg) String() string { return proto.CompactTextString(m) }
+func (*ModerateCountdownMsg) ProtoMessage()    {}
+func (*ModerateCountdownMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{30}
+}
+func (m *ModerateCountdownMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModerateCountdownMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModerateCountdownMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModerateCountdownMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModerateCountdownMsg.Merge(m, src)
+}
+func (m *ModerateCountdownMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModerateCountdownMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModerateCountdownMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModerateCountdownMsg proto.InternalMessageInfo
+
+func (m *ModerateCountdownMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ModerateCountdownMsg) GetCountdownID() []byte {
+	if m != nil {
+		return m.CountdownID
+	}
+	return nil
+}
+
+func (m *ModerateCountdownMsg) GetAction() ModerationAction {
+	if m != nil {
+		return m.Action
+	}
+	return ModerationAction_Invalid
+}
+
+func (m *ModerateCountdownMsg) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// CreateCountdownDraftMsg creates a draft that lyrics chunks can be appended
+// to. The fields have the same meaning as in CreateCountdownMsg
+type CreateCountdownDraftMsg struct {
+	Metadata           *weave.Metadata                   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Title              string                            `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	MissedRevealPolicy MissedRevealPolicy                `protobuf:"varint,3,opt,name=missed_reveal_policy,json=missedRevealPolicy,proto3,enum=countdown.MissedRevealPolicy" json:"missed_reveal_policy,omitempty"`
+	Owner              github_com_iov_one_weave.Address  `protobuf:"bytes,4,opt,name=owner,proto3,casttype=github.com/iov-one/weave.Address" json:"owner,omitempty"`
+	Bounty             []*coin.Coin                      `protobuf:"bytes,5,rep,name=bounty,proto3" json:"bounty,omitempty"`
+	Beneficiary        github_com_iov_one_weave.Address  `protobuf:"bytes,6,opt,name=beneficiary,proto3,casttype=github.com/iov-one/weave.Address" json:"beneficiary,omitempty"`
+	Sponsor            github_com_iov_one_weave.Address  `protobuf:"bytes,7,opt,name=sponsor,proto3,casttype=github.com/iov-one/weave.Address" json:"sponsor,omitempty"`
+	DeleteAt           github_com_iov_one_weave.UnixTime `protobuf:"varint,8,opt,name=delete_at,json=deleteAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"delete_at,omitempty"`
+	RepeatPolicy       RepeatPolicy                      `protobuf:"varint,9,opt,name=repeat_policy,json=repeatPolicy,proto3,enum=countdown.RepeatPolicy" json:"repeat_policy,omitempty"`
+	RepeatCount        int32                             `protobuf:"varint,10,opt,name=repeat_count,json=repeatCount,proto3" json:"repeat_count,omitempty"`
+	Tags               []string                          `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (m *CreateCountdownDraftMsg) Reset()         { *m = CreateCountdownDraftMsg{} }
+func (m *CreateCountdownDraftMsg) String() string { return proto.CompactTextString(m) }
+func (*CreateCountdownDraftMsg) ProtoMessage()    {}
+func (*CreateCountdownDraftMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{31}
+}
+func (m *CreateCountdownDraftMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateCountdownDraftMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateCountdownDraftMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateCountdownDraftMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCountdownDraftMsg.Merge(m, src)
+}
+func (m *CreateCountdownDraftMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateCountdownDraftMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCountdownDraftMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCountdownDraftMsg proto.InternalMessageInfo
+
+func (m *CreateCountdownDraftMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CreateCountdownDraftMsg) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *CreateCountdownDraftMsg) GetMissedRevealPolicy() MissedRevealPolicy {
+	if m != nil {
+		return m.MissedRevealPolicy
+	}
+	return MissedRevealPolicy_Invalid
+}
+
+func (m *CreateCountdownDraftMsg) GetOwner() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *CreateCountdownDraftMsg) GetBounty() []*coin.Coin {
+	if m != nil {
+		return m.Bounty
+	}
+	return nil
+}
+
+func (m *CreateCountdownDraftMsg) GetBeneficiary() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return nil
+}
+
+func (m *CreateCountdownDraftMsg) GetSponsor() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Sponsor
+	}
+	return nil
+}
+
+func (m *CreateCountdownDraftMsg) GetDeleteAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.DeleteAt
+	}
+	return 0
+}
+
+func (m *CreateCountdownDraftMsg) GetRepeatPolicy() RepeatPolicy {
+	if m != nil {
+		return m.RepeatPolicy
+	}
+	return RepeatPolicy_None
+}
+
+func (m *CreateCountdownDraftMsg) GetRepeatCount() int32 {
+	if m != nil {
+		return m.RepeatCount
+	}
+	return 0
+}
+
+func (m *CreateCountdownDraftMsg) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+// AppendLyricsChunkMsg appends lines to the lyrics of a draft. Chunks must be
+// appended in order
+type AppendLyricsChunkMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	DraftID  []byte          `protobuf:"bytes,2,opt,name=draft_id,json=draftId,proto3" json:"draft_id,omitempty"`
+	// Index is the position of the chunk, starting at zero
+	Index int32 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// Lyrics are the lines of the chunk, JSON encoded
+	Lyrics []byte `protobuf:"bytes,4,opt,name=lyrics,proto3" json:"lyrics,omitempty"`
+	// Hash is the sha256 hash of the chunk lyrics
+	Hash []byte `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *AppendLyricsChunkMsg) Reset()         { *m = AppendLyricsChunkMsg{} }
+func (m *AppendLyricsChunkMsg) String() string { return proto.CompactTextString(m) }
+func (*AppendLyricsChunkMsg) ProtoMessage()    {}
+func (*AppendLyricsChunkMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{32}
+}
+func (m *AppendLyricsChunkMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppendLyricsChunkMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppendLyricsChunkMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppendLyricsChunkMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppendLyricsChunkMsg.Merge(m, src)
+}
+func (m *AppendLyricsChunkMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppendLyricsChunkMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppendLyricsChunkMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppendLyricsChunkMsg proto.InternalMessageInfo
+
+func (m *AppendLyricsChunkMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *AppendLyricsChunkMsg) GetDraftID() []byte {
+	if m != nil {
+		return m.DraftID
+	}
+	return nil
+}
+
+func (m *AppendLyricsChunkMsg) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *AppendLyricsChunkMsg) GetLyrics() []byte {
+	if m != nil {
+		return m.Lyrics
+	}
+	return nil
+}
+
+func (m *AppendLyricsChunkMsg) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+// PublishCountdownMsg turns a draft into a countdown and starts its schedule
+type PublishCountdownMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	DraftID  []byte          `protobuf:"bytes,2,opt,name=draft_id,json=draftId,proto3" json:"draft_id,omitempty"`
+	// LyricsHash must match the chained hash of all appended chunks
+	LyricsHash []byte `protobuf:"bytes,3,opt,name=lyrics_hash,json=lyricsHash,proto3" json:"lyrics_hash,omitempty"`
+	// Attachments optionally reference off-chain media for lyrics lines
+	Attachments []*Attachment `protobuf:"bytes,4,rep,name=attachments,proto3" json:"attachments,omitempty"`
+}
+
+func (m *PublishCountdownMsg) Reset()         { *m = PublishCountdownMsg{} }
+func (m *PublishCountdownMsg) String() string { return proto.CompactTextString(m) }
+func (*PublishCountdownMsg) ProtoMessage()    {}
+func (*PublishCountdownMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{33}
+}
+func (m *PublishCountdownMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PublishCountdownMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PublishCountdownMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PublishCountdownMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishCountdownMsg.Merge(m, src)
+}
+func (m *PublishCountdownMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *PublishCountdownMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishCountdownMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublishCountdownMsg proto.InternalMessageInfo
+
+func (m *PublishCountdownMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *PublishCountdownMsg) GetDraftID() []byte {
+	if m != nil {
+		return m.DraftID
+	}
+	return nil
+}
+
+func (m *PublishCountdownMsg) GetLyricsHash() []byte {
+	if m != nil {
+		return m.LyricsHash
+	}
+	return nil
+}
+
+func (m *PublishCountdownMsg) GetAttachments() []*Attachment {
+	if m != nil {
+		return m.Attachments
+	}
+	return nil
+}
+
+// DeleteCountdownDraftMsg deletes a draft that is not published and refunds
+// its storage deposit
+type DeleteCountdownDraftMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	DraftID  []byte          `protobuf:"bytes,2,opt,name=draft_id,json=draftId,proto3" json:"draft_id,omitempty"`
+}
+
+func (m *DeleteCountdownDraftMsg) Reset()         { *m = DeleteCountdownDraftMsg{} }
+func (m *DeleteCountdownDraftMsg) String() string { return proto.CompactTextString(m) }
+func (*DeleteCountdownDraftMsg) ProtoMessage()    {}
+func (*DeleteCountdownDraftMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{34}
+}
+func (m *DeleteCountdownDraftMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteCountdownDraftMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteCountdownDraftMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteCountdownDraftMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCountdownDraftMsg.Merge(m, src)
+}
+func (m *DeleteCountdownDraftMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteCountdownDraftMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCountdownDraftMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCountdownDraftMsg proto.InternalMessageInfo
+
+func (m *DeleteCountdownDraftMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *DeleteCountdownDraftMsg) GetDraftID() []byte {
+	if m != nil {
+		return m.DraftID
+	}
+	return nil
+}
+
+// SetSuccessorMsg chains a countdown that did not start yet to another
+// countdown. The successor's first reveal is scheduled when the countdown
+// completes. An empty successor ID removes the successor, which then starts
+// right away
+type SetSuccessorMsg struct {
+	Metadata    *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	CountdownID []byte          `protobuf:"bytes,2,opt,name=countdown_id,json=countdownId,proto3" json:"countdown_id,omitempty"`
+	SuccessorID []byte          `protobuf:"bytes,3,opt,name=successor_id,json=successorId,proto3" json:"successor_id,omitempty"`
+}
+
+func (m *SetSuccessorMsg) Reset()         { *m = SetSuccessorMsg{} }
+func (m *SetSuccessorMsg) String() string { return proto.CompactTextString(m) }
+func (*SetSuccessorMsg) ProtoMessage()    {}
+func (*SetSuccessorMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{35}
+}
+func (m *SetSuccessorMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetSuccessorMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetSuccessorMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetSuccessorMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetSuccessorMsg.Merge(m, src)
+}
+func (m *SetSuccessorMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetSuccessorMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetSuccessorMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetSuccessorMsg proto.InternalMessageInfo
+
+func (m *SetSuccessorMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *SetSuccessorMsg) GetCountdownID() []byte {
+	if m != nil {
+		return m.CountdownID
+	}
+	return nil
+}
+
+func (m *SetSuccessorMsg) GetSuccessorID() []byte {
+	if m != nil {
+		return m.SuccessorID
+	}
+	return nil
+}
+
+// RestartCountdownMsg starts the next iteration of a completed countdown
+// right away. The countdown's repeat policy must allow another iteration
+type RestartCountdownMsg struct {
+	Metadata    *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	CountdownID []byte          `protobuf:"bytes,2,opt,name=countdown_id,json=countdownId,proto3" json:"countdown_id,omitempty"`
+}
+
+func (m *RestartCountdownMsg) Reset()         { *m = RestartCountdownMsg{} }
+func (m *RestartCountdownMsg) String() string { return proto.CompactTextString(m) }
+func (*RestartCountdownMsg) ProtoMessage()    {}
+func (*RestartCountdownMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{36}
+}
+func (m *RestartCountdownMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestartCountdownMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestartCountdownMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestartCountdownMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestartCountdownMsg.Merge(m, src)
+}
+func (m *RestartCountdownMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestartCountdownMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestartCountdownMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestartCountdownMsg proto.InternalMessageInfo
+
+func (m *RestartCountdownMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *RestartCountdownMsg) GetCountdownID() []byte {
+	if m != nil {
+		return m.CountdownID
+	}
+	return nil
+}
+
+// ForkCountdownMsg creates a countdown owned by the main signer from the
+// revealed lines of another countdown. The source is recorded in the new
+// countdown for attribution
+type ForkCountdownMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// SourceID is the countdown the lyrics are copied from
+	SourceID           []byte             `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Title              string             `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	MissedRevealPolicy MissedRevealPolicy `protobuf:"varint,4,opt,name=missed_reveal_policy,json=missedRevealPolicy,proto3,enum=countdown.MissedRevealPolicy" json:"missed_reveal_policy,omitempty"`
+	// Lines are the zero based indexes of the source lines to copy, in the
+	// order of the new lyrics. All revealed lines are copied if empty
+	Lines []int32 `protobuf:"varint,5,rep,packed,name=lines,proto3" json:"lines,omitempty"`
+	// Edits replace the text of copied lines
+	Edits []*LineEdit `protobuf:"bytes,6,rep,name=edits,proto3" json:"edits,omitempty"`
+}
+
+func (m *ForkCountdownMsg) Reset()         { *m = ForkCountdownMsg{} }
+func (m *ForkCountdownMsg) String() string { return proto.CompactTextString(m) }
+func (*ForkCountdownMsg) ProtoMessage()    {}
+func (*ForkCountdownMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{37}
+}
+func (m *ForkCountdownMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForkCountdownMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForkCountdownMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForkCountdownMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkCountdownMsg.Merge(m, src)
+}
+func (m *ForkCountdownMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForkCountdownMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkCountdownMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForkCountdownMsg proto.InternalMessageInfo
+
+func (m *ForkCountdownMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ForkCountdownMsg) GetSourceID() []byte {
+	if m != nil {
+		return m.SourceID
+	}
+	return nil
+}
+
+func (m *ForkCountdownMsg) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ForkCountdownMsg) GetMissedRevealPolicy() MissedRevealPolicy {
+	if m != nil {
+		return m.MissedRevealPolicy
+	}
+	return MissedRevealPolicy_Invalid
+}
+
+func (m *ForkCountdownMsg) GetLines() []int32 {
+	if m != nil {
+		return m.Lines
+	}
+	return nil
+}
+
+func (m *ForkCountdownMsg) GetEdits() []*LineEdit {
+	if m != nil {
+		return m.Edits
+	}
+	return nil
+}
+
+// LineEdit replaces the text of a lyrics line
+type LineEdit struct {
+	// Line is the zero based index of the line in the new lyrics
+	Line int32  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (m *LineEdit) Reset()         { *m = LineEdit{} }
+func (m *LineEdit) String() string { return proto.CompactTextString(m) }
+func (*LineEdit) ProtoMessage()    {}
+func (*LineEdit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{38}
+}
+func (m *LineEdit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LineEdit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LineEdit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LineEdit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LineEdit.Merge(m, src)
+}
+func (m *LineEdit) XXX_Size() int {
+	return m.Size()
+}
+func (m *LineEdit) XXX_DiscardUnknown() {
+	xxx_messageInfo_LineEdit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LineEdit proto.InternalMessageInfo
+
+func (m *LineEdit) GetLine() int32 {
+	if m != nil {
+		return m.Line
+	}
+	return 0
+}
+
+func (m *LineEdit) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+// CreateSeriesMsg creates a series of countdowns
+type CreateSeriesMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Title    string          `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// Owner is optional, defaults to the main signer
+	Owner github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=owner,proto3,casttype=github.com/iov-one/weave.Address" json:"owner,omitempty"`
+	// CountdownIDs are the initial members of the series, in order
+	CountdownIDs [][]byte `protobuf:"bytes,4,rep,name=countdown_ids,json=countdownIds,proto3" json:"countdown_ids,omitempty"`
+}
+
+func (m *CreateSeriesMsg) Reset()         { *m = CreateSeriesMsg{} }
+func (m *CreateSeriesMsg) String() string { return proto.CompactTextString(m) }
+func (*CreateSeriesMsg) ProtoMessage()    {}
+func (*CreateSeriesMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{39}
+}
+func (m *CreateSeriesMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateSeriesMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateSeriesMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateSeriesMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSeriesMsg.Merge(m, src)
+}
+func (m *CreateSeriesMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateSeriesMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSeriesMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateSeriesMsg proto.InternalMessageInfo
+
+func (m *CreateSeriesMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CreateSeriesMsg) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *CreateSeriesMsg) GetOwner() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *CreateSeriesMsg) GetCountdownIDs() [][]byte {
+	if m != nil {
+		return m.CountdownIDs
+	}
+	return nil
+}
+
+// AddSeriesCountdownMsg adds a countdown to a series
+type AddSeriesCountdownMsg struct {
+	Metadata    *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	SeriesID    []byte          `protobuf:"bytes,2,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	CountdownID []byte          `protobuf:"bytes,3,opt,name=countdown_id,json=countdownId,proto3" json:"countdown_id,omitempty"`
+	// Position is the zero based index the countdown is inserted at. Members
+	// from that index on move back. A position past the last member appends.
+	Position int32 `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (m *AddSeriesCountdownMsg) Reset()         { *m = AddSeriesCountdownMsg{} }
+func (m *AddSeriesCountdownMsg) String() string { return proto.CompactTextString(m) }
+func (*AddSeriesCountdownMsg) ProtoMessage()    {}
+func (*AddSeriesCountdownMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{40}
+}
+func (m *AddSeriesCountdownMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddSeriesCountdownMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddSeriesCountdownMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddSeriesCountdownMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddSeriesCountdownMsg.Merge(m, src)
+}
+func (m *AddSeriesCountdownMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddSeriesCountdownMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddSeriesCountdownMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddSeriesCountdownMsg proto.InternalMessageInfo
+
+func (m *AddSeriesCountdownMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *AddSeriesCountdownMsg) GetSeriesID() []byte {
+	if m != nil {
+		return m.SeriesID
+	}
+	return nil
+}
+
+func (m *AddSeriesCountdownMsg) GetCountdownID() []byte {
+	if m != nil {
+		return m.CountdownID
+	}
+	return nil
+}
+
+func (m *AddSeriesCountdownMsg) GetPosition() int32 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+// RemoveSeriesCountdownMsg removes a countdown from a series
+type RemoveSeriesCountdownMsg struct {
+	Metadata    *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	SeriesID    []byte          `protobuf:"bytes,2,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	CountdownID []byte          `protobuf:"bytes,3,opt,name=countdown_id,json=countdownId,proto3" json:"countdown_id,omitempty"`
+}
+
+func (m *RemoveSeriesCountdownMsg) Reset()         { *m = RemoveSeriesCountdownMsg{} }
+func (m *RemoveSeriesCountdownMsg) String() string { return proto.CompactTextString(m) }
+func (*RemoveSeriesCountdownMsg) ProtoMessage()    {}
+func (*RemoveSeriesCountdownMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{41}
+}
+func (m *RemoveSeriesCountdownMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveSeriesCountdownMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveSeriesCountdownMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveSeriesCountdownMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveSeriesCountdownMsg.Merge(m, src)
+}
+func (m *RemoveSeriesCountdownMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveSeriesCountdownMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveSeriesCountdownMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveSeriesCountdownMsg proto.InternalMessageInfo
+
+func (m *RemoveSeriesCountdownMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *RemoveSeriesCountdownMsg) GetSeriesID() []byte {
+	if m != nil {
+		return m.SeriesID
+	}
+	return nil
+}
+
+func (m *RemoveSeriesCountdownMsg) GetCountdownID() []byte {
+	if m != nil {
+		return m.CountdownID
+	}
+	return nil
+}
+
+// ReorderSeriesMsg sets the order of the members of a series
+type ReorderSeriesMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	SeriesID []byte          `protobuf:"bytes,2,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// CountdownIDs are all members of the series, in the new order
+	CountdownIDs [][]byte `protobuf:"bytes,3,rep,name=countdown_ids,json=countdownIds,proto3" json:"countdown_ids,omitempty"`
+}
+
+func (m *ReorderSeriesMsg) Reset()         { *m = ReorderSeriesMsg{} }
+func (m *ReorderSeriesMsg) String() string { return proto.CompactTextString(m) }
+func (*ReorderSeriesMsg) ProtoMessage()    {}
+func (*ReorderSeriesMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{42}
+}
+func (m *ReorderSeriesMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReorderSeriesMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReorderSeriesMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReorderSeriesMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReorderSeriesMsg.Merge(m, src)
+}
+func (m *ReorderSeriesMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReorderSeriesMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReorderSeriesMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReorderSeriesMsg proto.InternalMessageInfo
+
+func (m *ReorderSeriesMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ReorderSeriesMsg) GetSeriesID() []byte {
+	if m != nil {
+		return m.SeriesID
+	}
+	return nil
+}
+
+func (m *ReorderSeriesMsg) GetCountdownIDs() [][]byte {
+	if m != nil {
+		return m.CountdownIDs
+	}
+	return nil
+}
+
+// MigrateCountdownsMsg stores a range of countdowns again, migrating them to
+// the current schema and updating their index entries
+type MigrateCountdownsMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// StartID is the ID of the first countdown to migrate
+	StartID []byte `protobuf:"bytes,2,opt,name=start_id,json=startId,proto3" json:"start_id,omitempty"`
+	// Limit is the number of consecutive countdown IDs to migrate
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *MigrateCountdownsMsg) Reset()         { *m = MigrateCountdownsMsg{} }
+func (m *MigrateCountdownsMsg) String() string { return proto.CompactTextString(m) }
+func (*MigrateCountdownsMsg) ProtoMessage()    {}
+func (*MigrateCountdownsMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{43}
+}
+func (m *MigrateCountdownsMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigrateCountdownsMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrateCountdownsMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MigrateCountdownsMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateCountdownsMsg.Merge(m, src)
+}
+func (m *MigrateCountdownsMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigrateCountdownsMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateCountdownsMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateCountdownsMsg proto.InternalMessageInfo
+
+func (m *MigrateCountdownsMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *MigrateCountdownsMsg) GetStartID() []byte {
+	if m != nil {
+		return m.StartID
+	}
+	return nil
+}
+
+func (m *MigrateCountdownsMsg) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// ExpireCountdownTask is a scheduled task deleting a countdown once it expires
+type ExpireCountdownTask struct {
+	Metadata    *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	CountdownID []byte          `protobuf:"bytes,2,opt,name=countdown_id,json=countdownId,proto3" json:"countdown_id,omitempty"`
+}
+
+func (m *ExpireCountdownTask) Reset()         { *m = ExpireCountdownTask{} }
+func (m *ExpireCountdownTask) String() string { return proto.CompactTextString(m) }
+func (*ExpireCountdownTask) ProtoMessage()    {}
+func (*ExpireCountdownTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2611f682f9384d74, []int{44}
+}
+func (m *ExpireCountdownTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpireCountdownTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpireCountdownTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpireCountdownTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpireCountdownTask.Merge(m, src)
+}
+func (m *ExpireCountdownTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpireCountdownTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpireCountdownTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpireCountdownTask proto.InternalMessageInfo
+
+func (m *ExpireCountdownTask) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ExpireCountdownTask) GetCountdownID() []byte {
+	if m != nil {
+		return m.CountdownID
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("countdown.EditorRole", EditorRole_name, EditorRole_value)
+	proto.RegisterEnum("countdown.MissedRevealPolicy", MissedRevealPolicy_name, MissedRevealPolicy_value)
+	proto.RegisterEnum("countdown.RepeatPolicy", RepeatPolicy_name, RepeatPolicy_value)
+	proto.RegisterEnum("countdown.CountdownStatus", CountdownStatus_name, CountdownStatus_value)
+	proto.RegisterEnum("countdown.ModerationAction", ModerationAction_name, ModerationAction_value)
+	proto.RegisterType((*User)(nil), "countdown.User")
+	proto.RegisterType((*Countdown)(nil), "countdown.Countdown")
+	proto.RegisterType((*Attachment)(nil), "countdown.Attachment")
+	proto.RegisterType((*TopTipper)(nil), "countdown.TopTipper")
+	proto.RegisterType((*Tipper)(nil), "countdown.Tipper")
+	proto.RegisterType((*Flag)(nil), "countdown.Flag")
+	proto.RegisterType((*Draft)(nil), "countdown.Draft")
+	proto.RegisterType((*Series)(nil), "countdown.Series")
+	proto.RegisterType((*CreationLog)(nil), "countdown.CreationLog")
+	proto.RegisterType((*Editor)(nil), "countdown.Editor")
+	proto.RegisterType((*Reveal)(nil), "countdown.Reveal")
+	proto.RegisterType((*CountdownProgress)(nil), "countdown.CountdownProgress")
+	proto.RegisterType((*CountdownTask)(nil), "countdown.CountdownTask")
+	proto.RegisterType((*Configuration)(nil), "countdown.Configuration")
+	proto.RegisterType((*UpdateConfigurationMsg)(nil), "countdown.UpdateConfigurationMsg")
+	proto.RegisterType((*CreateUserMsg)(nil), "countdown.CreateUserMsg")
+	proto.RegisterType((*CreateCountdownMsg)(nil), "countdown.CreateCountdownMsg")
+	proto.RegisterType((*DeleteCountdownMsg)(nil), "countdown.DeleteCountdownMsg")
+	proto.RegisterType((*GrantRoleMsg)(nil), "countdown.GrantRoleMsg")
+	proto.RegisterType((*RevokeRoleMsg)(nil), "countdown.RevokeRoleMsg")
+	proto.RegisterType((*UpdateLyricsMsg)(nil), "countdown.UpdateLyricsMsg")
+	proto.RegisterType((*PauseCountdownMsg)(nil), "countdown.PauseCountdownMsg")
+	proto.RegisterType((*ResumeCountdownMsg)(nil), "countdown.ResumeCountdownMsg")
+	proto.RegisterType((*TransferCountdownMsg)(nil), "countdown.TransferCountdownMsg")
+	proto.RegisterType((*AcceptCountdownTransferMsg)(nil), "countdown.AcceptCountdownTransferMsg")
+	proto.RegisterType((*TipCountdownMsg)(nil), "countdown.TipCountdownMsg")
+	proto.RegisterType((*SetUnlockPriceMsg)(nil), "countdown.SetUnlockPriceMsg")
+	proto.RegisterType((*UnlockNextLineMsg)(nil), "countdown.UnlockNextLineMsg")
+	proto.RegisterType((*DeleteUserMsg)(nil), "countdown.DeleteUserMsg")
+	proto.RegisterType((*FlagCountdownMsg)(nil), "countdown.FlagCountdownMsg")
+	proto.RegisterType((*ModerateCountdownMsg)(nil), "countdown.ModerateCountdownMsg")
+	proto.RegisterType((*CreateCountdownDraftMsg)(nil), "countdown.CreateCountdownDraftMsg")
+	proto.RegisterType((*AppendLyricsChunkMsg)(nil), "countdown.AppendLyricsChunkMsg")
+	proto.RegisterType((*PublishCountdownMsg)(nil), "countdown.PublishCountdownMsg")
+	proto.RegisterType((*DeleteCountdownDraftMsg)(nil), "countdown.DeleteCountdownDraftMsg")
+	proto.RegisterType((*SetSuccessorMsg)(nil), "countdown.SetSuccessorMsg")
+	proto.RegisterType((*RestartCountdownMsg)(nil), "countdown.RestartCountdownMsg")
+	proto.RegisterType((*ForkCountdownMsg)(nil), "countdown.ForkCountdownMsg")
+	proto.RegisterType((*LineEdit)(nil), "countdown.LineEdit")
+	proto.RegisterType((*CreateSeriesMsg)(nil), "countdown.CreateSeriesMsg")
+	proto.RegisterType((*AddSeriesCountdownMsg)(nil), "countdown.AddSeriesCountdownMsg")
+	proto.RegisterType((*RemoveSeriesCountdownMsg)(nil), "countdown.RemoveSeriesCountdownMsg")
+	proto.RegisterType((*ReorderSeriesMsg)(nil), "countdown.ReorderSeriesMsg")
+	proto.RegisterType((*MigrateCountdownsMsg)(nil), "countdown.MigrateCountdownsMsg")
+	proto.RegisterType((*ExpireCountdownTask)(nil), "countdown.ExpireCountdownTask")
+}
+
+func init() { proto.RegisterFile("x/countdown/codec.proto", fileDescriptor_2611f682f9384d74) }
+
+var fileDescriptor_2611f682f9384d74 = []byte{
+	// 3086 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0xcf, 0x6f, 0xe3, 0xc6,
+	0xf5, 0x5f, 0xea, 0x97, 0xa5, 0x27, 0xc9, 0xa6, 0xc7, 0x5e, 0x2f, 0xa3, 0xec, 0xae, 0x15, 0x66,
+	0x77, 0xe3, 0xdd, 0x7c, 0xd7, 0x0b, 0x38, 0x08, 0xbe, 0xdf, 0x6f, 0x90, 0xef, 0x17, 0x95, 0x25,
+	0x39, 0x56, 0x6b, 0x5b, 0x2e, 0x25, 0x6f, 0x9a, 0x43, 0x41, 0x70, 0xc5, 0x59, 0x89, 0x58, 0x89,
+	0x64, 0xc9, 0x91, 0x7f, 0x00, 0xe9, 0xa1, 0x87, 0x5e, 0xf6, 0x54, 0x14, 0x68, 0xd1, 0x43, 0xb7,
+	0x45, 0x2f, 0xb9, 0x15, 0xe8, 0xb1, 0xb7, 0xa2, 0x68, 0x81, 0xe6, 0x92, 0x22, 0x87, 0x16, 0x28,
+	0x7a, 0x30, 0x52, 0xef, 0xb5, 0x7f, 0x41, 0x4e, 0xc5, 0xcc, 0x90, 0x14, 0x25, 0x59, 0x89, 0x29,
+	0x19, 0x4e, 0x8a, 0xde, 0xc8, 0x99, 0xf7, 0x1e, 0xe7, 0xcd, 0xbc, 0xf9, 0xbc, 0xcf, 0xbc, 0x91,
+	0xe0, 0xc6, 0xf1, 0xa3, 0x96, 0xd5, 0x37, 0x89, 0x6e, 0x1d, 0x99, 0x8f, 0x5a, 0x96, 0x8e, 0x5b,
+	0xeb, 0xb6, 0x63, 0x11, 0x0b, 0x65, 0x82, 0xe6, 0x42, 0x36, 0xd4, 0x5e, 0x10, 0x5b, 0x96, 0x31,
+	0x24, 0x59, 0x58, 0x6e, 0x5b, 0x6d, 0x8b, 0x3d, 0x3e, 0xa2, 0x4f, 0xbc, 0x55, 0xfe, 0x49, 0x0c,
+	0x12, 0x07, 0x2e, 0x76, 0xd0, 0x9b, 0x90, 0xee, 0x61, 0xa2, 0xe9, 0x1a, 0xd1, 0x24, 0xa1, 0x28,
+	0xac, 0x65, 0x37, 0x16, 0xd6, 0x8f, 0xb0, 0x76, 0x88, 0xd7, 0x77, 0xbd, 0x66, 0x25, 0x10, 0x40,
+	0x2b, 0x10, 0x33, 0x74, 0x29, 0x56, 0x14, 0xd6, 0x72, 0x9b, 0xa9, 0xb3, 0xd3, 0xd5, 0x58, 0xad,
+	0xa2, 0xc4, 0x0c, 0x1d, 0x15, 0x20, 0xdd, 0x77, 0xb1, 0x63, 0x6a, 0x3d, 0x2c, 0xc5, 0x8b, 0xc2,
+	0x5a, 0x46, 0x09, 0xde, 0xd1, 0x37, 0x21, 0xef, 0xe0, 0xb6, 0xe1, 0x12, 0xec, 0x60, 0x5d, 0xd5,
+	0x88, 0x94, 0x28, 0x0a, 0x6b, 0xf1, 0xcd, 0xbb, 0x9f, 0x9f, 0xae, 0xbe, 0xd6, 0x36, 0x48, 0xa7,
+	0xff, 0x64, 0xbd, 0x65, 0xf5, 0x1e, 0x19, 0xd6, 0xe1, 0x43, 0xcb, 0xc4, 0x8f, 0xf8, 0xb7, 0x0f,
+	0x4c, 0xe3, 0xb8, 0x69, 0xf4, 0xb0, 0x92, 0x1b, 0xe8, 0x96, 0x08, 0x7a, 0x07, 0x92, 0xd6, 0x91,
+	0x89, 0x1d, 0x29, 0xc9, 0x86, 0x70, 0xe7, 0xf3, 0xd3, 0xd5, 0xe2, 0x44, 0x1b, 0x25, 0x5d, 0x77,
+	0xb0, 0xeb, 0x2a, 0x5c, 0x05, 0xdd, 0x81, 0x39, 0x1d, 0xdb, 0x96, 0x6b, 0x10, 0x29, 0xc5, 0xfc,
+	0x84, 0x75, 0x3a, 0x57, 0xeb, 0x65, 0xcb, 0x30, 0x15, 0xbf, 0x4b, 0xfe, 0xa1, 0x08, 0x99, 0xb2,
+	0x3f, 0xb5, 0x97, 0x33, 0x39, 0xc1, 0xa0, 0x13, 0xd1, 0x07, 0xbd, 0x0c, 0x49, 0x62, 0x90, 0x2e,
+	0x66, 0x0e, 0x67, 0x14, 0xfe, 0x82, 0x56, 0x20, 0xd5, 0x3d, 0x71, 0x8c, 0x96, 0xcb, 0x3c, 0xc9,
+	0x29, 0xde, 0x1b, 0xba, 0x09, 0x83, 0xb0, 0x90, 0xe6, 0x58, 0xd7, 0xa0, 0x01, 0x55, 0x00, 0x5a,
+	0x0e, 0xd6, 0x08, 0x5f, 0x85, 0x74, 0x94, 0x55, 0xc8, 0x78, 0x8a, 0x25, 0x82, 0xb6, 0x21, 0xd7,
+	0xb2, 0x7a, 0x76, 0x17, 0x7b, 0x76, 0x32, 0x51, 0xec, 0x64, 0x03, 0xd5, 0x12, 0x41, 0x9b, 0x90,
+	0xd1, 0x31, 0x7d, 0xa1, 0x66, 0x20, 0x8a, 0x99, 0x34, 0xd7, 0x2b, 0x11, 0x54, 0x87, 0xe5, 0x9e,
+	0xe1, 0xba, 0x58, 0x57, 0x1d, 0x7c, 0x88, 0xb5, 0xae, 0x6a, 0x5b, 0x5d, 0xa3, 0x75, 0x22, 0x65,
+	0x8b, 0xc2, 0xda, 0xfc, 0xc6, 0xad, 0xf5, 0xc0, 0xfb, 0xf5, 0x5d, 0x26, 0xa6, 0x30, 0xa9, 0x7d,
+	0x26, 0xa4, 0xa0, 0xde, 0x58, 0x1b, 0x7a, 0x13, 0xe6, 0xb8, 0x25, 0x57, 0xca, 0x15, 0xe3, 0x6b,
+	0xd9, 0x8d, 0xc5, 0x90, 0x0d, 0x2e, 0xa9, 0xf8, 0x12, 0x54, 0x18, 0xeb, 0x06, 0xb1, 0x1c, 0x57,
+	0xca, 0x8f, 0x09, 0x57, 0x59, 0x8f, 0xe2, 0x4b, 0xa0, 0xd7, 0x61, 0x8e, 0x68, 0xee, 0x33, 0xd5,
+	0xd0, 0xa5, 0x79, 0x16, 0x08, 0x70, 0x76, 0xba, 0x9a, 0x6a, 0x6a, 0xee, 0xb3, 0x5a, 0x45, 0x49,
+	0xd1, 0xae, 0x9a, 0x4e, 0xe7, 0xc4, 0xd6, 0xfa, 0x2e, 0x9f, 0xda, 0x85, 0x48, 0x73, 0xc2, 0xf5,
+	0x4a, 0x04, 0xed, 0xc0, 0xbc, 0xdb, 0xea, 0x60, 0xbd, 0xdf, 0xc5, 0xaa, 0x4b, 0x34, 0x87, 0x48,
+	0x62, 0x14, 0x43, 0x79, 0x5f, 0xb9, 0x41, 0x75, 0xd1, 0xb7, 0x60, 0xde, 0xc4, 0xc7, 0xc4, 0x9f,
+	0x5f, 0x8d, 0x48, 0x8b, 0x91, 0xf6, 0x2f, 0x55, 0xe6, 0xf3, 0x56, 0x22, 0xa8, 0x06, 0x79, 0x1b,
+	0x9b, 0xba, 0x61, 0xb6, 0x55, 0xbe, 0x25, 0x50, 0x84, 0x2d, 0x91, 0xf3, 0x54, 0xeb, 0x6c, 0x67,
+	0xbc, 0x01, 0x19, 0x62, 0xd8, 0x2a, 0xb1, 0x88, 0xd6, 0x95, 0x96, 0x8a, 0xf1, 0x91, 0x0d, 0x9d,
+	0x26, 0x86, 0xdd, 0xa4, 0x7d, 0xe8, 0x6d, 0xc8, 0x12, 0xcb, 0x56, 0x89, 0x61, 0xdb, 0xd8, 0x71,
+	0xa5, 0x65, 0x26, 0xba, 0x1c, 0x5a, 0xa8, 0xa6, 0x65, 0x37, 0x59, 0xa7, 0x02, 0xc4, 0x7f, 0x74,
+	0x91, 0x0c, 0xa9, 0x27, 0x54, 0xe4, 0x44, 0xba, 0x3e, 0x66, 0xdc, 0xeb, 0x41, 0x5b, 0x90, 0x7d,
+	0x82, 0x4d, 0xfc, 0xd4, 0x68, 0x19, 0x9a, 0x73, 0x22, 0xad, 0x44, 0x70, 0x26, 0xac, 0x88, 0xfe,
+	0x1f, 0xe6, 0x5c, 0xdb, 0x32, 0x5d, 0xcb, 0x91, 0x6e, 0x44, 0xb0, 0xe1, 0x2b, 0xa1, 0x87, 0x90,
+	0xeb, 0x9b, 0x5d, 0xab, 0xf5, 0x4c, 0xb5, 0x1d, 0xa3, 0x85, 0x25, 0x69, 0x0c, 0xdf, 0xb2, 0xbc,
+	0x7f, 0x9f, 0x76, 0xa3, 0x06, 0x20, 0xfe, 0x3a, 0xd8, 0x36, 0x1a, 0x91, 0x5e, 0x89, 0xb2, 0xac,
+	0xa2, 0x6f, 0x20, 0x58, 0xda, 0x10, 0xbc, 0x16, 0x26, 0xc2, 0x2b, 0xdf, 0xf3, 0xec, 0xd1, 0x72,
+	0xa4, 0x57, 0x23, 0xf8, 0x3a, 0x50, 0x43, 0xb7, 0x00, 0x9e, 0x76, 0xb5, 0xb6, 0xca, 0x56, 0x50,
+	0xba, 0x59, 0x14, 0xd6, 0x92, 0x4a, 0x86, 0xb6, 0x30, 0xdc, 0xa6, 0x9f, 0xe8, 0x18, 0xba, 0x8e,
+	0x4d, 0xea, 0xd4, 0xad, 0x48, 0x5b, 0x88, 0xeb, 0x95, 0x08, 0xfa, 0x6f, 0xc8, 0x6a, 0x84, 0x68,
+	0xad, 0x4e, 0x0f, 0x9b, 0xc4, 0x95, 0x6e, 0xb3, 0x08, 0xb8, 0x1e, 0x8a, 0x99, 0x52, 0xd0, 0xab,
+	0x84, 0x25, 0xd1, 0x9b, 0xb0, 0x48, 0x21, 0x8e, 0x0e, 0x19, 0xeb, 0xaa, 0x07, 0xd2, 0xab, 0x0c,
+	0x89, 0xc5, 0x41, 0xc7, 0x0e, 0x6b, 0x47, 0x77, 0x61, 0x9e, 0x4f, 0x3f, 0xd6, 0x3d, 0x67, 0x8a,
+	0xcc, 0x99, 0xbc, 0xdf, 0xca, 0x1d, 0xda, 0x80, 0x9c, 0xdb, 0x6f, 0xb5, 0xb0, 0xeb, 0x5a, 0x0e,
+	0x45, 0x8f, 0xd7, 0xd8, 0xb4, 0x2d, 0x9c, 0x9d, 0xae, 0x66, 0x1b, 0x7e, 0x7b, 0xad, 0xa2, 0x64,
+	0x03, 0xa1, 0x9a, 0x8e, 0xfe, 0x07, 0xe6, 0x6d, 0x07, 0xeb, 0x78, 0xa0, 0x25, 0x33, 0xad, 0xc5,
+	0xb3, 0xd3, 0xd5, 0xfc, 0xfe, 0xa0, 0xa7, 0x56, 0x51, 0xf2, 0x21, 0xc1, 0x9a, 0x8e, 0xde, 0xa5,
+	0xe9, 0xda, 0xc6, 0x1a, 0xf1, 0xa1, 0xf4, 0x75, 0x06, 0xa5, 0x37, 0x86, 0x60, 0x90, 0xf6, 0x7b,
+	0x20, 0x9a, 0x73, 0x42, 0x6f, 0xe8, 0x35, 0xf0, 0xde, 0x3d, 0x87, 0xee, 0x30, 0x87, 0xb2, 0xbc,
+	0x8d, 0xbb, 0x73, 0x13, 0x32, 0x06, 0xc1, 0x8e, 0x46, 0x0c, 0xcb, 0x94, 0xee, 0xf2, 0xd5, 0x0b,
+	0x1a, 0xd0, 0x7d, 0xc8, 0xb8, 0x56, 0xdf, 0x69, 0x61, 0x3a, 0xe6, 0x7b, 0x6c, 0xcc, 0xb9, 0xb3,
+	0xd3, 0xd5, 0x74, 0x83, 0x35, 0xd6, 0x2a, 0x4a, 0x9a, 0x77, 0xd7, 0x74, 0xf4, 0x1e, 0xe4, 0x3c,
+	0x51, 0x8e, 0x25, 0x6f, 0x44, 0xd9, 0x7e, 0x5c, 0x93, 0x43, 0x09, 0x82, 0x04, 0xd1, 0xda, 0xae,
+	0xb4, 0x56, 0x8c, 0xaf, 0x65, 0x14, 0xf6, 0x2c, 0x7f, 0x1b, 0x60, 0xb0, 0xc6, 0x54, 0xa2, 0x6b,
+	0x98, 0x98, 0x71, 0x80, 0xa4, 0xc2, 0x9e, 0x69, 0x5b, 0x47, 0x73, 0x3b, 0x3c, 0xe1, 0x2b, 0xec,
+	0x19, 0xbd, 0x0a, 0x99, 0x9e, 0xd1, 0xc3, 0x2a, 0x39, 0xb1, 0x03, 0x22, 0x44, 0x1b, 0x9a, 0x27,
+	0x36, 0x96, 0x7b, 0x90, 0x09, 0xa0, 0x86, 0x6e, 0x79, 0x8d, 0x8f, 0x45, 0x12, 0x22, 0x8c, 0xdb,
+	0x57, 0x42, 0x45, 0x48, 0x72, 0xe8, 0x8b, 0x8d, 0x6d, 0x36, 0xde, 0x21, 0xbf, 0x14, 0x20, 0xe5,
+	0x7d, 0xec, 0x52, 0x68, 0xcc, 0x06, 0xe4, 0x82, 0x10, 0xa0, 0x8b, 0x13, 0x1f, 0x84, 0x61, 0x40,
+	0x98, 0x68, 0x18, 0x06, 0x42, 0x35, 0x3d, 0xec, 0x65, 0x62, 0x26, 0x2f, 0x93, 0x63, 0x18, 0xec,
+	0x79, 0xf9, 0xcb, 0x18, 0x24, 0xb6, 0xba, 0x5a, 0xfb, 0xab, 0xf3, 0xf1, 0x1b, 0x90, 0x76, 0xb0,
+	0x6d, 0x39, 0x24, 0x22, 0xc3, 0x0b, 0xb4, 0x28, 0x9d, 0x73, 0xb0, 0xe6, 0x5a, 0xa6, 0xc7, 0xf2,
+	0xbc, 0x37, 0x4a, 0xd8, 0x28, 0xac, 0xb5, 0x39, 0x1b, 0x48, 0x45, 0x22, 0x6c, 0x9e, 0x62, 0x89,
+	0xc8, 0x7f, 0x4d, 0x41, 0xb2, 0xe2, 0x68, 0x4f, 0xc9, 0x25, 0xb3, 0xd9, 0xf8, 0x0c, 0x6c, 0x36,
+	0x11, 0x66, 0xb3, 0x93, 0x38, 0x5c, 0x72, 0x5a, 0x0e, 0x37, 0x48, 0xdd, 0xa9, 0x8b, 0xa6, 0xee,
+	0xb9, 0x4b, 0x48, 0xdd, 0xe9, 0x69, 0x52, 0xf7, 0x10, 0x09, 0xce, 0x4c, 0x47, 0x82, 0x87, 0x89,
+	0x3d, 0x4c, 0x49, 0xec, 0x07, 0x87, 0x8a, 0xec, 0xd0, 0xa1, 0x62, 0x05, 0x52, 0xad, 0x4e, 0xdf,
+	0x7c, 0x46, 0x09, 0x31, 0x45, 0x3f, 0xef, 0x0d, 0xad, 0x42, 0x96, 0x4b, 0xa8, 0x0c, 0x06, 0xf3,
+	0x4c, 0x09, 0x78, 0xd3, 0x36, 0x05, 0xc3, 0xb1, 0x4c, 0x32, 0x3f, 0x4b, 0x26, 0x59, 0x18, 0xcf,
+	0x24, 0x3e, 0x6e, 0x8b, 0x03, 0xdc, 0x0e, 0xd3, 0x90, 0xc5, 0x0b, 0xd2, 0x10, 0x34, 0x15, 0x0d,
+	0x91, 0x7f, 0x1e, 0x83, 0x54, 0x03, 0x3b, 0x06, 0x76, 0xbf, 0xae, 0x1b, 0xeb, 0x6d, 0xc8, 0x87,
+	0xd1, 0xcc, 0x65, 0x28, 0x9a, 0xdb, 0x14, 0xcf, 0x4e, 0x57, 0x73, 0x21, 0x38, 0x73, 0x95, 0x5c,
+	0x08, 0xcf, 0xdc, 0x91, 0x70, 0x4a, 0x4d, 0x17, 0x4e, 0xf2, 0xcf, 0x04, 0xc8, 0x96, 0xe9, 0x9b,
+	0x61, 0x99, 0x3b, 0xd6, 0x25, 0xe1, 0xf3, 0xf0, 0xd0, 0xe2, 0xc5, 0xf8, 0x54, 0x43, 0x73, 0x21,
+	0xc5, 0x0f, 0x67, 0x33, 0x67, 0xe1, 0xfb, 0x90, 0x70, 0xac, 0x2e, 0x66, 0x23, 0x9d, 0x1f, 0x22,
+	0x88, 0xfc, 0x03, 0x8a, 0xd5, 0xc5, 0x0a, 0x13, 0x91, 0x3f, 0x84, 0x14, 0x07, 0xa9, 0x73, 0xc9,
+	0xc4, 0x0a, 0xa4, 0x3a, 0xd8, 0x68, 0x77, 0x08, 0x33, 0x15, 0x57, 0xbc, 0x37, 0x0a, 0x53, 0x01,
+	0x45, 0x64, 0x1e, 0x47, 0x58, 0x0c, 0xf0, 0x35, 0x4b, 0x44, 0xfe, 0x6d, 0x1c, 0x16, 0x83, 0x25,
+	0xdf, 0x77, 0xac, 0x36, 0x1b, 0xfe, 0x68, 0xba, 0x13, 0x2e, 0x90, 0xee, 0x36, 0x20, 0xe5, 0x12,
+	0x8d, 0xf4, 0x5d, 0xcf, 0xe9, 0x42, 0xc8, 0xe9, 0x40, 0xa9, 0xc1, 0x24, 0x14, 0x4f, 0xf2, 0x1c,
+	0xa2, 0x1b, 0x3f, 0x8f, 0xe8, 0xae, 0xd2, 0x93, 0x1a, 0xd1, 0xba, 0x2a, 0x9d, 0x12, 0xce, 0x18,
+	0x92, 0xf4, 0x4c, 0x46, 0xb4, 0xee, 0x0e, 0x6d, 0x41, 0xf7, 0x41, 0xb4, 0xb1, 0xd3, 0xc2, 0x26,
+	0x51, 0xfd, 0x42, 0x02, 0xcb, 0x12, 0x49, 0x65, 0xc1, 0x6b, 0x2f, 0x7b, 0xcd, 0xe7, 0x1c, 0x5b,
+	0x53, 0xd3, 0x1f, 0x5b, 0xbf, 0x0b, 0x37, 0xb0, 0x4b, 0x8c, 0x1e, 0x0b, 0x3c, 0xef, 0xcb, 0x86,
+	0xc5, 0x0e, 0x18, 0x73, 0x51, 0xac, 0x5e, 0x0f, 0xac, 0x94, 0x03, 0x23, 0xa5, 0x11, 0x46, 0x9c,
+	0x1e, 0x61, 0xc4, 0xf2, 0x27, 0x02, 0xe4, 0x83, 0x89, 0xa5, 0xe5, 0x82, 0xaf, 0x8e, 0xea, 0x94,
+	0x01, 0x58, 0x09, 0x23, 0x7a, 0x39, 0x2b, 0x43, 0xf5, 0x18, 0xdb, 0x96, 0x7f, 0x9f, 0xa2, 0xfe,
+	0x98, 0x4f, 0x8d, 0x76, 0xdf, 0xe3, 0xfc, 0x91, 0xfc, 0x09, 0x60, 0x32, 0x16, 0x1d, 0x26, 0xdf,
+	0x82, 0x1c, 0x2d, 0x4b, 0xaa, 0x7e, 0x86, 0x88, 0x8f, 0x66, 0x88, 0xcd, 0xc4, 0xc7, 0xa7, 0xab,
+	0xd7, 0x94, 0x2c, 0x95, 0xaa, 0x78, 0xb9, 0xe2, 0xff, 0x60, 0x31, 0x98, 0x83, 0x40, 0x33, 0x31,
+	0x41, 0x53, 0x0c, 0x44, 0x7d, 0x75, 0x19, 0xf2, 0x26, 0x3e, 0x52, 0xd9, 0x77, 0x5b, 0x96, 0x4b,
+	0x58, 0xc0, 0xc6, 0x95, 0xac, 0x89, 0x8f, 0x68, 0xfd, 0xb5, 0x6c, 0xb9, 0x04, 0xfd, 0x17, 0x20,
+	0x2a, 0x33, 0xf8, 0x0c, 0x13, 0x64, 0x01, 0xab, 0x88, 0x26, 0x3e, 0x0a, 0x16, 0x84, 0x49, 0xaf,
+	0xc3, 0xd2, 0xb0, 0xa4, 0xda, 0x37, 0x0d, 0x2f, 0x12, 0x95, 0xc5, 0x56, 0x58, 0xf6, 0xc0, 0x34,
+	0x08, 0xba, 0x07, 0x0b, 0x3d, 0xc3, 0x64, 0x9b, 0x4a, 0xed, 0x62, 0xb3, 0x4d, 0x3a, 0x5e, 0x90,
+	0xe5, 0x7b, 0x86, 0x49, 0x37, 0xd6, 0x0e, 0x6b, 0x64, 0x72, 0xda, 0xf1, 0x90, 0x5c, 0xc6, 0x93,
+	0xd3, 0x8e, 0x43, 0x72, 0x0a, 0x2c, 0x78, 0xbb, 0xca, 0x30, 0x09, 0x76, 0x0e, 0xb5, 0x2e, 0xe3,
+	0x1c, 0xc9, 0xcd, 0xfb, 0x9f, 0x9f, 0xae, 0xde, 0xfd, 0xc2, 0x5d, 0x50, 0xf1, 0x96, 0x5c, 0xf1,
+	0xf0, 0xa0, 0xe6, 0x19, 0xa0, 0xc0, 0xde, 0xb3, 0x74, 0x1a, 0xf2, 0xb4, 0x98, 0x96, 0x2d, 0xc6,
+	0x2f, 0xbc, 0xb4, 0x21, 0x3d, 0xb4, 0x01, 0xd7, 0xa9, 0x07, 0x5a, 0x8b, 0x18, 0x87, 0x78, 0x30,
+	0x9d, 0x3e, 0x73, 0x59, 0xea, 0x69, 0xc7, 0x25, 0xd6, 0x17, 0x4c, 0xa8, 0x8b, 0xfe, 0x17, 0x5e,
+	0xa1, 0x3a, 0x03, 0x61, 0xd5, 0xc6, 0x8e, 0x7a, 0x64, 0x98, 0xba, 0x75, 0xc4, 0x48, 0x4d, 0x52,
+	0x59, 0xe9, 0x69, 0xc7, 0x03, 0x8d, 0x7d, 0xec, 0xbc, 0xcf, 0x7a, 0xe9, 0x44, 0xb4, 0xbc, 0x0c,
+	0xe7, 0x2b, 0xcc, 0x47, 0x9e, 0x08, 0xdf, 0x82, 0x67, 0xf3, 0x0e, 0xcc, 0x07, 0x8b, 0xf0, 0xe4,
+	0x84, 0x60, 0xd7, 0x23, 0x3e, 0x39, 0x6f, 0x0d, 0x36, 0x69, 0x9b, 0xdc, 0x87, 0x95, 0x03, 0x5b,
+	0xd7, 0x08, 0x1e, 0xda, 0x48, 0xbb, 0x6e, 0xc4, 0x34, 0xbb, 0x0e, 0x49, 0x5b, 0x23, 0xad, 0x8e,
+	0x77, 0x88, 0x94, 0x86, 0xa0, 0x3c, 0x64, 0x58, 0xe1, 0x62, 0xf2, 0x77, 0x20, 0xcf, 0x52, 0x3a,
+	0xa6, 0x91, 0x1b, 0xf9, 0x6b, 0xe1, 0x4b, 0x82, 0xd8, 0xf0, 0x25, 0x81, 0xfc, 0x51, 0x12, 0x10,
+	0x37, 0x1d, 0x4c, 0x74, 0x64, 0xfb, 0x01, 0x09, 0x8a, 0x85, 0x49, 0x90, 0x1c, 0xd0, 0xda, 0xf8,
+	0xa0, 0xea, 0xca, 0x0b, 0x30, 0x01, 0xc5, 0x9d, 0x74, 0x02, 0x49, 0x4c, 0x7b, 0x02, 0x99, 0xe5,
+	0x9e, 0xe2, 0x3f, 0xed, 0xf4, 0x32, 0x52, 0x6b, 0x83, 0x0b, 0xd7, 0xda, 0xc6, 0xce, 0x17, 0xd9,
+	0x59, 0xce, 0x17, 0xb9, 0xc9, 0xe7, 0x8b, 0x7c, 0xa8, 0x2e, 0xf4, 0x01, 0xa0, 0x0a, 0x1b, 0xf9,
+	0xf4, 0x71, 0x3a, 0x21, 0x23, 0xcb, 0x7f, 0x17, 0x20, 0xf7, 0x9e, 0xa3, 0x99, 0x84, 0xb2, 0xc6,
+	0xc8, 0x56, 0x47, 0xf3, 0x79, 0x2c, 0x5a, 0x79, 0x26, 0x3e, 0x0b, 0xfd, 0x4d, 0x7c, 0x39, 0xfd,
+	0xfd, 0x8d, 0x00, 0x79, 0x05, 0x1f, 0x5a, 0xcf, 0xf0, 0xbf, 0x8b, 0x77, 0xf2, 0x1f, 0x04, 0x58,
+	0xe0, 0x28, 0xcb, 0xe1, 0xe2, 0x4a, 0x06, 0xbd, 0x32, 0x0c, 0x57, 0x01, 0x44, 0x8d, 0xec, 0x92,
+	0xc4, 0x45, 0x77, 0x89, 0x4c, 0x60, 0x71, 0x9f, 0xde, 0x0c, 0x4d, 0x1f, 0xaf, 0x53, 0xb8, 0x21,
+	0xf7, 0x01, 0x29, 0xd8, 0xed, 0xf7, 0xae, 0xf8, 0xb3, 0xff, 0x10, 0x60, 0xb9, 0xe9, 0x68, 0xa6,
+	0xfb, 0x94, 0x32, 0xab, 0x2b, 0xfc, 0x32, 0x2a, 0x41, 0x86, 0x52, 0xb8, 0xe8, 0x27, 0xf8, 0xb4,
+	0x89, 0x8f, 0x78, 0x19, 0x9a, 0x9d, 0x92, 0xbe, 0xd7, 0x37, 0x1c, 0xac, 0x6a, 0xad, 0x16, 0xb6,
+	0x39, 0xcb, 0x4c, 0x2b, 0x79, 0xaf, 0xb5, 0xc4, 0x1a, 0xe5, 0xef, 0x43, 0x81, 0x3f, 0x0d, 0x0e,
+	0x05, 0x9e, 0xc7, 0x57, 0x32, 0xc5, 0x7f, 0x11, 0x60, 0xa1, 0x69, 0xd8, 0x57, 0x3b, 0xbb, 0xef,
+	0x42, 0x8a, 0xdf, 0xdf, 0x45, 0x9a, 0x5a, 0x4f, 0x87, 0x66, 0x54, 0xad, 0xc7, 0x40, 0x7e, 0x8c,
+	0xb6, 0x2b, 0x5e, 0x8f, 0xfc, 0x53, 0x01, 0x16, 0x1b, 0x98, 0x1c, 0x0c, 0xae, 0xc9, 0xae, 0xc4,
+	0xb1, 0x22, 0x24, 0xf9, 0x95, 0x5d, 0x7c, 0xbc, 0x8c, 0xcf, 0x3a, 0x28, 0x70, 0x2e, 0xf2, 0x51,
+	0xed, 0xe1, 0x63, 0x42, 0x29, 0xe0, 0x95, 0x0c, 0xec, 0x1d, 0x4a, 0x0d, 0x4f, 0xa2, 0x56, 0xa3,
+	0x98, 0x8a, 0xdc, 0x84, 0x3c, 0xcf, 0x91, 0x53, 0xd1, 0xc4, 0x49, 0xe9, 0xf1, 0x13, 0x01, 0xc4,
+	0x2d, 0xff, 0x96, 0xef, 0xca, 0x22, 0x2f, 0x5c, 0xdd, 0x8f, 0xcf, 0x58, 0xdd, 0x4f, 0x84, 0xab,
+	0xfb, 0xf2, 0x1f, 0x05, 0x58, 0xde, 0xe5, 0x67, 0x97, 0xab, 0x45, 0x49, 0xf4, 0x16, 0xa4, 0xe8,
+	0x11, 0xc9, 0x32, 0x99, 0x47, 0xf3, 0x1b, 0xaf, 0x86, 0x09, 0x2e, 0x1f, 0x11, 0x2d, 0x4c, 0x30,
+	0x11, 0xc5, 0x13, 0x9d, 0xe8, 0xc6, 0x9f, 0x13, 0x70, 0x63, 0x84, 0xb9, 0xb3, 0xdb, 0x86, 0x4b,
+	0xa2, 0xef, 0x93, 0xa8, 0x79, 0x7c, 0x66, 0x6a, 0x9e, 0x98, 0x85, 0x9a, 0x27, 0x2f, 0x4a, 0xcd,
+	0x53, 0x97, 0x40, 0xcd, 0xe7, 0x66, 0xa6, 0xe6, 0xe9, 0xe9, 0xa8, 0xf9, 0x18, 0xc3, 0xce, 0xcc,
+	0xc2, 0xb0, 0x61, 0x32, 0xc3, 0xce, 0x86, 0x18, 0xf6, 0xaf, 0x05, 0x58, 0x2e, 0xd9, 0xf4, 0xb7,
+	0x1e, 0x9c, 0x76, 0x95, 0xe9, 0x75, 0x43, 0xe4, 0x68, 0xba, 0x07, 0x69, 0x9d, 0x86, 0xe1, 0x60,
+	0x4f, 0x64, 0xcf, 0x4e, 0x57, 0xe7, 0x58, 0x68, 0xd6, 0x2a, 0xca, 0x1c, 0xeb, 0xac, 0xe9, 0x34,
+	0xea, 0x0c, 0x53, 0xc7, 0xc7, 0x5e, 0x45, 0x92, 0xbf, 0x84, 0x58, 0x58, 0x62, 0x88, 0x85, 0xf9,
+	0x77, 0xbe, 0xc9, 0xc1, 0x9d, 0x2f, 0xdd, 0xc7, 0x4b, 0xfb, 0xfd, 0x27, 0x5d, 0xc3, 0xed, 0x4c,
+	0xbf, 0x8d, 0x2f, 0x3a, 0xdc, 0x91, 0x4b, 0x97, 0xf8, 0xd8, 0xa5, 0xcb, 0xd4, 0x3c, 0xd1, 0x84,
+	0x1b, 0x23, 0x07, 0x9b, 0xe9, 0xb6, 0xf1, 0x05, 0x3d, 0x91, 0x3f, 0x12, 0x60, 0xa1, 0x81, 0x49,
+	0xf0, 0x0b, 0x86, 0x2b, 0x41, 0xbe, 0xd1, 0x9f, 0x52, 0xc4, 0xbf, 0xfc, 0xa7, 0x14, 0xf2, 0x21,
+	0x2c, 0x29, 0x98, 0xfd, 0x8e, 0xea, 0x6a, 0xb9, 0xec, 0x8f, 0x63, 0x20, 0x6e, 0x59, 0xce, 0xb3,
+	0xe9, 0xbf, 0x3a, 0xf4, 0x5b, 0x8a, 0xd8, 0x17, 0xfe, 0x96, 0x22, 0x00, 0xdf, 0xf8, 0x45, 0xc0,
+	0x77, 0xea, 0xba, 0xc8, 0x32, 0x24, 0x79, 0x6d, 0x9f, 0xe2, 0x67, 0x52, 0xe1, 0x2f, 0xe8, 0x3e,
+	0x24, 0xb1, 0x6e, 0x10, 0xd7, 0x2b, 0x78, 0x2c, 0x85, 0xec, 0x52, 0xbe, 0x43, 0xcf, 0x92, 0x0a,
+	0x97, 0x90, 0x37, 0x20, 0xed, 0x37, 0x4d, 0xfa, 0x51, 0x06, 0xc1, 0xc7, 0xc4, 0xcb, 0x21, 0xec,
+	0x59, 0xfe, 0x93, 0x00, 0x0b, 0x3c, 0x43, 0xf1, 0xeb, 0xba, 0x4b, 0xca, 0x4c, 0xb3, 0xdc, 0xd7,
+	0x8d, 0xdd, 0xcc, 0x25, 0x2e, 0x72, 0x33, 0x27, 0xff, 0x4e, 0x80, 0xeb, 0x25, 0x5d, 0xe7, 0x6e,
+	0xcc, 0x16, 0x17, 0xcc, 0xc4, 0x68, 0x5c, 0xb0, 0x46, 0x16, 0x17, 0xfc, 0x69, 0xba, 0x5b, 0x82,
+	0x02, 0xa4, 0x59, 0xe9, 0xdb, 0xf0, 0xb8, 0x42, 0x52, 0x09, 0xde, 0xe9, 0xae, 0x97, 0x14, 0xdc,
+	0xb3, 0x0e, 0xf1, 0xd7, 0xdb, 0x09, 0xf9, 0x57, 0x02, 0x88, 0x0a, 0xb6, 0x1c, 0x1d, 0x3b, 0x53,
+	0x46, 0x4d, 0x84, 0x01, 0x8e, 0x85, 0x43, 0xfc, 0x42, 0xe1, 0xf0, 0x03, 0xca, 0x20, 0x8d, 0xf6,
+	0x10, 0x81, 0x74, 0xa7, 0x01, 0x6c, 0x86, 0x6e, 0x23, 0x80, 0xcd, 0x7e, 0xfd, 0x49, 0x01, 0x9b,
+	0x75, 0x72, 0x88, 0xe8, 0x1a, 0x3d, 0xc3, 0xbf, 0xbb, 0xe3, 0x2f, 0x14, 0x1d, 0xab, 0xc7, 0xb6,
+	0xe1, 0xe0, 0x19, 0xae, 0xa8, 0xa6, 0x40, 0xc7, 0x07, 0x1f, 0x02, 0x0c, 0x6a, 0x4c, 0xe8, 0x0e,
+	0x2c, 0x55, 0x2b, 0xb5, 0x66, 0x5d, 0x51, 0x95, 0xfa, 0x4e, 0x55, 0xad, 0xed, 0x3d, 0x2e, 0xed,
+	0xd4, 0x2a, 0xe2, 0xb5, 0x42, 0xf6, 0xf9, 0x8b, 0xe2, 0x5c, 0xcd, 0x3c, 0xd4, 0xba, 0x86, 0x8e,
+	0x64, 0x40, 0x61, 0x29, 0xfe, 0x2c, 0x0a, 0x05, 0x78, 0xfe, 0xa2, 0xe8, 0xdf, 0x08, 0x8f, 0x58,
+	0xda, 0x2d, 0xed, 0x95, 0xde, 0xab, 0x2a, 0x62, 0x8c, 0x5b, 0xda, 0xd5, 0x4c, 0xad, 0x8d, 0x9d,
+	0x07, 0xbf, 0x10, 0x00, 0x8d, 0x43, 0x1e, 0x7a, 0x08, 0x37, 0x77, 0x6b, 0x8d, 0x46, 0xb5, 0xa2,
+	0x2a, 0xd5, 0xc7, 0xd5, 0xd2, 0x8e, 0xba, 0x5f, 0xdf, 0xa9, 0x95, 0x3f, 0x98, 0x34, 0x9e, 0x75,
+	0xb8, 0x75, 0xae, 0x78, 0xb9, 0xd4, 0x2c, 0x6f, 0xab, 0x07, 0xfb, 0xa2, 0xc0, 0xe5, 0xcb, 0xb4,
+	0xf8, 0x7e, 0x60, 0xa3, 0xfb, 0x50, 0x38, 0x57, 0xbe, 0xb1, 0x5d, 0xdb, 0x6a, 0x8a, 0xb1, 0x42,
+	0xe6, 0xf9, 0x8b, 0x62, 0xb2, 0xd1, 0x31, 0x9e, 0x92, 0x07, 0x1f, 0x42, 0x2e, 0xcc, 0xcc, 0x50,
+	0x11, 0x90, 0x52, 0xdd, 0xaf, 0x96, 0x9a, 0xbe, 0xce, 0x5e, 0x7d, 0xaf, 0x2a, 0x5e, 0x2b, 0xa4,
+	0x9f, 0xbf, 0x28, 0x26, 0xf6, 0x2c, 0x93, 0xd6, 0xc9, 0x97, 0x86, 0x25, 0x9a, 0xb5, 0xdd, 0x6a,
+	0x43, 0x14, 0xb8, 0x55, 0x4a, 0x0c, 0x5d, 0x74, 0x0f, 0xae, 0x0f, 0xcb, 0x6c, 0xd5, 0xe9, 0x48,
+	0x82, 0xe9, 0xd9, 0xb2, 0x68, 0x9e, 0x70, 0x1e, 0xfc, 0x93, 0x22, 0xee, 0xf0, 0x5d, 0x30, 0xba,
+	0x0f, 0x52, 0xb9, 0x7e, 0xb0, 0xd7, 0xac, 0xd4, 0xdf, 0xdf, 0x53, 0x1b, 0xcd, 0x52, 0xf3, 0xa0,
+	0x31, 0x69, 0x5e, 0x1e, 0x42, 0x61, 0x4c, 0xb4, 0x51, 0xde, 0xae, 0x56, 0x0e, 0x76, 0xaa, 0x15,
+	0x51, 0x28, 0xe4, 0x9f, 0xbf, 0x28, 0x66, 0x1a, 0xde, 0xaf, 0x94, 0x75, 0xf4, 0x06, 0xdc, 0x18,
+	0x13, 0x2f, 0x95, 0x9b, 0xb5, 0xc7, 0x55, 0x31, 0xc6, 0xd7, 0x96, 0x5f, 0xfa, 0x9c, 0x2b, 0xb8,
+	0x5f, 0x3a, 0x68, 0x54, 0x2b, 0x62, 0x9c, 0x0b, 0xb2, 0x4a, 0xd9, 0xf9, 0x03, 0x28, 0xd7, 0x77,
+	0xf7, 0x77, 0xaa, 0xcd, 0x6a, 0x45, 0x4c, 0xf0, 0x01, 0xf8, 0x57, 0xcd, 0xfa, 0x83, 0xcf, 0x04,
+	0x10, 0x47, 0xcf, 0x4d, 0xe8, 0x01, 0xbc, 0xb2, 0x5b, 0xaf, 0x54, 0x95, 0x52, 0xb3, 0x56, 0xdf,
+	0x63, 0xe3, 0xa9, 0xef, 0x4d, 0x72, 0xf8, 0x0e, 0xac, 0x8c, 0xcb, 0x6e, 0xd7, 0x2a, 0x55, 0x51,
+	0xe0, 0x2b, 0xb4, 0x6d, 0xe8, 0xf8, 0x7c, 0xa9, 0xc6, 0x76, 0xfd, 0x7d, 0x31, 0xc6, 0xa5, 0x1a,
+	0x1d, 0xeb, 0x08, 0xad, 0x81, 0x34, 0x2e, 0xa5, 0x54, 0x77, 0xeb, 0x8f, 0xab, 0xbe, 0x97, 0x1c,
+	0x80, 0xcf, 0x1f, 0x61, 0xa5, 0xd6, 0xa0, 0x31, 0x26, 0x26, 0xf8, 0x08, 0x2b, 0x86, 0x4b, 0xd3,
+	0xf8, 0xa6, 0xf4, 0xf1, 0xd9, 0x6d, 0xe1, 0xd3, 0xb3, 0xdb, 0xc2, 0x67, 0x67, 0xb7, 0x85, 0x1f,
+	0xbd, 0xbc, 0x7d, 0xed, 0xd3, 0x97, 0xb7, 0xaf, 0xfd, 0xed, 0xe5, 0xed, 0x6b, 0x4f, 0x52, 0xec,
+	0xff, 0x24, 0x6f, 0xfd, 0x6b, 0x00, 0x96, 0x07, 0x5a, 0x5c, 0xaa, 0x32, 0x00, 0x00,
+}
+
+func (m *User) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *User) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n1, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.Username) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Username)))
+		i += copy(dAtA[i:], m.Username)
+	}
+	if m.RegisteredAt != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.RegisteredAt))
+	}
+	if len(m.Owner) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Owner)))
+		i += copy(dAtA[i:], m.Owner)
+	}
+	if m.Deposit != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Deposit.Size()))
+		n2, err := m.Deposit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	return i, nil
+}
+
+func (m *Countdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Countdown) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n3, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.Owner) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Owner)))
+		i += copy(dAtA[i:], m.Owner)
+	}
+	if len(m.Title) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
+		i += copy(dAtA[i:], m.Title)
+	}
+	if len(m.Lyrics) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Lyrics)))
+		i += copy(dAtA[i:], m.Lyrics)
+	}
+	if len(m.Countdown) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Countdown)))
+		i += copy(dAtA[i:], m.Countdown)
+	}
+	if m.CreatedAt != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CreatedAt))
+	}
+	if m.CompletedAt != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CompletedAt))
+	}
+	if m.DeleteAt != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DeleteAt))
+	}
+	if m.MissedRevealPolicy != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MissedRevealPolicy))
+	}
+	if len(m.Reveals) > 0 {
+		for _, msg := range m.Reveals {
+			dAtA[i] = 0x62
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Editors) > 0 {
+		for _, msg := range m.Editors {
+			dAtA[i] = 0x6a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.TaskID) > 0 {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.TaskID)))
+		i += copy(dAtA[i:], m.TaskID)
+	}
+	if m.PausedAt != 0 {
+		dAtA[i] = 0x78
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PausedAt))
+	}
+	if m.ScheduleStart != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ScheduleStart))
+	}
+	if m.NextRevealAt != 0 {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.NextRevealAt))
+	}
+	if len(m.PendingOwner) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PendingOwner)))
+		i += copy(dAtA[i:], m.PendingOwner)
+	}
+	if len(m.TipTotal) > 0 {
+		for _, msg := range m.TipTotal {
+			dAtA[i] = 0x9a
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.TopTippers) > 0 {
+		for _, msg := range m.TopTippers {
+			dAtA[i] = 0xa2
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Bounty) > 0 {
+		for _, msg := range m.Bounty {
+			dAtA[i] = 0xaa
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Beneficiary) > 0 {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Beneficiary)))
+		i += copy(dAtA[i:], m.Beneficiary)
+	}
+	if len(m.Sponsor) > 0 {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Sponsor)))
+		i += copy(dAtA[i:], m.Sponsor)
+	}
+	if m.UnlockPrice != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.UnlockPrice.Size()))
+		n4, err := m.UnlockPrice.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.UnlockedRevealAt != 0 {
+		dAtA[i] = 0xc8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.UnlockedRevealAt))
+	}
+	if m.Deposit != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Deposit.Size()))
+		n5, err := m.Deposit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if len(m.Depositor) > 0 {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Depositor)))
+		i += copy(dAtA[i:], m.Depositor)
+	}
+	if m.FlagCount != 0 {
+		dAtA[i] = 0xe0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.FlagCount))
+	}
+	if m.HiddenAt != 0 {
+		dAtA[i] = 0xe8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.HiddenAt))
+	}
+	if len(m.Attachments) > 0 {
+		for _, msg := range m.Attachments {
+			dAtA[i] = 0xf2
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.CompressedLyrics) > 0 {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CompressedLyrics)))
+		i += copy(dAtA[i:], m.CompressedLyrics)
+	}
+	if m.RevealedCount != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.RevealedCount))
+	}
+	if len(m.SuccessorID) > 0 {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.SuccessorID)))
+		i += copy(dAtA[i:], m.SuccessorID)
+	}
+	if len(m.PredecessorID) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PredecessorID)))
+		i += copy(dAtA[i:], m.PredecessorID)
+	}
+	if m.RepeatPolicy != 0 {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.RepeatPolicy))
+	}
+	if m.RepeatCount != 0 {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.RepeatCount))
+	}
+	if m.Iteration != 0 {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Iteration))
+	}
+	if len(m.SourceID) > 0 {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.SourceID)))
+		i += copy(dAtA[i:], m.SourceID)
+	}
+	if len(m.SourceOwner) > 0 {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.SourceOwner)))
+		i += copy(dAtA[i:], m.SourceOwner)
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			dAtA[i] = 0xc2
+			i++
+			dAtA[i] = 0x2
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *Attachment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Attachment) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Line != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Line))
+	}
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
+	}
+	if len(m.MimeType) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.MimeType)))
+		i += copy(dAtA[i:], m.MimeType)
+	}
+	return i, nil
+}
+
+func (m *TopTipper) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopTipper) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	if m.Total != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Total.Size()))
+		n6, err := m.Total.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	return i, nil
+}
+
+func (m *Tipper) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Tipper) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n7, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.CountdownID) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CountdownID)))
+		i += copy(dAtA[i:], m.CountdownID)
+	}
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	if len(m.Total) > 0 {
+		for _, msg := range m.Total {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Flag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Flag) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n8, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.CountdownID) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CountdownID)))
+		i += copy(dAtA[i:], m.CountdownID)
+	}
+	if len(m.Reporter) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Reporter)))
+		i += copy(dAtA[i:], m.Reporter)
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	if m.FlaggedAt != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.FlaggedAt))
+	}
+	return i, nil
+}
+
+func (m *Draft) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Draft) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n9, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.Owner) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Owner)))
+		i += copy(dAtA[i:], m.Owner)
+	}
+	if len(m.Title) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
+		i += copy(dAtA[i:], m.Title)
+	}
+	if m.MissedRevealPolicy != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MissedRevealPolicy))
+	}
+	if len(m.Bounty) > 0 {
+		for _, msg := range m.Bounty {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Beneficiary) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Beneficiary)))
+		i += copy(dAtA[i:], m.Beneficiary)
+	}
+	if len(m.Sponsor) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Sponsor)))
+		i += copy(dAtA[i:], m.Sponsor)
+	}
+	if m.DeleteAt != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DeleteAt))
+	}
+	if m.CreatedAt != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CreatedAt))
+	}
+	if len(m.Lyrics) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Lyrics)))
+		i += copy(dAtA[i:], m.Lyrics)
+	}
+	if m.Chunks != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Chunks))
+	}
+	if len(m.LyricsHash) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.LyricsHash)))
+		i += copy(dAtA[i:], m.LyricsHash)
+	}
+	if m.RepeatPolicy != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.RepeatPolicy))
+	}
+	if m.RepeatCount != 0 {
+		dAtA[i] = 0x78
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.RepeatCount))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Deposit != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Deposit.Size()))
+		n10, err := m.Deposit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if len(m.Depositor) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Depositor)))
+		i += copy(dAtA[i:], m.Depositor)
+	}
+	return i, nil
+}
+
+func (m *Series) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Series) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n11, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.Owner) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Owner)))
+		i += copy(dAtA[i:], m.Owner)
+	}
+	if len(m.Title) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
+		i += copy(dAtA[i:], m.Title)
+	}
+	if len(m.CountdownIDs) > 0 {
+		for _, b := range m.CountdownIDs {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.CreatedAt != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CreatedAt))
+	}
+	return i, nil
+}
+
+func (m *CreationLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreationLog) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n12, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.CreatedAt) > 0 {
+		dAtA14 := make([]byte, len(m.CreatedAt)*10)
+		var j13 int
+		for _, num1 := range m.CreatedAt {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(j13))
+		i += copy(dAtA[i:], dAtA14[:j13])
+	}
+	return i, nil
+}
+
+func (m *Editor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Editor) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	if m.Role != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Role))
+	}
+	return i, nil
+}
+
+func (m *Reveal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Reveal) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Line != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Line))
+	}
+	if m.Height != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Height))
+	}
+	if m.RevealedAt != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.RevealedAt))
+	}
+	return i, nil
+}
+
+func (m *CountdownProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CountdownProgress) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.CountdownID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CountdownID)))
+		i += copy(dAtA[i:], m.CountdownID)
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Status))
+	}
+	if m.RevealedCount != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.RevealedCount))
+	}
+	if m.TotalLines != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TotalLines))
+	}
+	if m.PercentComplete != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PercentComplete))
+	}
+	if m.NextRevealAt != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.NextRevealAt))
+	}
+	if m.EstimatedCompletionAt != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EstimatedCompletionAt))
+	}
+	if m.Iteration != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Iteration))
+	}
+	return i, nil
+}
+
+func (m *CountdownTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CountdownTask) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n15, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.CountdownID) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CountdownID)))
+		i += copy(dAtA[i:], m.CountdownID)
+	}
+	if len(m.TaskOwner) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.TaskOwner)))
+		i += copy(dAtA[i:], m.TaskOwner)
+	}
+	return i, nil
+}
+
+func (m *Configuration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Configuration) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n16, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	if len(m.Owner) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Owner)))
+		i += copy(dAtA[i:], m.Owner)
+	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.UserDeposit.Size()))
+	n17, err := m.UserDeposit.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n17
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.CountdownDeposit.Size()))
+	n18, err := m.CountdownDeposit.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n18
+	if m.NewUserCost != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.NewUserCost))
+	}
+	if m.NewCountdownCost != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.NewCountdownCost))
+	}
+	if m.CountdownCostUnit != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CountdownCostUnit))
+	}
+	if m.MinLineLength != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MinLineLength))
+	}
+	if m.MaxLineLength != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MaxLineLength))
+	}
+	if m.RevealInterval != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.RevealInterval))
+	}
+	if len(m.Moderators) > 0 {
+		for _, b := range m.Moderators {
+			dAtA[i] = 0x5a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.MaxActiveCountdowns != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MaxActiveCountdowns))
+	}
+	if m.MaxCountdownsPerWindow != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MaxCountdownsPerWindow))
+	}
+	if m.CreationWindow != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CreationWindow))
+	}
+	if m.MaxLineBytes != 0 {
+		dAtA[i] = 0x78
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MaxLineBytes))
+	}
+	return i, nil
+}
+
+func (m *UpdateConfigurationMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n19, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	if m.Patch != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Patch.Size()))
+		n20, err := m.Patch.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	return i, nil
+}
+
+func (m *CreateUserMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateUserMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n21, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	if len(m.Username) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Username)))
+		i += copy(dAtA[i:], m.Username)
+	}
+	return i, nil
+}
+
+func (m *CreateCountdownMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n22, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	if len(m.Title) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
+		i += copy(dAtA[i:], m.Title)
+	}
+	if len(m.Lyrics) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Lyrics)))
+		i += copy(dAtA[i:], m.Lyrics)
+	}
+	if m.MissedRevealPolicy != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MissedRevealPolicy))
+	}
+	if len(m.Owner) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Owner)))
+		i += copy(dAtA[i:], m.Owner)
+	}
+	if len(m.Bounty) > 0 {
+		for _, msg := range m.Bounty {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Beneficiary) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Beneficiary)))
+		i += copy(dAtA[i:], m.Beneficiary)
+	}
+	if len(m.Sponsor) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Sponsor)))
+		i += copy(dAtA[i:], m.Sponsor)
+	}
+	if m.DeleteAt != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DeleteAt))
+	}
+	if len(m.Attachments) > 0 {
+		for _, msg := range m.Attachments {
+			dAtA[i] = 0x52
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.RepeatPolicy != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.RepeatPolicy))
+	}
+	if m.RepeatCount != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.RepeatCount))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			dAtA[i] = 0x6a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *DeleteCountdownMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n23, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	return i, nil
+}
+
+func (m *GrantRoleMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantRoleMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n24, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	if len(m.CountdownID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CountdownID)))
+		i += copy(dAtA[i:], m.CountdownID)
+	}
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	if m.Role != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Role))
+	}
+	return i, nil
+}
+
+func (m *RevokeRoleMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokeRoleMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n25, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
+	if len(m.CountdownID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CountdownID)))
+		i += copy(dAtA[i:], m.CountdownID)
+	}
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	return i, nil
+}
+
+func (m *UpdateLyricsMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateLyricsMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n26, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
+	}
+	if len(m.CountdownID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CountdownID)))
+		i += copy(dAtA[i:], m.CountdownID)
+	}
+	if len(m.Lyrics) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Lyrics)))
+		i += copy(dAtA[i:], m.Lyrics)
+	}
+	if len(m.Attachments) > 0 {
+		for _, msg := range m.Attachments {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *PauseCountdownMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n27, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
+	}
+	if len(m.CountdownID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CountdownID)))
+		i += copy(dAtA[i:], m.CountdownID)
+	}
+	return i, nil
+}
+
+func (m *ResumeCountdownMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResumeCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n28, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	if len(m.CountdownID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CountdownID)))
+		i += copy(dAtA[i:], m.CountdownID)
+	}
+	return i, nil
+}
+
+func (m *TransferCountdownMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n29, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
+	if len(m.CountdownID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CountdownID)))
+		i += copy(dAtA[i:], m.CountdownID)
+	}
+	if len(m.NewOwner) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.NewOwner)))
+		i += copy(dAtA[i:], m.NewOwner)
+	}
+	if m.RequireAccept {
+		dAtA[i] = 0x20
+		i++
+		if m.RequireAccept {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *AcceptCountdownTransferMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AcceptCountdownTransferMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n30, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
+	}
+	if len(m.CountdownID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CountdownID)))
+		i += copy(dAtA[i:], m.CountdownID)
+	}
+	return i, nil
+}
+
+func (m *TipCountdownMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TipCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n31, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
+	}
+	if len(m.CountdownID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CountdownID)))
+		i += copy(dAtA[i:], m.CountdownID)
+	}
+	if len(m.Tipper) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Tipper)))
+		i += copy(dAtA[i:], m.Tipper)
+	}
+	if m.Amount != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Amount.Size()))
+		n32, err := m.Amount.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
+	}
+	return i, nil
+}
+
+func (m *SetUnlockPriceMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetUnlockPriceMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n33, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
+	}
+	if len(m.CountdownID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CountdownID)))
+		i += copy(dAtA[i:], m.CountdownID)
+	}
+	if m.Price != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Price.Size()))
+		n34, err := m.Price.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
+	}
+	return i, nil
+}
+
+func (m *UnlockNextLineMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnlockNextLineMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n35, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
+	}
+	if len(m.CountdownID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CountdownID)))
+		i += copy(dAtA[i:], m.CountdownID)
+	}
+	if len(m.Payer) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Payer)))
+		i += copy(dAtA[i:], m.Payer)
+	}
+	return i, nil
+}
+
+func (m *DeleteUserMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteUserMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n36, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
+	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	return i, nil
+}
+
+func (m *FlagCountdownMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlagCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n37, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n37
+	}
+	if len(m.CountdownID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CountdownID)))
+		i += copy(dAtA[i:], m.CountdownID)
+	}
+	if len(m.Reporter) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Reporter)))
+		i += copy(dAtA[i:], m.Reporter)
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	return i, nil
+}
+
+func (m *ModerateCountdownMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModerateCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n38, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n38
+	}
+	if len(m.CountdownID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CountdownID)))
+		i += copy(dAtA[i:], m.CountdownID)
+	}
+	if m.Action != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Action))
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	return i, nil
+}
+
+func (m *CreateCountdownDraftMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateCountdownDraftMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n39, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
+	}
+	if len(m.Title) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
+		i += copy(dAtA[i:], m.Title)
+	}
+	if m.MissedRevealPolicy != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MissedRevealPolicy))
+	}
+	if len(m.Owner) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Owner)))
+		i += copy(dAtA[i:], m.Owner)
+	}
+	if len(m.Bounty) > 0 {
+		for _, msg := range m.Bounty {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Beneficiary) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Beneficiary)))
+		i += copy(dAtA[i:], m.Beneficiary)
+	}
+	if len(m.Sponsor) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Sponsor)))
+		i += copy(dAtA[i:], m.Sponsor)
+	}
+	if m.DeleteAt != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DeleteAt))
+	}
+	if m.RepeatPolicy != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.RepeatPolicy))
+	}
+	if m.RepeatCount != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.RepeatCount))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			dAtA[i] = 0x5a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *AppendLyricsChunkMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppendLyricsChunkMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n40, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
+	}
+	if len(m.DraftID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.DraftID)))
+		i += copy(dAtA[i:], m.DraftID)
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Index))
+	}
+	if len(m.Lyrics) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Lyrics)))
+		i += copy(dAtA[i:], m.Lyrics)
+	}
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
+	}
+	return i, nil
+}
+
+func (m *PublishCountdownMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PublishCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n41, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n41
+	}
+	if len(m.DraftID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.DraftID)))
+		i += copy(dAtA[i:], m.DraftID)
+	}
+	if len(m.LyricsHash) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.LyricsHash)))
+		i += copy(dAtA[i:], m.LyricsHash)
+	}
+	if len(m.Attachments) > 0 {
+		for _, msg := range m.Attachments {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *DeleteCountdownDraftMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteCountdownDraftMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n42, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n42
+	}
+	if len(m.DraftID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.DraftID)))
+		i += copy(dAtA[i:], m.DraftID)
+	}
+	return i, nil
+}
+
+func (m *SetSuccessorMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetSuccessorMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n43, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n43
+	}
+	if len(m.CountdownID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CountdownID)))
+		i += copy(dAtA[i:], m.CountdownID)
+	}
+	if len(m.SuccessorID) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.SuccessorID)))
+		i += copy(dAtA[i:], m.SuccessorID)
+	}
+	return i, nil
+}
+
+func (m *RestartCountdownMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestartCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n44, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n44
+	}
+	if len(m.CountdownID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CountdownID)))
+		i += copy(dAtA[i:], m.CountdownID)
+	}
+	return i, nil
+}
+
+func (m *ForkCountdownMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForkCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n45, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n45
+	}
+	if len(m.SourceID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.SourceID)))
+		i += copy(dAtA[i:], m.SourceID)
+	}
+	if len(m.Title) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
+		i += copy(dAtA[i:], m.Title)
+	}
+	if m.MissedRevealPolicy != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MissedRevealPolicy))
+	}
+	if len(m.Lines) > 0 {
+		dAtA47 := make([]byte, len(m.Lines)*10)
+		var j46 int
+		for _, num1 := range m.Lines {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA47[j46] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j46++
+			}
+			dAtA47[j46] = uint8(num)
+			j46++
+		}
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(j46))
+		i += copy(dAtA[i:], dAtA47[:j46])
+	}
+	if len(m.Edits) > 0 {
+		for _, msg := range m.Edits {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *LineEdit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LineEdit) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Line != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Line))
+	}
+	if len(m.Text) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Text)))
+		i += copy(dAtA[i:], m.Text)
+	}
+	return i, nil
+}
+
+func (m *CreateSeriesMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateSeriesMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n48, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n48
+	}
+	if len(m.Title) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
+		i += copy(dAtA[i:], m.Title)
+	}
+	if len(m.Owner) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Owner)))
+		i += copy(dAtA[i:], m.Owner)
+	}
+	if len(m.CountdownIDs) > 0 {
+		for _, b := range m.CountdownIDs {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	return i, nil
+}
+
+func (m *AddSeriesCountdownMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddSeriesCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n49, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n49
+	}
+	if len(m.SeriesID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.SeriesID)))
+		i += copy(dAtA[i:], m.SeriesID)
+	}
+	if len(m.CountdownID) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CountdownID)))
+		i += copy(dAtA[i:], m.CountdownID)
+	}
+	if m.Position != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Position))
+	}
+	return i, nil
+}
+
+func (m *RemoveSeriesCountdownMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveSeriesCountdownMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n50, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n50
+	}
+	if len(m.SeriesID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.SeriesID)))
+		i += copy(dAtA[i:], m.SeriesID)
+	}
+	if len(m.CountdownID) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CountdownID)))
+		i += copy(dAtA[i:], m.CountdownID)
+	}
+	return i, nil
+}
+
+func (m *ReorderSeriesMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReorderSeriesMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n51, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n51
+	}
+	if len(m.SeriesID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.SeriesID)))
+		i += copy(dAtA[i:], m.SeriesID)
+	}
+	if len(m.CountdownIDs) > 0 {
+		for _, b := range m.CountdownIDs {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	return i, nil
+}
+
+func (m *MigrateCountdownsMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrateCountdownsMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n52, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n52
+	}
+	if len(m.StartID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.StartID)))
+		i += copy(dAtA[i:], m.StartID)
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Limit))
+	}
+	return i, nil
+}
+
+func (m *ExpireCountdownTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExpireCountdownTask) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n53, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n53
+	}
+	if len(m.CountdownID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CountdownID)))
+		i += copy(dAtA[i:], m.CountdownID)
+	}
+	return i, nil
+}
+
+func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *User) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.RegisteredAt != 0 {
+		n += 1 + sovCodec(uint64(m.RegisteredAt))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Deposit != nil {
+		l = m.Deposit.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *Countdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Lyrics)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Countdown)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovCodec(uint64(m.CreatedAt))
+	}
+	if m.CompletedAt != 0 {
+		n += 1 + sovCodec(uint64(m.CompletedAt))
+	}
+	if m.DeleteAt != 0 {
+		n += 1 + sovCodec(uint64(m.DeleteAt))
+	}
+	if m.MissedRevealPolicy != 0 {
+		n += 1 + sovCodec(uint64(m.MissedRevealPolicy))
+	}
+	if len(m.Reveals) > 0 {
+		for _, e := range m.Reveals {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if len(m.Editors) > 0 {
+		for _, e := range m.Editors {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	l = len(m.TaskID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.PausedAt != 0 {
+		n += 1 + sovCodec(uint64(m.PausedAt))
+	}
+	if m.ScheduleStart != 0 {
+		n += 2 + sovCodec(uint64(m.ScheduleStart))
+	}
+	if m.NextRevealAt != 0 {
+		n += 2 + sovCodec(uint64(m.NextRevealAt))
+	}
+	l = len(m.PendingOwner)
+	if l > 0 {
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	if len(m.TipTotal) > 0 {
+		for _, e := range m.TipTotal {
+			l = e.Size()
+			n += 2 + l + sovCodec(uint64(l))
+		}
+	}
+	if len(m.TopTippers) > 0 {
+		for _, e := range m.TopTippers {
+			l = e.Size()
+			n += 2 + l + sovCodec(uint64(l))
+		}
+	}
+	if len(m.Bounty) > 0 {
+		for _, e := range m.Bounty {
+			l = e.Size()
+			n += 2 + l + sovCodec(uint64(l))
+		}
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	if m.UnlockPrice != nil {
+		l = m.UnlockPrice.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	if m.UnlockedRevealAt != 0 {
+		n += 2 + sovCodec(uint64(m.UnlockedRevealAt))
+	}
+	if m.Deposit != nil {
+		l = m.Deposit.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	if m.FlagCount != 0 {
+		n += 2 + sovCodec(uint64(m.FlagCount))
+	}
+	if m.HiddenAt != 0 {
+		n += 2 + sovCodec(uint64(m.HiddenAt))
+	}
+	if len(m.Attachments) > 0 {
+		for _, e := range m.Attachments {
+			l = e.Size()
+			n += 2 + l + sovCodec(uint64(l))
+		}
+	}
+	l = len(m.CompressedLyrics)
+	if l > 0 {
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	if m.RevealedCount != 0 {
+		n += 2 + sovCodec(uint64(m.RevealedCount))
+	}
+	l = len(m.SuccessorID)
+	if l > 0 {
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PredecessorID)
+	if l > 0 {
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	if m.RepeatPolicy != 0 {
+		n += 2 + sovCodec(uint64(m.RepeatPolicy))
+	}
+	if m.RepeatCount != 0 {
+		n += 2 + sovCodec(uint64(m.RepeatCount))
+	}
+	if m.Iteration != 0 {
+		n += 2 + sovCodec(uint64(m.Iteration))
+	}
+	l = len(m.SourceID)
+	if l > 0 {
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	l = len(m.SourceOwner)
+	if l > 0 {
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 2 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Attachment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Line != 0 {
+		n += 1 + sovCodec(uint64(m.Line))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.MimeType)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *TopTipper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Total != nil {
+		l = m.Total.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *Tipper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.CountdownID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Flag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.CountdownID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.FlaggedAt != 0 {
+		n += 1 + sovCodec(uint64(m.FlaggedAt))
+	}
+	return n
+}
+
+func (m *Draft) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.MissedRevealPolicy != 0 {
+		n += 1 + sovCodec(uint64(m.MissedRevealPolicy))
+	}
+	if len(m.Bounty) > 0 {
+		for _, e := range m.Bounty {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.DeleteAt != 0 {
+		n += 1 + sovCodec(uint64(m.DeleteAt))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovCodec(uint64(m.CreatedAt))
+	}
+	l = len(m.Lyrics)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Chunks != 0 {
+		n += 1 + sovCodec(uint64(m.Chunks))
+	}
+	l = len(m.LyricsHash)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.RepeatPolicy != 0 {
+		n += 1 + sovCodec(uint64(m.RepeatPolicy))
+	}
+	if m.RepeatCount != 0 {
+		n += 1 + sovCodec(uint64(m.RepeatCount))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 2 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.Deposit != nil {
+		l = m.Deposit.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *Series) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.CountdownIDs) > 0 {
+		for _, b := range m.CountdownIDs {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovCodec(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *CreationLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.CreatedAt) > 0 {
+		l = 0
+		for _, e := range m.CreatedAt {
+			l += sovCodec(uint64(e))
+		}
+		n += 1 + sovCodec(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *Editor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovCodec(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *Reveal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Line != 0 {
+		n += 1 + sovCodec(uint64(m.Line))
+	}
+	if m.Height != 0 {
+		n += 1 + sovCodec(uint64(m.Height))
+	}
+	if m.RevealedAt != 0 {
+		n += 1 + sovCodec(uint64(m.RevealedAt))
+	}
+	return n
+}
+
+func (m *CountdownProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CountdownID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovCodec(uint64(m.Status))
+	}
+	if m.RevealedCount != 0 {
+		n += 1 + sovCodec(uint64(m.RevealedCount))
+	}
+	if m.TotalLines != 0 {
+		n += 1 + sovCodec(uint64(m.TotalLines))
+	}
+	if m.PercentComplete != 0 {
+		n += 1 + sovCodec(uint64(m.PercentComplete))
+	}
+	if m.NextRevealAt != 0 {
+		n += 1 + sovCodec(uint64(m.NextRevealAt))
+	}
+	if m.EstimatedCompletionAt != 0 {
+		n += 1 + sovCodec(uint64(m.EstimatedCompletionAt))
+	}
+	if m.Iteration != 0 {
+		n += 1 + sovCodec(uint64(m.Iteration))
+	}
+	return n
+}
+
+func (m *CountdownTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.CountdownID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.TaskOwner)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *Configuration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = m.UserDeposit.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	l = m.CountdownDeposit.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	if m.NewUserCost != 0 {
+		n += 1 + sovCodec(uint64(m.NewUserCost))
+	}
+	if m.NewCountdownCost != 0 {
+		n += 1 + sovCodec(uint64(m.NewCountdownCost))
+	}
+	if m.CountdownCostUnit != 0 {
+		n += 1 + sovCodec(uint64(m.CountdownCostUnit))
+	}
+	if m.MinLineLength != 0 {
+		n += 1 + sovCodec(uint64(m.MinLineLength))
+	}
+	if m.MaxLineLength != 0 {
+		n += 1 + sovCodec(uint64(m.MaxLineLength))
+	}
+	if m.RevealInterval != 0 {
+		n += 1 + sovCodec(uint64(m.RevealInterval))
+	}
+	if len(m.Moderators) > 0 {
+		for _, b := range m.Moderators {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.MaxActiveCountdowns != 0 {
+		n += 1 + sovCodec(uint64(m.MaxActiveCountdowns))
+	}
+	if m.MaxCountdownsPerWindow != 0 {
+		n += 1 + sovCodec(uint64(m.MaxCountdownsPerWindow))
+	}
+	if m.CreationWindow != 0 {
+		n += 1 + sovCodec(uint64(m.CreationWindow))
+	}
+	if m.MaxLineBytes != 0 {
+		n += 1 + sovCodec(uint64(m.MaxLineBytes))
+	}
+	return n
+}
+
+func (m *UpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Patch != nil {
+		l = m.Patch.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateUserMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Lyrics)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.MissedRevealPolicy != 0 {
+		n += 1 + sovCodec(uint64(m.MissedRevealPolicy))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Bounty) > 0 {
+		for _, e := range m.Bounty {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.DeleteAt != 0 {
+		n += 1 + sovCodec(uint64(m.DeleteAt))
+	}
+	if len(m.Attachments) > 0 {
+		for _, e := range m.Attachments {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.RepeatPolicy != 0 {
+		n += 1 + sovCodec(uint64(m.RepeatPolicy))
+	}
+	if m.RepeatCount != 0 {
+		n += 1 + sovCodec(uint64(m.RepeatCount))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeleteCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *GrantRoleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.CountdownID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovCodec(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *RevokeRoleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.CountdownID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateLyricsMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.CountdownID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Lyrics)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Attachments) > 0 {
+		for _, e := range m.Attachments {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PauseCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.CountdownID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *ResumeCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.CountdownID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.CountdownID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.RequireAccept {
+		n += 2
+	}
+	return n
+}
+
+func (m *AcceptCountdownTransferMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.CountdownID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *TipCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.CountdownID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Tipper)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *SetUnlockPriceMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.CountdownID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *UnlockNextLineMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.CountdownID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteUserMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *FlagCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.CountdownID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *ModerateCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.CountdownID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovCodec(uint64(m.Action))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateCountdownDraftMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.MissedRevealPolicy != 0 {
+		n += 1 + sovCodec(uint64(m.MissedRevealPolicy))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Bounty) > 0 {
+		for _, e := range m.Bounty {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.DeleteAt != 0 {
+		n += 1 + sovCodec(uint64(m.DeleteAt))
+	}
+	if m.RepeatPolicy != 0 {
+		n += 1 + sovCodec(uint64(m.RepeatPolicy))
+	}
+	if m.RepeatCount != 0 {
+		n += 1 + sovCodec(uint64(m.RepeatCount))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AppendLyricsChunkMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.DraftID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovCodec(uint64(m.Index))
+	}
+	l = len(m.Lyrics)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *PublishCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.DraftID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.LyricsHash)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Attachments) > 0 {
+		for _, e := range m.Attachments {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeleteCountdownDraftMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.DraftID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *SetSuccessorMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.CountdownID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.SuccessorID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *RestartCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.CountdownID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *ForkCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.SourceID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.MissedRevealPolicy != 0 {
+		n += 1 + sovCodec(uint64(m.MissedRevealPolicy))
+	}
+	if len(m.Lines) > 0 {
+		l = 0
+		for _, e := range m.Lines {
+			l += sovCodec(uint64(e))
+		}
+		n += 1 + sovCodec(uint64(l)) + l
+	}
+	if len(m.Edits) > 0 {
+		for _, e := range m.Edits {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LineEdit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Line != 0 {
+		n += 1 + sovCodec(uint64(m.Line))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateSeriesMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.CountdownIDs) > 0 {
+		for _, b := range m.CountdownIDs {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AddSeriesCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.SeriesID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.CountdownID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Position != 0 {
+		n += 1 + sovCodec(uint64(m.Position))
+	}
+	return n
+}
+
+func (m *RemoveSeriesCountdownMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.SeriesID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.CountdownID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *ReorderSeriesMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.SeriesID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.CountdownIDs) > 0 {
+		for _, b := range m.CountdownIDs {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MigrateCountdownsMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.StartID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovCodec(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *ExpireCountdownTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
 		l = m.Metadata.Size()
 		n += 1 + l + sovCodec(uint64(l))
 	}
-	l = len(m.Username)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	l = len(m.CountdownID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func sovCodec(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozCodec(x uint64) (n int) {
+	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *User) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: User: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredAt", wireType)
+			}
+			m.RegisteredAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegisteredAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deposit == nil {
+				m.Deposit = &coin.Coin{}
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Countdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Countdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Countdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lyrics", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lyrics = append(m.Lyrics[:0], dAtA[iNdEx:postIndex]...)
+			if m.Lyrics == nil {
+				m.Lyrics = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Countdown", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Countdown = append(m.Countdown[:0], dAtA[iNdEx:postIndex]...)
+			if m.Countdown == nil {
+				m.Countdown = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAt", wireType)
+			}
+			m.CompletedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteAt", wireType)
+			}
+			m.DeleteAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeleteAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedRevealPolicy", wireType)
+			}
+			m.MissedRevealPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedRevealPolicy |= MissedRevealPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reveals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reveals = append(m.Reveals, &Reveal{})
+			if err := m.Reveals[len(m.Reveals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Editors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Editors = append(m.Editors, &Editor{})
+			if err := m.Editors[len(m.Editors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskID = append(m.TaskID[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaskID == nil {
+				m.TaskID = []byte{}
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedAt", wireType)
+			}
+			m.PausedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PausedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleStart", wireType)
+			}
+			m.ScheduleStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleStart |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRevealAt", wireType)
+			}
+			m.NextRevealAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRevealAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOwner = append(m.PendingOwner[:0], dAtA[iNdEx:postIndex]...)
+			if m.PendingOwner == nil {
+				m.PendingOwner = []byte{}
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TipTotal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TipTotal = append(m.TipTotal, &coin.Coin{})
+			if err := m.TipTotal[len(m.TipTotal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopTippers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopTippers = append(m.TopTippers, &TopTipper{})
+			if err := m.TopTippers[len(m.TopTippers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bounty = append(m.Bounty, &coin.Coin{})
+			if err := m.Bounty[len(m.Bounty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = append(m.Beneficiary[:0], dAtA[iNdEx:postIndex]...)
+			if m.Beneficiary == nil {
+				m.Beneficiary = []byte{}
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = append(m.Sponsor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sponsor == nil {
+				m.Sponsor = []byte{}
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UnlockPrice == nil {
+				m.UnlockPrice = &coin.Coin{}
+			}
+			if err := m.UnlockPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockedRevealAt", wireType)
+			}
+			m.UnlockedRevealAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockedRevealAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deposit == nil {
+				m.Deposit = &coin.Coin{}
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Depositor == nil {
+				m.Depositor = []byte{}
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlagCount", wireType)
+			}
+			m.FlagCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlagCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HiddenAt", wireType)
+			}
+			m.HiddenAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HiddenAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attachments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attachments = append(m.Attachments, &Attachment{})
+			if err := m.Attachments[len(m.Attachments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressedLyrics", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompressedLyrics = append(m.CompressedLyrics[:0], dAtA[iNdEx:postIndex]...)
+			if m.CompressedLyrics == nil {
+				m.CompressedLyrics = []byte{}
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealedCount", wireType)
+			}
+			m.RevealedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealedCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessorID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuccessorID = append(m.SuccessorID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SuccessorID == nil {
+				m.SuccessorID = []byte{}
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredecessorID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredecessorID = append(m.PredecessorID[:0], dAtA[iNdEx:postIndex]...)
+			if m.PredecessorID == nil {
+				m.PredecessorID = []byte{}
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatPolicy", wireType)
+			}
+			m.RepeatPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepeatPolicy |= RepeatPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatCount", wireType)
+			}
+			m.RepeatCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepeatCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iteration", wireType)
+			}
+			m.Iteration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Iteration |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceID = append(m.SourceID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SourceID == nil {
+				m.SourceID = []byte{}
+			}
+			iNdEx = postIndex
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceOwner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceOwner = append(m.SourceOwner[:0], dAtA[iNdEx:postIndex]...)
+			if m.SourceOwner == nil {
+				m.SourceOwner = []byte{}
+			}
+			iNdEx = postIndex
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Attachment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attachment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attachment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Line", wireType)
+			}
+			m.Line = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Line |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MimeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopTipper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopTipper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopTipper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Total == nil {
+				m.Total = &coin.Coin{}
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tipper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tipper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tipper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountdownID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CountdownID = append(m.CountdownID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CountdownID == nil {
+				m.CountdownID = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, &coin.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Flag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Flag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Flag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountdownID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CountdownID = append(m.CountdownID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CountdownID == nil {
+				m.CountdownID = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = append(m.Reporter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Reporter == nil {
+				m.Reporter = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlaggedAt", wireType)
+			}
+			m.FlaggedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlaggedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Draft) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Draft: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Draft: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedRevealPolicy", wireType)
+			}
+			m.MissedRevealPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedRevealPolicy |= MissedRevealPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bounty = append(m.Bounty, &coin.Coin{})
+			if err := m.Bounty[len(m.Bounty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = append(m.Beneficiary[:0], dAtA[iNdEx:postIndex]...)
+			if m.Beneficiary == nil {
+				m.Beneficiary = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = append(m.Sponsor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sponsor == nil {
+				m.Sponsor = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteAt", wireType)
+			}
+			m.DeleteAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeleteAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lyrics", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lyrics = append(m.Lyrics[:0], dAtA[iNdEx:postIndex]...)
+			if m.Lyrics == nil {
+				m.Lyrics = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			m.Chunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chunks |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LyricsHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LyricsHash = append(m.LyricsHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LyricsHash == nil {
+				m.LyricsHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatPolicy", wireType)
+			}
+			m.RepeatPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepeatPolicy |= RepeatPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatCount", wireType)
+			}
+			m.RepeatCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepeatCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deposit == nil {
+				m.Deposit = &coin.Coin{}
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Depositor == nil {
+				m.Depositor = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Series) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Series: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Series: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountdownIDs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CountdownIDs = append(m.CountdownIDs, make([]byte, postIndex-iNdEx))
+			copy(m.CountdownIDs[len(m.CountdownIDs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreationLog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreationLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreationLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v github_com_iov_one_weave.UnixTime
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCodec
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CreatedAt = append(m.CreatedAt, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCodec
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCodec
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCodec
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CreatedAt) == 0 {
+					m.CreatedAt = make([]github_com_iov_one_weave.UnixTime, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v github_com_iov_one_weave.UnixTime
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCodec
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CreatedAt = append(m.CreatedAt, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Editor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Editor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Editor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= EditorRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Reveal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reveal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reveal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Line", wireType)
+			}
+			m.Line = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Line |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealedAt", wireType)
+			}
+			m.RevealedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CountdownProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CountdownProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CountdownProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountdownID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CountdownID = append(m.CountdownID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CountdownID == nil {
+				m.CountdownID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= CountdownStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealedCount", wireType)
+			}
+			m.RevealedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealedCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLines", wireType)
+			}
+			m.TotalLines = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalLines |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PercentComplete", wireType)
+			}
+			m.PercentComplete = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PercentComplete |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRevealAt", wireType)
+			}
+			m.NextRevealAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRevealAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedCompletionAt", wireType)
+			}
+			m.EstimatedCompletionAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedCompletionAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iteration", wireType)
+			}
+			m.Iteration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Iteration |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CountdownTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CountdownTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CountdownTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountdownID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CountdownID = append(m.CountdownID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CountdownID == nil {
+				m.CountdownID = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskOwner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskOwner = append(m.TaskOwner[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaskOwner == nil {
+				m.TaskOwner = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Configuration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Configuration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Configuration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UserDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountdownDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CountdownDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewUserCost", wireType)
+			}
+			m.NewUserCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewUserCost |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCountdownCost", wireType)
+			}
+			m.NewCountdownCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewCountdownCost |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountdownCostUnit", wireType)
+			}
+			m.CountdownCostUnit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CountdownCostUnit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLineLength", wireType)
+			}
+			m.MinLineLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinLineLength |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLineLength", wireType)
+			}
+			m.MaxLineLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLineLength |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealInterval", wireType)
+			}
+			m.RevealInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealInterval |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moderators", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moderators = append(m.Moderators, make([]byte, postIndex-iNdEx))
+			copy(m.Moderators[len(m.Moderators)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActiveCountdowns", wireType)
+			}
+			m.MaxActiveCountdowns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActiveCountdowns |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCountdownsPerWindow", wireType)
+			}
+			m.MaxCountdownsPerWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCountdownsPerWindow |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationWindow", wireType)
+			}
+			m.CreationWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationWindow |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLineBytes", wireType)
+			}
+			m.MaxLineBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLineBytes |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateConfigurationMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateConfigurationMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateConfigurationMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Patch == nil {
+				m.Patch = &Configuration{}
+			}
+			if err := m.Patch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateUserMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateUserMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateUserMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateCountdownMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateCountdownMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateCountdownMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lyrics", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lyrics = append(m.Lyrics[:0], dAtA[iNdEx:postIndex]...)
+			if m.Lyrics == nil {
+				m.Lyrics = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedRevealPolicy", wireType)
+			}
+			m.MissedRevealPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedRevealPolicy |= MissedRevealPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bounty = append(m.Bounty, &coin.Coin{})
+			if err := m.Bounty[len(m.Bounty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = append(m.Beneficiary[:0], dAtA[iNdEx:postIndex]...)
+			if m.Beneficiary == nil {
+				m.Beneficiary = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = append(m.Sponsor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sponsor == nil {
+				m.Sponsor = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteAt", wireType)
+			}
+			m.DeleteAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeleteAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attachments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attachments = append(m.Attachments, &Attachment{})
+			if err := m.Attachments[len(m.Attachments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatPolicy", wireType)
+			}
+			m.RepeatPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepeatPolicy |= RepeatPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatCount", wireType)
+			}
+			m.RepeatCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepeatCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteCountdownMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteCountdownMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteCountdownMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GrantRoleMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrantRoleMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrantRoleMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountdownID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CountdownID = append(m.CountdownID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CountdownID == nil {
+				m.CountdownID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= EditorRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevokeRoleMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevokeRoleMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevokeRoleMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountdownID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CountdownID = append(m.CountdownID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CountdownID == nil {
+				m.CountdownID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateLyricsMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateLyricsMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateLyricsMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountdownID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CountdownID = append(m.CountdownID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CountdownID == nil {
+				m.CountdownID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lyrics", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lyrics = append(m.Lyrics[:0], dAtA[iNdEx:postIndex]...)
+			if m.Lyrics == nil {
+				m.Lyrics = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attachments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attachments = append(m.Attachments, &Attachment{})
+			if err := m.Attachments[len(m.Attachments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseCountdownMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseCountdownMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseCountdownMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountdownID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CountdownID = append(m.CountdownID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CountdownID == nil {
+				m.CountdownID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResumeCountdownMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResumeCountdownMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResumeCountdownMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountdownID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CountdownID = append(m.CountdownID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CountdownID == nil {
+				m.CountdownID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferCountdownMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferCountdownMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferCountdownMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountdownID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CountdownID = append(m.CountdownID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CountdownID == nil {
+				m.CountdownID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = append(m.NewOwner[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewOwner == nil {
+				m.NewOwner = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireAccept", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireAccept = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AcceptCountdownTransferMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AcceptCountdownTransferMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AcceptCountdownTransferMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountdownID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CountdownID = append(m.CountdownID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CountdownID == nil {
+				m.CountdownID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TipCountdownMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TipCountdownMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TipCountdownMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountdownID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CountdownID = append(m.CountdownID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CountdownID == nil {
+				m.CountdownID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tipper", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tipper = append(m.Tipper[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tipper == nil {
+				m.Tipper = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &coin.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetUnlockPriceMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetUnlockPriceMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetUnlockPriceMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountdownID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CountdownID = append(m.CountdownID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CountdownID == nil {
+				m.CountdownID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Price == nil {
+				m.Price = &coin.Coin{}
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnlockNextLineMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnlockNextLineMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnlockNextLineMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountdownID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CountdownID = append(m.CountdownID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CountdownID == nil {
+				m.CountdownID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = append(m.Payer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payer == nil {
+				m.Payer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteUserMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteUserMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteUserMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FlagCountdownMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlagCountdownMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlagCountdownMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountdownID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CountdownID = append(m.CountdownID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CountdownID == nil {
+				m.CountdownID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = append(m.Reporter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Reporter == nil {
+				m.Reporter = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModerateCountdownMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModerateCountdownMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModerateCountdownMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountdownID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CountdownID = append(m.CountdownID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CountdownID == nil {
+				m.CountdownID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= ModerationAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateCountdownDraftMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateCountdownDraftMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateCountdownDraftMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedRevealPolicy", wireType)
+			}
+			m.MissedRevealPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedRevealPolicy |= MissedRevealPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bounty = append(m.Bounty, &coin.Coin{})
+			if err := m.Bounty[len(m.Bounty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = append(m.Beneficiary[:0], dAtA[iNdEx:postIndex]...)
+			if m.Beneficiary == nil {
+				m.Beneficiary = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = append(m.Sponsor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sponsor == nil {
+				m.Sponsor = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteAt", wireType)
+			}
+			m.DeleteAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeleteAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatPolicy", wireType)
+			}
+			m.RepeatPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepeatPolicy |= RepeatPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatCount", wireType)
+			}
+			m.RepeatCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepeatCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppendLyricsChunkMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppendLyricsChunkMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppendLyricsChunkMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DraftID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DraftID = append(m.DraftID[:0], dAtA[iNdEx:postIndex]...)
+			if m.DraftID == nil {
+				m.DraftID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lyrics", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lyrics = append(m.Lyrics[:0], dAtA[iNdEx:postIndex]...)
+			if m.Lyrics == nil {
+				m.Lyrics = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PublishCountdownMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PublishCountdownMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PublishCountdownMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DraftID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DraftID = append(m.DraftID[:0], dAtA[iNdEx:postIndex]...)
+			if m.DraftID == nil {
+				m.DraftID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LyricsHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LyricsHash = append(m.LyricsHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LyricsHash == nil {
+				m.LyricsHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attachments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attachments = append(m.Attachments, &Attachment{})
+			if err := m.Attachments[len(m.Attachments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteCountdownDraftMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteCountdownDraftMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteCountdownDraftMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DraftID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DraftID = append(m.DraftID[:0], dAtA[iNdEx:postIndex]...)
+			if m.DraftID == nil {
+				m.DraftID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetSuccessorMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetSuccessorMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetSuccessorMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountdownID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CountdownID = append(m.CountdownID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CountdownID == nil {
+				m.CountdownID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessorID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuccessorID = append(m.SuccessorID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SuccessorID == nil {
+				m.SuccessorID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestartCountdownMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestartCountdownMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestartCountdownMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountdownID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CountdownID = append(m.CountdownID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CountdownID == nil {
+				m.CountdownID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForkCountdownMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForkCountdownMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForkCountdownMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceID = append(m.SourceID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SourceID == nil {
+				m.SourceID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedRevealPolicy", wireType)
+			}
+			m.MissedRevealPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedRevealPolicy |= MissedRevealPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCodec
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Lines = append(m.Lines, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCodec
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCodec
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCodec
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Lines) == 0 {
+					m.Lines = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCodec
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Lines = append(m.Lines, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lines", wireType)
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Edits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Edits = append(m.Edits, &LineEdit{})
+			if err := m.Edits[len(m.Edits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *CreateCountdownMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.Lyrics)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *DeleteCountdownMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+func (m *LineEdit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LineEdit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LineEdit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Line", wireType)
+			}
+			m.Line = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Line |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovCodec(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozCodec(x uint64) (n int) {
-	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Countdown) Unmarshal(dAtA []byte) error {
+func (m *CreateSeriesMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -817,10 +16715,10 @@ func (m *Countdown) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Countdown: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateSeriesMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Countdown: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateSeriesMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -861,7 +16759,39 @@ func (m *Countdown) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -888,14 +16818,14 @@ func (m *Countdown) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ID == nil {
-				m.ID = []byte{}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CountdownIDs", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -912,26 +16842,77 @@ func (m *Countdown) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CountdownIDs = append(m.CountdownIDs, make([]byte, postIndex-iNdEx))
+			copy(m.CountdownIDs[len(m.CountdownIDs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthCodec
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
-			if m.Owner == nil {
-				m.Owner = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddSeriesCountdownMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
 			}
-			iNdEx = postIndex
-		case 5:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddSeriesCountdownMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddSeriesCountdownMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -941,27 +16922,31 @@ func (m *Countdown) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lyrics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -988,14 +16973,14 @@ func (m *Countdown) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lyrics = append(m.Lyrics[:0], dAtA[iNdEx:postIndex]...)
-			if m.Lyrics == nil {
-				m.Lyrics = []byte{}
+			m.SeriesID = append(m.SeriesID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SeriesID == nil {
+				m.SeriesID = []byte{}
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Countdown", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CountdownID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1022,54 +17007,16 @@ func (m *Countdown) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Countdown = append(m.Countdown[:0], dAtA[iNdEx:postIndex]...)
-			if m.Countdown == nil {
-				m.Countdown = []byte{}
+			m.CountdownID = append(m.CountdownID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CountdownID == nil {
+				m.CountdownID = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
-			}
-			m.CreatedAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreatedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAt", wireType)
-			}
-			m.CompletedAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CompletedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 			}
-			m.DeleteAt = 0
+			m.Position = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1079,7 +17026,7 @@ func (m *Countdown) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DeleteAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				m.Position |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1108,7 +17055,7 @@ func (m *Countdown) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CountdownTask) Unmarshal(dAtA []byte) error {
+func (m *RemoveSeriesCountdownMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1131,10 +17078,10 @@ func (m *CountdownTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CountdownTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveSeriesCountdownMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CountdownTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveSeriesCountdownMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1175,7 +17122,7 @@ func (m *CountdownTask) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1202,9 +17149,9 @@ func (m *CountdownTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ID == nil {
-				m.ID = []byte{}
+			m.SeriesID = append(m.SeriesID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SeriesID == nil {
+				m.SeriesID = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
@@ -1241,40 +17188,6 @@ func (m *CountdownTask) Unmarshal(dAtA []byte) error {
 				m.CountdownID = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskOwner", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TaskOwner = append(m.TaskOwner[:0], dAtA[iNdEx:postIndex]...)
-			if m.TaskOwner == nil {
-				m.TaskOwner = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1299,7 +17212,7 @@ func (m *CountdownTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateUserMsg) Unmarshal(dAtA []byte) error {
+func (m *ReorderSeriesMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1322,10 +17235,10 @@ func (m *CreateUserMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateUserMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReorderSeriesMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateUserMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReorderSeriesMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1366,9 +17279,9 @@ func (m *CreateUserMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesID", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1378,23 +17291,57 @@ func (m *CreateUserMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.SeriesID = append(m.SeriesID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SeriesID == nil {
+				m.SeriesID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountdownIDs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CountdownIDs = append(m.CountdownIDs, make([]byte, postIndex-iNdEx))
+			copy(m.CountdownIDs[len(m.CountdownIDs)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1420,7 +17367,7 @@ func (m *CreateUserMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateCountdownMsg) Unmarshal(dAtA []byte) error {
+func (m *MigrateCountdownsMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1443,10 +17390,10 @@ func (m *CreateCountdownMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateCountdownMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: MigrateCountdownsMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateCountdownMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MigrateCountdownsMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1487,9 +17434,9 @@ func (m *CreateCountdownMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartID", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1499,29 +17446,31 @@ func (m *CreateCountdownMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.StartID = append(m.StartID[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartID == nil {
+				m.StartID = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lyrics", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var byteLen int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1531,26 +17480,11 @@ func (m *CreateCountdownMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Limit |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Lyrics = append(m.Lyrics[:0], dAtA[iNdEx:postIndex]...)
-			if m.Lyrics == nil {
-				m.Lyrics = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1575,7 +17509,7 @@ func (m *CreateCountdownMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteCountdownMsg) Unmarshal(dAtA []byte) error {
+func (m *ExpireCountdownTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1598,10 +17532,10 @@ func (m *DeleteCountdownMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteCountdownMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExpireCountdownTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteCountdownMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExpireCountdownTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1642,7 +17576,7 @@ func (m *DeleteCountdownMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CountdownID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1669,9 +17603,9 @@ func (m *DeleteCountdownMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ID == nil {
-				m.ID = []byte{}
+			m.CountdownID = append(m.CountdownID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CountdownID == nil {
+				m.CountdownID = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -0,0 +1,145 @@
+package countdown
+
+import (
+	"strconv"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/x"
+)
+
+// NewConfigHandler returns a handler that allows the configuration owner to
+// update the countdown module configuration.
+func NewConfigHandler(auth x.Authenticator) weave.Handler {
+	var conf Configuration
+	return gconf.NewUpdateConfigurationHandler(packageName, &conf, auth)
+}
+
+// Validate ensures the configuration is valid
+func (c *Configuration) Validate() error {
+	var errs error
+
+	if len(c.Owner) != 0 {
+		errs = errors.AppendField(errs, "Owner", c.Owner.Validate())
+	}
+	errs = errors.AppendField(errs, "UserDeposit", validateDepositRate(c.UserDeposit))
+	errs = errors.AppendField(errs, "CountdownDeposit", validateDepositRate(c.CountdownDeposit))
+
+	if c.NewUserCost < 0 {
+		errs = errors.AppendField(errs, "NewUserCost", errors.Wrap(errors.ErrInput, "cannot be negative"))
+	}
+	if c.NewCountdownCost < 0 {
+		errs = errors.AppendField(errs, "NewCountdownCost", errors.Wrap(errors.ErrInput, "cannot be negative"))
+	}
+	if c.CountdownCostUnit < 0 {
+		errs = errors.AppendField(errs, "CountdownCostUnit", errors.Wrap(errors.ErrInput, "cannot be negative"))
+	}
+
+	if c.MinLineLength <= 0 {
+		errs = errors.AppendField(errs, "MinLineLength", errors.Wrap(errors.ErrInput, "must be greater than zero"))
+	}
+	if c.MaxLineLength < c.MinLineLength {
+		errs = errors.AppendField(errs, "MaxLineLength", errors.Wrap(errors.ErrInput, "cannot be less than the minimal line length"))
+	}
+	if c.MaxLineBytes < 0 {
+		errs = errors.AppendField(errs, "MaxLineBytes", errors.Wrap(errors.ErrInput, "cannot be negative"))
+	} else if c.MaxLineBytes > 0 && c.MaxLineBytes < c.MaxLineLength {
+		errs = errors.AppendField(errs, "MaxLineBytes", errors.Wrap(errors.ErrInput, "cannot be less than the maximal line length"))
+	}
+
+	if c.RevealInterval <= 0 {
+		errs = errors.AppendField(errs, "RevealInterval", errors.Wrap(errors.ErrInput, "must be greater than zero"))
+	}
+
+	if c.MaxActiveCountdowns < 0 {
+		errs = errors.AppendField(errs, "MaxActiveCountdowns", errors.Wrap(errors.ErrInput, "cannot be negative"))
+	}
+	if c.MaxCountdownsPerWindow < 0 {
+		errs = errors.AppendField(errs, "MaxCountdownsPerWindow", errors.Wrap(errors.ErrInput, "cannot be negative"))
+	}
+	if c.CreationWindow < 0 {
+		errs = errors.AppendField(errs, "CreationWindow", errors.Wrap(errors.ErrInput, "cannot be negative"))
+	} else if c.MaxCountdownsPerWindow > 0 && c.CreationWindow == 0 {
+		errs = errors.AppendField(errs, "CreationWindow", errors.Wrap(errors.ErrEmpty, "required by the creation limit"))
+	}
+
+	seen := make(map[string]bool, len(c.Moderators))
+	for i, m := range c.Moderators {
+		field := "Moderators." + strconv.Itoa(i)
+		if err := m.Validate(); err != nil {
+			errs = errors.AppendField(errs, field, err)
+		} else if seen[m.String()] {
+			errs = errors.AppendField(errs, field, errors.Wrap(errors.ErrDuplicate, "address is already a moderator"))
+		}
+		seen[m.String()] = true
+	}
+
+	return errs
+}
+
+// validateDepositRate ensures the deposit per byte is a valid, not negative
+// amount. A zero amount disables the deposit.
+func validateDepositRate(rate coin.Coin) error {
+	if rate.IsZero() {
+		return nil
+	}
+	if err := rate.Validate(); err != nil {
+		return err
+	}
+	if !rate.IsNonNegative() {
+		return errors.Wrap(errors.ErrAmount, "deposit cannot be negative")
+	}
+	return nil
+}
+
+// loadConf returns the current configuration of the countdown module.
+func loadConf(store weave.ReadOnlyKVStore) (*Configuration, error) {
+	var conf Configuration
+	if err := gconf.Load(store, packageName, &conf); err != nil {
+		return nil, errors.Wrap(err, "cannot load countdown configuration")
+	}
+	return &conf, nil
+}
+
+// depositFor returns the storage deposit of an object with the given size.
+func depositFor(rate coin.Coin, size int) (coin.Coin, error) {
+	if rate.IsZero() {
+		return coin.Coin{}, nil
+	}
+	deposit, err := rate.Multiply(int64(size))
+	if err != nil {
+		return coin.Coin{}, errors.Wrap(err, "cannot compute deposit")
+	}
+	return deposit, nil
+}
+
+// countdownCost returns the gas allocated for the creation of a countdown
+// with the given lyrics.
+func (c *Configuration) countdownCost(lyrics []byte) int64 {
+	if c.CountdownCostUnit == 0 {
+		return c.NewCountdownCost
+	}
+	return c.NewCountdownCost + int64(len(lyrics))/c.CountdownCostUnit
+}
+
+// validateLines ensures every lyrics line length, counted in user perceived
+// characters, and every lyrics line size are within the configured limits.
+func (c *Configuration) validateLines(lines []string) error {
+	maxBytes := int(c.MaxLineBytes)
+	if maxBytes == 0 {
+		maxBytes = 4 * int(c.MaxLineLength)
+	}
+	var errs error
+	for i, line := range lines {
+		field := "Lyrics line " + strconv.Itoa(i)
+		if len(line) > maxBytes {
+			errs = errors.AppendField(errs, field, errors.Wrapf(errors.ErrInput, "must not be longer than %d bytes", maxBytes))
+		} else if n := graphemeCount(line); n < int(c.MinLineLength) || n > int(c.MaxLineLength) {
+			errs = errors.AppendField(errs, field,
+				errors.Wrapf(errors.ErrInput, "must be between %d and %d characters", c.MinLineLength, c.MaxLineLength))
+		}
+	}
+	return errs
+}
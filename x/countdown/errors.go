@@ -0,0 +1,10 @@
+package countdown
+
+import (
+	"github.com/iov-one/weave/errors"
+)
+
+var (
+	// ErrLimit is returned when an owner exceeds one of the configured quotas
+	ErrLimit = errors.Register(130, "limit exceeded")
+)
@@ -1,36 +1,285 @@
 package countdown
 
 import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/x"
+	"github.com/iov-one/weave/x/cash"
+	"github.com/tendermint/tendermint/libs/common"
 )
 
 const (
-	packageName             = "countdown"
-	newUserCost       int64 = 1
-	newCountdownCost  int64 = 10
-	countdownCostUnit int64 = 1000 // first 1000 chars are free then pay 1 per mille
+	packageName = "countdown"
+
+	// maxTopTippers is the number of top tippers kept per currency
+	maxTopTippers = 10
+
+	// maxRangeQueryResults is the maximum number of countdowns returned by a
+	// time range query
+	maxRangeQueryResults = 100
+
+	// maxMigrateCountdowns is the maximum number of countdowns migrated by a
+	// single MigrateCountdownsMsg
+	maxMigrateCountdowns = 100
 )
 
-// RegisterQuery registers buckets for querying.
+// RegisterQuery registers buckets for querying. Countdown queries do not
+// return hidden countdowns, moderators can list them with /hiddenCountdowns.
+//
+// Countdowns can be listed by status with /countdowns/status, see StatusQuery,
+// and by the time of their next reveal or their completion with
+// /revealingCountdowns and /completedCountdowns, see TimeRangeQuery.
+//
+// Forks of a countdown are listed with /countdowns/forks and countdowns with a
+// tag with /countdowns/tag, see NormalizeTag.
+//
+// Every /countdowns path has a /countdownProgress counterpart returning the
+// CountdownProgress of the matching countdowns instead.
+//
+// The visible countdowns of a series are listed in series order with
+// /seriesCountdowns.
 func RegisterQuery(qr weave.QueryRouter) {
+	NewUserBucket().Register("countdownUsers", qr)
+	NewTipperBucket().Register("countdownTippers", qr)
+	NewFlagBucket().Register("countdownFlags", qr)
+	NewDraftBucket().Register("countdownDrafts", qr)
+	NewSeriesBucket().Register("countdownSeries", qr)
+	NewCreationLogBucket().Register("countdownCreations", qr)
+
+	b := NewCountdownBucket()
+	countdowns := weave.NewQueryRouter()
+	b.Register("countdowns", countdowns)
+	for _, path := range []string{"/countdowns", "/countdowns/user", "/countdowns/editor", "/countdowns/status", "/countdowns/forks", "/countdowns/tag"} {
+		q := countdownQuery{QueryHandler: countdowns.Handler(path)}
+		qr.Register(path, q)
+		qr.Register(strings.Replace(path, "/countdowns", "/countdownProgress", 1), progressQuery{QueryHandler: q})
+	}
+	qr.Register("/hiddenCountdowns", countdownQuery{QueryHandler: countdowns.Handler("/countdowns"), hidden: true})
+	qr.Register("/revealingCountdowns", countdownRangeQuery{b: b, index: "nextreveal"})
+	qr.Register("/completedCountdowns", countdownRangeQuery{b: b, index: "completed"})
+	qr.Register("/seriesCountdowns", seriesCountdownsQuery{b: b, series: NewSeriesBucket()})
+}
+
+// countdownQuery filters the result of a countdown bucket query by the
+// hidden state of the countdowns.
+type countdownQuery struct {
+	weave.QueryHandler
+	hidden bool
+}
+
+// Query returns the countdowns matching the query that are hidden or visible,
+// depending on the handler configuration. Countdowns are returned migrated to
+// the current schema.
+func (q countdownQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	models, err := q.QueryHandler.Query(db, mod, data)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]weave.Model, 0, len(models))
+	for _, m := range models {
+		var cd Countdown
+		if err := cd.Unmarshal(m.Value); err != nil {
+			return nil, errors.Wrap(errors.ErrState, "cannot unmarshal countdown")
+		}
+		if (cd.HiddenAt != 0) != q.hidden {
+			continue
+		}
+		if cd.Metadata != nil && cd.Metadata.Schema < countdownSchema {
+			if err := migrateCountdown(db, &cd); err != nil {
+				return nil, err
+			}
+			if m.Value, err = cd.Marshal(); err != nil {
+				return nil, errors.Wrap(errors.ErrState, "cannot marshal countdown")
+			}
+		}
+		res = append(res, m)
+	}
+	return res, nil
+}
+
+// progressQuery returns the progress of the countdowns returned by a
+// countdown query, keyed like the countdowns.
+type progressQuery struct {
+	weave.QueryHandler
+}
+
+// Query returns the CountdownProgress of each countdown matching the query.
+func (q progressQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	models, err := q.QueryHandler.Query(db, mod, data)
+	if err != nil || len(models) == 0 {
+		return models, err
+	}
+	conf, err := loadConf(db)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]weave.Model, 0, len(models))
+	for _, m := range models {
+		var cd Countdown
+		if err := cd.Unmarshal(m.Value); err != nil {
+			return nil, errors.Wrap(errors.ErrState, "cannot unmarshal countdown")
+		}
+		progress, err := cd.Progress(conf.RevealInterval.Duration())
+		if err != nil {
+			return nil, errors.Wrapf(err, "countdown with ID %s", cd.ID)
+		}
+		raw, err := progress.Marshal()
+		if err != nil {
+			return nil, errors.Wrap(errors.ErrState, "cannot marshal progress")
+		}
+		res = append(res, weave.Model{Key: m.Key, Value: raw})
+	}
+	return res, nil
+}
+
+// seriesCountdownsQuery returns the visible countdowns of a series, in series
+// order.
+type seriesCountdownsQuery struct {
+	b      *CountdownBucket
+	series *SeriesBucket
+}
+
+// Query returns the countdowns of the series with the ID given as query data.
+func (q seriesCountdownsQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	var series Series
+	if err := q.series.One(db, data, &series); err != nil {
+		if errors.ErrNotFound.Is(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "cannot retrieve series with ID %s", data)
+	}
+	res := make([]weave.Model, 0, len(series.CountdownIDs))
+	for _, id := range series.CountdownIDs {
+		var cd Countdown
+		if err := q.b.One(db, id, &cd); err != nil {
+			return nil, errors.Wrapf(err, "cannot retrieve countdown with ID %s", id)
+		}
+		if cd.HiddenAt != 0 {
+			continue
+		}
+		raw, err := cd.Marshal()
+		if err != nil {
+			return nil, errors.Wrap(errors.ErrState, "cannot marshal countdown")
+		}
+		res = append(res, weave.Model{Key: append([]byte("countdown:"), id...), Value: raw})
+	}
+	return res, nil
+}
+
+// countdownRangeQuery returns the visible countdowns with a time index key in
+// a range. At most maxRangeQueryResults countdowns are returned, in index
+// order.
+type countdownRangeQuery struct {
+	b     *CountdownBucket
+	index string
+}
+
+// Query returns the countdowns indexed with a time in [from, to). The query
+// data are both times, as encoded by TimeRangeQuery.
+func (q countdownRangeQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	if len(data) != 16 {
+		return nil, errors.Wrap(errors.ErrInput, "query data must be a time range")
+	}
+	if bytes.Compare(data[:8], data[8:]) > 0 {
+		return nil, errors.Wrap(errors.ErrInput, "range start is after its end")
+	}
+	prefix := countdownIndexPrefix(q.index)
+	start := append(append([]byte{}, prefix...), data[:8]...)
+	end := append(append([]byte{}, prefix...), data[8:]...)
+
+	it, err := db.Iterator(start, end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot iterate index")
+	}
+	defer it.Release()
+
+	var res []weave.Model
+	for len(res) < maxRangeQueryResults {
+		_, value, err := it.Next()
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot iterate index")
+		}
+		var refs orm.MultiRef
+		if err := refs.Unmarshal(value); err != nil {
+			return nil, errors.Wrap(errors.ErrState, "cannot unmarshal index entry")
+		}
+		for _, id := range refs.Refs {
+			var cd Countdown
+			if err := q.b.One(db, id, &cd); err != nil {
+				return nil, errors.Wrapf(err, "cannot retrieve countdown with ID %s", id)
+			}
+			if cd.HiddenAt != 0 {
+				continue
+			}
+			raw, err := cd.Marshal()
+			if err != nil {
+				return nil, errors.Wrap(errors.ErrState, "cannot marshal countdown")
+			}
+			key := append([]byte("countdown:"), id...)
+			res = append(res, weave.Model{Key: key, Value: raw})
+			if len(res) == maxRangeQueryResults {
+				break
+			}
+		}
+	}
+	return res, nil
 }
 
 // RegisterRoutes registers handlers for message processing.
-func RegisterRoutes(r weave.Registry, auth x.Authenticator, scheduler weave.Scheduler) {
+func RegisterRoutes(r weave.Registry, auth x.Authenticator, scheduler weave.Scheduler, ctrl cash.Controller) {
 	//r = migration.SchemaMigratingRegistry(packageName, r)
-	r.Handle(&CreateUserMsg{}, NewCreateUserHandler(auth))
-	r.Handle(&CreateCountdownMsg{}, NewCreateCountdownHandler(auth, scheduler))
-	r.Handle(&DeleteCountdownMsg{}, NewDeleteCountdownHandler(auth, scheduler))
+	r.Handle(&CreateUserMsg{}, NewCreateUserHandler(auth, ctrl))
+	r.Handle(&DeleteUserMsg{}, NewDeleteUserHandler(auth, ctrl))
+	r.Handle(&CreateCountdownMsg{}, NewCreateCountdownHandler(auth, scheduler, ctrl))
+	r.Handle(&DeleteCountdownMsg{}, NewDeleteCountdownHandler(auth, scheduler, ctrl))
+	r.Handle(&GrantRoleMsg{}, NewGrantRoleHandler(auth))
+	r.Handle(&RevokeRoleMsg{}, NewRevokeRoleHandler(auth))
+	r.Handle(&UpdateLyricsMsg{}, NewUpdateLyricsHandler(auth, ctrl))
+	r.Handle(&PauseCountdownMsg{}, NewPauseCountdownHandler(auth, scheduler))
+	r.Handle(&ResumeCountdownMsg{}, NewResumeCountdownHandler(auth, scheduler))
+	r.Handle(&RestartCountdownMsg{}, NewRestartCountdownHandler(auth, scheduler))
+	r.Handle(&ForkCountdownMsg{}, NewForkCountdownHandler(auth, scheduler, ctrl))
+	r.Handle(&TransferCountdownMsg{}, NewTransferCountdownHandler(auth, scheduler))
+	r.Handle(&AcceptCountdownTransferMsg{}, NewAcceptCountdownTransferHandler(auth, scheduler))
+	r.Handle(&TipCountdownMsg{}, NewTipCountdownHandler(auth, ctrl))
+	r.Handle(&SetUnlockPriceMsg{}, NewSetUnlockPriceHandler(auth))
+	r.Handle(&UnlockNextLineMsg{}, NewUnlockNextLineHandler(auth, scheduler, ctrl))
+	r.Handle(&UpdateConfigurationMsg{}, NewConfigHandler(auth))
+	r.Handle(&FlagCountdownMsg{}, NewFlagCountdownHandler(auth))
+	r.Handle(&ModerateCountdownMsg{}, NewModerateCountdownHandler(auth, scheduler, ctrl))
+	r.Handle(&CreateCountdownDraftMsg{}, NewCreateCountdownDraftHandler(auth, ctrl))
+	r.Handle(&AppendLyricsChunkMsg{}, NewAppendLyricsChunkHandler(auth, ctrl))
+	r.Handle(&DeleteCountdownDraftMsg{}, NewDeleteCountdownDraftHandler(auth, ctrl))
+	r.Handle(&PublishCountdownMsg{}, NewPublishCountdownHandler(auth, scheduler, ctrl))
+	r.Handle(&MigrateCountdownsMsg{}, NewMigrateCountdownsHandler(auth))
+	r.Handle(&SetSuccessorMsg{}, NewSetSuccessorHandler(auth, scheduler))
+	r.Handle(&CreateSeriesMsg{}, NewCreateSeriesHandler(auth))
+	r.Handle(&AddSeriesCountdownMsg{}, NewAddSeriesCountdownHandler(auth))
+	r.Handle(&RemoveSeriesCountdownMsg{}, NewRemoveSeriesCountdownHandler(auth))
+	r.Handle(&ReorderSeriesMsg{}, NewReorderSeriesHandler(auth))
 }
 
 // RegisterCronRoutes registers routes that are not exposed to
 // routers
-func RegisterCronRoutes(r weave.Registry, auth x.Authenticator, scheduler weave.Scheduler) {
-	r.Handle(&CountdownTask{}, NewCronAddLyricsHandler(auth, scheduler))
+func RegisterCronRoutes(r weave.Registry, auth x.Authenticator, scheduler weave.Scheduler, ctrl cash.Controller) {
+	r.Handle(&CountdownTask{}, NewCronAddLyricsHandler(auth, scheduler, ctrl))
+	r.Handle(&ExpireCountdownTask{}, NewCronExpireCountdownHandler(auth, scheduler, ctrl))
 }
 
 // ------------------- CreateUserHandler -------------------
@@ -39,15 +288,17 @@ func RegisterCronRoutes(r weave.Registry, auth x.Authenticator, scheduler weave.
 type CreateUserHandler struct {
 	auth x.Authenticator
 	b    *UserBucket
+	ctrl cash.Controller
 }
 
 var _ weave.Handler = CreateUserHandler{}
 
 // NewCreateUserHandler creates a user message handler
-func NewCreateUserHandler(auth x.Authenticator) weave.Handler {
+func NewCreateUserHandler(auth x.Authenticator, ctrl cash.Controller) weave.Handler {
 	return CreateUserHandler{
 		auth: auth,
 		b:    NewUserBucket(),
+		ctrl: ctrl,
 	}
 }
 
@@ -65,10 +316,17 @@ func (h CreateUserHandler) validate(ctx weave.Context, store weave.KVStore, tx w
 	}
 	now := weave.AsUnixTime(blockTime)
 
+	// the owner pays the storage deposit
+	signer := x.MainSigner(ctx, h.auth)
+	if signer == nil {
+		return nil, nil, errors.Field("Owner", errors.ErrEmpty, "no signer")
+	}
+
 	user := &User{
 		Metadata:     msg.Metadata,
 		Username:     msg.Username,
 		RegisteredAt: now,
+		Owner:        signer.Address(),
 	}
 
 	return &msg, user, nil
@@ -82,7 +340,12 @@ func (h CreateUserHandler) Check(ctx weave.Context, store weave.KVStore, tx weav
 		return nil, err
 	}
 
-	return &weave.CheckResult{GasAllocated: newUserCost}, nil
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{GasAllocated: conf.NewUserCost}, nil
 }
 
 // Deliver creates a custom state and saves if all preconditions are met
@@ -97,27 +360,115 @@ func (h CreateUserHandler) Deliver(ctx weave.Context, store weave.KVStore, tx we
 		return nil, errors.Wrap(err, "cannot store user")
 	}
 
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, err
+	}
+	// the deposit is computed once the user is stored and has an ID
+	deposit, err := takeDeposit(store, h.ctrl, user.Owner, conf.UserDeposit, user.Size())
+	if err != nil {
+		return nil, err
+	}
+	if deposit != nil {
+		user.Deposit = deposit
+		if err := h.b.Put(store, user); err != nil {
+			return nil, errors.Wrap(err, "cannot store user")
+		}
+	}
+
 	// Returns generated user ID as response
 	return &weave.DeliverResult{Data: user.ID}, nil
 }
 
+// ------------------- DeleteUserHandler -------------------
+
+// DeleteUserHandler will handle DeleteUserMsg
+type DeleteUserHandler struct {
+	auth x.Authenticator
+	b    *UserBucket
+	ctrl cash.Controller
+}
+
+var _ weave.Handler = DeleteUserHandler{}
+
+// NewDeleteUserHandler creates a delete user message handler
+func NewDeleteUserHandler(auth x.Authenticator, ctrl cash.Controller) weave.Handler {
+	return DeleteUserHandler{
+		auth: auth,
+		b:    NewUserBucket(),
+		ctrl: ctrl,
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h DeleteUserHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*DeleteUserMsg, *User, error) {
+	var msg DeleteUserMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var user User
+	if err := h.b.One(store, msg.ID, &user); err != nil {
+		return nil, nil, errors.Wrapf(err, "cannot retrieve user with ID %s", msg.ID)
+	}
+
+	if !h.auth.HasAddress(ctx, user.Owner) {
+		return nil, nil, errors.Wrapf(errors.ErrUnauthorized, "owner of user with ID %s did not authorize the transaction", user.ID)
+	}
+
+	return &msg, &user, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h DeleteUserHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deleting is free of charge
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver refunds the deposit and deletes the user
+func (h DeleteUserHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, user, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := refundDeposit(store, h.ctrl, user.Owner, user.Deposit); err != nil {
+		return nil, err
+	}
+
+	if err := h.b.Delete(store, user.ID); err != nil {
+		return nil, errors.Wrapf(err, "cannot delete user with ID %s", user.ID)
+	}
+
+	return &weave.DeliverResult{}, nil
+}
+
 // ------------------- CreateCountdownHandler -------------------
 
 // CreateCountdownHandler will handle CreateCountdownMsg
 type CreateCountdownHandler struct {
-	auth x.Authenticator
-	b    *CountdownBucket
+	auth      x.Authenticator
+	b         *CountdownBucket
 	scheduler weave.Scheduler
+	ctrl      cash.Controller
 }
 
 var _ weave.Handler = CreateCountdownHandler{}
 
 // NewCreateCountdownHandler creates a countdown message handler
-func NewCreateCountdownandler(auth x.Authenticator, scheduler weave.Scheduler) weave.Handler {
+func NewCreateCountdownHandler(auth x.Authenticator, scheduler weave.Scheduler, ctrl cash.Controller) weave.Handler {
 	return CreateCountdownHandler{
-		auth: auth,
-		b:    NewCountdownBucket(),
+		auth:      auth,
+		b:         NewCountdownBucket(),
 		scheduler: scheduler,
+		ctrl:      ctrl,
 	}
 }
 
@@ -135,12 +486,54 @@ func (h CreateCountdownHandler) validate(ctx weave.Context, store weave.KVStore,
 	}
 	now := weave.AsUnixTime(blockTime)
 
+	if msg.DeleteAt != 0 && !msg.DeleteAt.Time().After(blockTime) {
+		return nil, nil, errors.Field("DeleteAt", errors.ErrInput, "must be in the future")
+	}
+
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, nil, err
+	}
+	lines, err := lyricsLines(msg.Lyrics)
+	if err != nil {
+		return nil, nil, errors.Field("Lyrics", errors.ErrInput, "must be a list of lines")
+	}
+	if err := conf.validateLines(lines); err != nil {
+		return nil, nil, err
+	}
+
+	owner, err := countdownOwner(ctx, h.auth, msg.Owner)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := checkQuota(store, h.b, conf, owner, blockTime); err != nil {
+		return nil, nil, err
+	}
+
+	sponsor, err := bountySponsor(ctx, h.auth, msg.Bounty, msg.Sponsor)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	cd := &Countdown{
-		Metadata:  msg.Metadata,
-		Owner:     x.MainSigner(ctx, h.auth).Address(),
-		Title:     msg.Title,
-		Lyrics:    msg.Lyrics,
-		CreatedAt: now,
+		Metadata:           &weave.Metadata{Schema: countdownSchema},
+		Owner:              owner,
+		Title:              msg.Title,
+		CreatedAt:          now,
+		MissedRevealPolicy: msg.MissedRevealPolicy,
+		ScheduleStart:      now,
+		Bounty:             msg.Bounty,
+		Beneficiary:        msg.Beneficiary,
+		Sponsor:            sponsor,
+		DeleteAt:           msg.DeleteAt,
+		Attachments:        msg.Attachments,
+		RepeatPolicy:       msg.RepeatPolicy,
+		RepeatCount:        msg.RepeatCount,
+		Tags:               msg.Tags,
+	}
+	if err := cd.SetLyrics(msg.Lyrics); err != nil {
+		return nil, nil, err
 	}
 
 	return &msg, cd, nil
@@ -149,12 +542,17 @@ func (h CreateCountdownHandler) validate(ctx weave.Context, store weave.KVStore,
 // Check just verifies it is properly formed and returns
 // the cost of executing it.
 func (h CreateCountdownHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
-	_, _, err := h.validate(ctx, store, tx)
+	msg, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	conf, err := loadConf(store)
 	if err != nil {
 		return nil, err
 	}
 
-	return &weave.CheckResult{GasAllocated: newCountdownCost}, nil
+	return &weave.CheckResult{GasAllocated: conf.countdownCost(msg.Lyrics)}, nil
 }
 
 // Deliver creates an custom state and saves if all preconditions are met
@@ -164,20 +562,8 @@ func (h CreateCountdownHandler) Deliver(ctx weave.Context, store weave.KVStore,
 		return nil, err
 	}
 
-	if err = h.b.Put(store, cd); err != nil {
-		return nil, errors.Wrap(err, "cannot store countdown")
-	}
-
-	// schedule first task to be executed for this countdown
-	future := weave.AsUnixTime(time.Now().Add(24*time.Hour))
-	taskMsg := &CountdownTask{
-		Metadata:    msg.Metadata,
-		CountdownID: cd.ID,
-		TaskOwner: 	 cd.Owner,
-	}
-
-	if _, err := h.scheduler.Schedule(store, future, nil, taskMsg); err != nil {
-		return nil, errors.Wrap(err, "could not schedule task")
+	if err := startCountdown(store, h.scheduler, h.ctrl, h.b, cd); err != nil {
+		return nil, err
 	}
 
 	// Returns generated countdown ID as response
@@ -188,19 +574,21 @@ func (h CreateCountdownHandler) Deliver(ctx weave.Context, store weave.KVStore,
 
 // DeleteCountdownHandler will handle DeleteCountdownMsg
 type DeleteCountdownHandler struct {
-	auth x.Authenticator
-	b    *CountdownBucket
+	auth      x.Authenticator
+	b         *CountdownBucket
 	scheduler weave.Scheduler
+	ctrl      cash.Controller
 }
 
 var _ weave.Handler = DeleteCountdownHandler{}
 
 // DeleteCountdownHandler creates a countdown message handler
-func NewDeleteCountdownHandler(auth x.Authenticator, scheduler weave.Scheduler) weave.Handler {
+func NewDeleteCountdownHandler(auth x.Authenticator, scheduler weave.Scheduler, ctrl cash.Controller) weave.Handler {
 	return DeleteCountdownHandler{
-		auth: auth,
-		b:    NewCountdownBucket(),
+		auth:      auth,
+		b:         NewCountdownBucket(),
 		scheduler: scheduler,
+		ctrl:      ctrl,
 	}
 }
 
@@ -217,9 +605,8 @@ func (h DeleteCountdownHandler) validate(ctx weave.Context, store weave.KVStore,
 		return nil, nil, errors.Wrapf(err, "cannot retrieve countdown with ID %s", msg.ID)
 	}
 
-	signer := x.MainSigner(ctx, h.auth).Address()
-	if !cd.Owner.Equals(signer) {
-		return nil, nil, errors.Wrapf(errors.ErrUnauthorized, "signer %s is unauthorized to delete countdown with ID %s", signer, cd.ID)
+	if err := authorize(ctx, h.auth, &cd, EditorRole_Manager); err != nil {
+		return nil, nil, err
 	}
 
 	return &msg, &cd, nil
@@ -244,53 +631,97 @@ func (h DeleteCountdownHandler) Deliver(ctx weave.Context, store weave.KVStore,
 		return nil, err
 	}
 
-	if err := h.b.Delete(store, cd.ID); err != nil {
-		return nil, errors.Wrapf(err, "cannot delete countdown with ID %s", cd.ID)
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "no block time in header")
+	}
+	if err := deleteCountdown(store, h.scheduler, h.ctrl, h.b, cd, now); err != nil {
+		return nil, err
 	}
 
 	return &weave.DeliverResult{}, nil
 }
 
-// ------------------- CronAddLyricsHandler -------------------
+// ------------------- CreateCountdownDraftHandler -------------------
 
-// CronAddLyricsHandler will handle scheduled CountdownTask
-type CronAddLyricsHandler struct {
-	auth x.Authenticator
-	b    *CountdownBucket
-	scheduler weave.Scheduler
+// CreateCountdownDraftHandler will handle CreateCountdownDraftMsg
+type CreateCountdownDraftHandler struct {
+	auth   x.Authenticator
+	b      *CountdownBucket
+	drafts *DraftBucket
+	ctrl   cash.Controller
 }
 
-var _ weave.Handler = CronAddCountdownHandler{}
+var _ weave.Handler = CreateCountdownDraftHandler{}
 
-// NewCronAddCountdownHandler creates a countdown message handler
-func NewCronAddLyricsHandler(auth x.Authenticator, scheduler weave.Scheduler) weave.Handler {
-	return CronAddLyricsHandler{
-		auth: auth,
-		b:    NewCountdownBucket(),
-		scheduler: scheduler,
+// NewCreateCountdownDraftHandler creates a countdown draft message handler
+func NewCreateCountdownDraftHandler(auth x.Authenticator, ctrl cash.Controller) weave.Handler {
+	return CreateCountdownDraftHandler{
+		auth:   auth,
+		b:      NewCountdownBucket(),
+		drafts: NewDraftBucket(),
+		ctrl:   ctrl,
 	}
 }
 
 // validate does all common pre-processing between Check and Deliver
-func (h CronAddLyricsHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*CountdownTask, error) {
-	var msg CountdownTask
+func (h CreateCountdownDraftHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*CreateCountdownDraftMsg, *Draft, error) {
+	var msg CreateCountdownDraftMsg
 
 	if err := weave.LoadMsg(tx, &msg); err != nil {
-		return nil, errors.Wrap(err, "load msg")
+		return nil, nil, errors.Wrap(err, "load msg")
 	}
 
-	var cd Countdown
-	if err := h.b.One(store, msg.ID, &countdown); err != nil {
-		return nil, nil, errors.Wrapf(err, "cannot retrieve countdown with id %s", msg.ID)
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "no block time in header")
 	}
 
-	return &msg, nil
+	if msg.DeleteAt != 0 && !msg.DeleteAt.Time().After(blockTime) {
+		return nil, nil, errors.Field("DeleteAt", errors.ErrInput, "must be in the future")
+	}
+
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	owner, err := countdownOwner(ctx, h.auth, msg.Owner)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := checkQuota(store, h.b, conf, owner, blockTime); err != nil {
+		return nil, nil, err
+	}
+
+	sponsor, err := bountySponsor(ctx, h.auth, msg.Bounty, msg.Sponsor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	draft := &Draft{
+		Metadata:           msg.Metadata,
+		Owner:              owner,
+		Title:              msg.Title,
+		MissedRevealPolicy: msg.MissedRevealPolicy,
+		Bounty:             msg.Bounty,
+		Beneficiary:        msg.Beneficiary,
+		Sponsor:            sponsor,
+		DeleteAt:           msg.DeleteAt,
+		CreatedAt:          weave.AsUnixTime(blockTime),
+		RepeatPolicy:       msg.RepeatPolicy,
+		RepeatCount:        msg.RepeatCount,
+		Tags:               msg.Tags,
+	}
+
+	return &msg, draft, nil
 }
 
 // Check just verifies it is properly formed and returns
 // the cost of executing it.
-func (h CronAddLyricsHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
-	_, err := h.validate(ctx, store, tx)
+func (h CreateCountdownDraftHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -298,57 +729,3037 @@ func (h CronAddLyricsHandler) Check(ctx weave.Context, store weave.KVStore, tx w
 	return &weave.CheckResult{}, nil
 }
 
-// Deliver stages a scheduled addition of lyrics to the countdown if all preconditions are met
-func (h CronAddLyricsHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
-	msg, err := h.validate(ctx, store, tx)
+// Deliver stores the draft, takes its storage deposit from the owner and
+// returns its ID
+func (h CreateCountdownDraftHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, draft, err := h.validate(ctx, store, tx)
 	if err != nil {
 		return nil, err
 	}
 
-	var cd Countdown
-	if err := h.b.One(store, msg.ID, &countdown); err != nil {
-		return nil, nil, errors.Wrapf(err, "cannot retrieve countdowns %s", msg.ID)
+	// the draft must be stored first so that it gets an ID
+	if err := h.drafts.Put(store, draft); err != nil {
+		return nil, errors.Wrap(err, "cannot store draft")
 	}
 
-	if len(cd.Countdown) < len(cd.Lyrics) {
-		var lyrics []string
-		var countdown []string
-
-		if err := json.Unmarshal(cd.Lyrics, lyrics); err != nil {
-			return nil, errors.Wrapf(err, "cannot unmarshal lyrics for countdown id %s", msg.ID)
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, err
+	}
+	deposit, err := takeDeposit(store, h.ctrl, draft.Owner, conf.CountdownDeposit, draft.Size())
+	if err != nil {
+		return nil, err
+	}
+	if deposit != nil {
+		draft.Deposit = deposit
+		draft.Depositor = draft.Owner
+		if err := h.drafts.Put(store, draft); err != nil {
+			return nil, errors.Wrap(err, "cannot store draft")
 		}
+	}
 
-		if err := json.Unmarshal(cd.Countdown, countdown); err != nil {
-			return nil, errors.Wrapf(err, "cannot unmarshal countdown lyrics for countdown id %s", msg.ID)
-		}
-		// append a new line of lyrics to the countdown
-		countdown = append(countdown, lyrics[len(countdown)])
+	return &weave.DeliverResult{Data: draft.ID}, nil
+}
 
-		cd.Countdown, err := json.Marshal(countdown)
-		if err != nil {
-			return nil, errors.Wrapf(err, "cannot marshal added lyrics for countdown id %s", msg.ID)
-		}
+// ------------------- AppendLyricsChunkHandler -------------------
 
-		// schedule next task to be executed
-		future := weave.AsUnixTime(time.Now().Add(24*time.Hour))
-		taskMsg := &CountdownTask{
-			Metadata:  msg.Metadata,
-			CountdownID: cd.ID,
-			TaskOwner: cd.Owner,
-		}
-	
-		if _, err := h.scheduler.Schedule(store, future, nil, taskMsg); err != nil {
-			return nil, errors.Wrap(err, "could not schedule next task")
-		}
+// AppendLyricsChunkHandler will handle AppendLyricsChunkMsg
+type AppendLyricsChunkHandler struct {
+	auth   x.Authenticator
+	drafts *DraftBucket
+	ctrl   cash.Controller
+}
 
-	} else {
-		// the countdown has reached its final line and is marked completed
-		cd.CompletedAt = weave.AsUnixTime(time.Now())
+var _ weave.Handler = AppendLyricsChunkHandler{}
+
+// NewAppendLyricsChunkHandler creates an append lyrics chunk message handler
+func NewAppendLyricsChunkHandler(auth x.Authenticator, ctrl cash.Controller) weave.Handler {
+	return AppendLyricsChunkHandler{
+		auth:   auth,
+		drafts: NewDraftBucket(),
+		ctrl:   ctrl,
 	}
+}
 
-	if err := h.b.Put(store, cd); err != nil {
-		return nil, errors.Wrapf(err, "cannot add lyrics to countdown with ID %s", msg.ID)
+// validate does all common pre-processing between Check and Deliver
+func (h AppendLyricsChunkHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*AppendLyricsChunkMsg, *Draft, error) {
+	var msg AppendLyricsChunkMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
 	}
 
-	return &weave.DeliverResult{}, nil
+	var draft Draft
+	if err := h.drafts.One(store, msg.DraftID, &draft); err != nil {
+		return nil, nil, errors.Wrapf(err, "cannot retrieve draft with ID %s", msg.DraftID)
+	}
+
+	if !h.auth.HasAddress(ctx, draft.Owner) {
+		return nil, nil, errors.Wrapf(errors.ErrUnauthorized, "owner of draft with ID %s did not authorize the transaction", draft.ID)
+	}
+
+	if msg.Index != draft.Chunks {
+		return nil, nil, errors.Field("Index", errors.ErrInput, "expected chunk "+strconv.Itoa(int(draft.Chunks)))
+	}
+	// lines are joined without the brackets of the chunk, so the sum is an
+	// upper bound of the appended lyrics size
+	if len(draft.Lyrics)+len(msg.Lyrics) > maxLyricsSize {
+		return nil, nil, errors.Field("Lyrics", errors.ErrInput, "draft lyrics would be too long")
+	}
+
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, nil, err
+	}
+	lines, err := lyricsLines(msg.Lyrics)
+	if err != nil {
+		return nil, nil, errors.Field("Lyrics", errors.ErrInput, "must be a list of lines")
+	}
+	if err := conf.validateLines(lines); err != nil {
+		return nil, nil, err
+	}
+
+	return &msg, &draft, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h AppendLyricsChunkHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver appends the chunk lines to the draft lyrics, chains the chunk hash
+// to the draft lyrics hash and tops up the storage deposit of the draft
+func (h AppendLyricsChunkHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, draft, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	var lines, chunk []string
+	if len(draft.Lyrics) != 0 {
+		if err := json.Unmarshal(draft.Lyrics, &lines); err != nil {
+			return nil, errors.Wrap(errors.ErrState, "cannot unmarshal draft lyrics")
+		}
+	}
+	if err := json.Unmarshal(msg.Lyrics, &chunk); err != nil {
+		return nil, errors.Wrap(errors.ErrInput, "cannot unmarshal chunk lyrics")
+	}
+	if draft.Lyrics, err = json.Marshal(append(lines, chunk...)); err != nil {
+		return nil, errors.Wrap(errors.ErrState, "cannot marshal draft lyrics")
+	}
+	draft.Chunks++
+	draft.LyricsHash = NextLyricsHash(draft.LyricsHash, msg.Lyrics)
+
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, err
+	}
+	draft.Deposit, draft.Depositor, err = updateDeposit(store, h.ctrl, draft.Owner, draft.Depositor, draft.Deposit, conf.CountdownDeposit, draft.Size())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.drafts.Put(store, draft); err != nil {
+		return nil, errors.Wrapf(err, "cannot store draft with ID %s", draft.ID)
+	}
+
+	return &weave.DeliverResult{}, nil
+}
+
+// ------------------- DeleteCountdownDraftHandler -------------------
+
+// DeleteCountdownDraftHandler will handle DeleteCountdownDraftMsg
+type DeleteCountdownDraftHandler struct {
+	auth   x.Authenticator
+	drafts *DraftBucket
+	ctrl   cash.Controller
+}
+
+var _ weave.Handler = DeleteCountdownDraftHandler{}
+
+// NewDeleteCountdownDraftHandler creates a delete countdown draft message
+// handler
+func NewDeleteCountdownDraftHandler(auth x.Authenticator, ctrl cash.Controller) weave.Handler {
+	return DeleteCountdownDraftHandler{
+		auth:   auth,
+		drafts: NewDraftBucket(),
+		ctrl:   ctrl,
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h DeleteCountdownDraftHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*DeleteCountdownDraftMsg, *Draft, error) {
+	var msg DeleteCountdownDraftMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var draft Draft
+	if err := h.drafts.One(store, msg.DraftID, &draft); err != nil {
+		return nil, nil, errors.Wrapf(err, "cannot retrieve draft with ID %s", msg.DraftID)
+	}
+
+	if !h.auth.HasAddress(ctx, draft.Owner) {
+		return nil, nil, errors.Wrapf(errors.ErrUnauthorized, "owner of draft with ID %s did not authorize the transaction", draft.ID)
+	}
+
+	return &msg, &draft, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h DeleteCountdownDraftHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deleting is free of charge
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver deletes the draft and refunds its storage deposit
+func (h DeleteCountdownDraftHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, draft, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := refundDeposit(store, h.ctrl, draft.Depositor, draft.Deposit); err != nil {
+		return nil, err
+	}
+
+	if err := h.drafts.Delete(store, draft.ID); err != nil {
+		return nil, errors.Wrapf(err, "cannot delete draft with ID %s", draft.ID)
+	}
+
+	return &weave.DeliverResult{}, nil
+}
+
+// ------------------- PublishCountdownHandler -------------------
+
+// PublishCountdownHandler will handle PublishCountdownMsg
+type PublishCountdownHandler struct {
+	auth      x.Authenticator
+	b         *CountdownBucket
+	drafts    *DraftBucket
+	scheduler weave.Scheduler
+	ctrl      cash.Controller
+}
+
+var _ weave.Handler = PublishCountdownHandler{}
+
+// NewPublishCountdownHandler creates a publish countdown message handler
+func NewPublishCountdownHandler(auth x.Authenticator, scheduler weave.Scheduler, ctrl cash.Controller) weave.Handler {
+	return PublishCountdownHandler{
+		auth:      auth,
+		b:         NewCountdownBucket(),
+		drafts:    NewDraftBucket(),
+		scheduler: scheduler,
+		ctrl:      ctrl,
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h PublishCountdownHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*PublishCountdownMsg, *Draft, *Countdown, error) {
+	var msg PublishCountdownMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var draft Draft
+	if err := h.drafts.One(store, msg.DraftID, &draft); err != nil {
+		return nil, nil, nil, errors.Wrapf(err, "cannot retrieve draft with ID %s", msg.DraftID)
+	}
+
+	if !h.auth.HasAddress(ctx, draft.Owner) {
+		return nil, nil, nil, errors.Wrapf(errors.ErrUnauthorized, "owner of draft with ID %s did not authorize the transaction", draft.ID)
+	}
+	if len(draft.Bounty) != 0 && !h.auth.HasAddress(ctx, draft.Sponsor) {
+		return nil, nil, nil, errors.Wrapf(errors.ErrUnauthorized, "sponsor %s did not authorize the bounty", draft.Sponsor)
+	}
+
+	if draft.Chunks == 0 {
+		return nil, nil, nil, errors.Wrapf(errors.ErrState, "draft with ID %s has no lyrics", draft.ID)
+	}
+	if !bytes.Equal(msg.LyricsHash, draft.LyricsHash) {
+		return nil, nil, nil, errors.Field("LyricsHash", errors.ErrInput, "does not match the appended chunks")
+	}
+
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "no block time in header")
+	}
+	now := weave.AsUnixTime(blockTime)
+
+	if draft.DeleteAt != 0 && !draft.DeleteAt.Time().After(blockTime) {
+		return nil, nil, nil, errors.Field("DeleteAt", errors.ErrInput, "must be in the future")
+	}
+
+	// the configuration could have changed since the chunks were appended
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if err := validateLyrics(draft.Lyrics); err != nil {
+		return nil, nil, nil, err
+	}
+	lines, err := lyricsLines(draft.Lyrics)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if err := conf.validateLines(lines); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := checkAttachmentLines(msg.Attachments, len(lines)); err != nil {
+		return nil, nil, nil, err
+	}
+
+	if err := checkQuota(store, h.b, conf, draft.Owner, blockTime); err != nil {
+		return nil, nil, nil, err
+	}
+
+	cd := &Countdown{
+		Metadata:           &weave.Metadata{Schema: countdownSchema},
+		Owner:              draft.Owner,
+		Title:              draft.Title,
+		CreatedAt:          now,
+		MissedRevealPolicy: draft.MissedRevealPolicy,
+		ScheduleStart:      now,
+		Bounty:             draft.Bounty,
+		Beneficiary:        draft.Beneficiary,
+		Sponsor:            draft.Sponsor,
+		DeleteAt:           draft.DeleteAt,
+		Attachments:        msg.Attachments,
+		RepeatPolicy:       draft.RepeatPolicy,
+		RepeatCount:        draft.RepeatCount,
+		Tags:               draft.Tags,
+	}
+	if err := cd.SetLyrics(draft.Lyrics); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return &msg, &draft, cd, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h PublishCountdownHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, draft, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{GasAllocated: conf.countdownCost(draft.Lyrics)}, nil
+}
+
+// Deliver creates the countdown from the draft, starts its schedule and
+// deletes the draft
+func (h PublishCountdownHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, draft, cd, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the countdown takes its own deposit, the draft deposit is refunded
+	if err := refundDeposit(store, h.ctrl, draft.Depositor, draft.Deposit); err != nil {
+		return nil, err
+	}
+
+	if err := startCountdown(store, h.scheduler, h.ctrl, h.b, cd); err != nil {
+		return nil, err
+	}
+
+	if err := h.drafts.Delete(store, draft.ID); err != nil {
+		return nil, errors.Wrapf(err, "cannot delete draft with ID %s", draft.ID)
+	}
+
+	// Returns generated countdown ID as response
+	return &weave.DeliverResult{Data: cd.ID}, nil
+}
+
+// ------------------- GrantRoleHandler -------------------
+
+// GrantRoleHandler will handle GrantRoleMsg
+type GrantRoleHandler struct {
+	auth x.Authenticator
+	b    *CountdownBucket
+}
+
+var _ weave.Handler = GrantRoleHandler{}
+
+// NewGrantRoleHandler creates a grant role message handler
+func NewGrantRoleHandler(auth x.Authenticator) weave.Handler {
+	return GrantRoleHandler{
+		auth: auth,
+		b:    NewCountdownBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h GrantRoleHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*GrantRoleMsg, *Countdown, error) {
+	var msg GrantRoleMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var cd Countdown
+	if err := h.b.One(store, msg.CountdownID, &cd); err != nil {
+		return nil, nil, errors.Wrapf(err, "cannot retrieve countdown with ID %s", msg.CountdownID)
+	}
+
+	// only the owner can manage roles, so that managers cannot escalate
+	if err := authorizeOwner(ctx, h.auth, &cd); err != nil {
+		return nil, nil, err
+	}
+
+	if cd.Owner.Equals(msg.Address) {
+		return nil, nil, errors.Wrap(errors.ErrInput, "owner cannot be granted a role")
+	}
+
+	return &msg, &cd, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h GrantRoleHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver grants the role and saves the countdown if all preconditions are met
+func (h GrantRoleHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, cd, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	granted := false
+	for _, e := range cd.Editors {
+		if e.Address.Equals(msg.Address) {
+			e.Role = msg.Role
+			granted = true
+		}
+	}
+	if !granted {
+		cd.Editors = append(cd.Editors, &Editor{Address: msg.Address, Role: msg.Role})
+	}
+
+	if err := h.b.Put(store, cd); err != nil {
+		return nil, errors.Wrapf(err, "cannot grant role on countdown with ID %s", cd.ID)
+	}
+
+	return &weave.DeliverResult{}, nil
+}
+
+// ------------------- RevokeRoleHandler -------------------
+
+// RevokeRoleHandler will handle RevokeRoleMsg
+type RevokeRoleHandler struct {
+	auth x.Authenticator
+	b    *CountdownBucket
+}
+
+var _ weave.Handler = RevokeRoleHandler{}
+
+// NewRevokeRoleHandler creates a revoke role message handler
+func NewRevokeRoleHandler(auth x.Authenticator) weave.Handler {
+	return RevokeRoleHandler{
+		auth: auth,
+		b:    NewCountdownBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h RevokeRoleHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*RevokeRoleMsg, *Countdown, error) {
+	var msg RevokeRoleMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var cd Countdown
+	if err := h.b.One(store, msg.CountdownID, &cd); err != nil {
+		return nil, nil, errors.Wrapf(err, "cannot retrieve countdown with ID %s", msg.CountdownID)
+	}
+
+	if err := authorizeOwner(ctx, h.auth, &cd); err != nil {
+		return nil, nil, err
+	}
+
+	return &msg, &cd, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h RevokeRoleHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver revokes the role and saves the countdown if all preconditions are met
+func (h RevokeRoleHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, cd, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	editors := make([]*Editor, 0, len(cd.Editors))
+	for _, e := range cd.Editors {
+		if !e.Address.Equals(msg.Address) {
+			editors = append(editors, e)
+		}
+	}
+	if len(editors) == len(cd.Editors) {
+		return nil, errors.Wrapf(errors.ErrNotFound, "address %s has no role on countdown with ID %s", msg.Address, cd.ID)
+	}
+	cd.Editors = editors
+
+	if err := h.b.Put(store, cd); err != nil {
+		return nil, errors.Wrapf(err, "cannot revoke role on countdown with ID %s", cd.ID)
+	}
+
+	return &weave.DeliverResult{}, nil
+}
+
+// ------------------- UpdateLyricsHandler -------------------
+
+// UpdateLyricsHandler will handle UpdateLyricsMsg
+type UpdateLyricsHandler struct {
+	auth x.Authenticator
+	b    *CountdownBucket
+	ctrl cash.Controller
+}
+
+var _ weave.Handler = UpdateLyricsHandler{}
+
+// NewUpdateLyricsHandler creates an update lyrics message handler
+func NewUpdateLyricsHandler(auth x.Authenticator, ctrl cash.Controller) weave.Handler {
+	return UpdateLyricsHandler{
+		auth: auth,
+		b:    NewCountdownBucket(),
+		ctrl: ctrl,
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h UpdateLyricsHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*UpdateLyricsMsg, *Countdown, error) {
+	var msg UpdateLyricsMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var cd Countdown
+	if err := h.b.One(store, msg.CountdownID, &cd); err != nil {
+		return nil, nil, errors.Wrapf(err, "cannot retrieve countdown with ID %s", msg.CountdownID)
+	}
+
+	if err := authorize(ctx, h.auth, &cd, EditorRole_Editor); err != nil {
+		return nil, nil, err
+	}
+
+	if cd.CompletedAt != 0 {
+		return nil, nil, errors.Wrapf(errors.ErrState, "countdown with ID %s is completed", cd.ID)
+	}
+
+	revealed, err := cd.RevealedLines()
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "countdown with ID %s", cd.ID)
+	}
+	lines, err := lyricsLines(msg.Lyrics)
+	if err != nil {
+		return nil, nil, errors.Field("Lyrics", errors.ErrInput, "must be a list of lines")
+	}
+	if len(lines) <= len(revealed) {
+		return nil, nil, errors.Field("Lyrics", errors.ErrInput, "at least one line must remain to be revealed")
+	}
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, nil, err
+	}
+	// revealed lines are not checked as they are kept unchanged
+	if err := conf.validateLines(lines[len(revealed):]); err != nil {
+		return nil, nil, err
+	}
+	for i, line := range revealed {
+		if lines[i] != line {
+			return nil, nil, errors.Field("Lyrics line "+strconv.Itoa(i), errors.ErrInput, "revealed line cannot be changed")
+		}
+	}
+	if len(msg.Attachments) != 0 && int(msg.Attachments[0].Line) < len(revealed) {
+		return nil, nil, errors.Field("Attachments", errors.ErrInput, "attachment of a revealed line cannot be changed")
+	}
+
+	return &msg, &cd, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h UpdateLyricsHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver replaces the lyrics and saves the countdown if all preconditions are
+// met. The storage deposit follows the new size of the countdown, the signer
+// pays for any growth.
+func (h UpdateLyricsHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, cd, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cd.SetLyrics(msg.Lyrics); err != nil {
+		return nil, err
+	}
+
+	// attachments of revealed lines are kept, the others are replaced
+	var attachments []*Attachment
+	for _, a := range cd.Attachments {
+		if a.Line < cd.RevealedCount {
+			attachments = append(attachments, a)
+		}
+	}
+	cd.Attachments = append(attachments, msg.Attachments...)
+
+	signer := x.MainSigner(ctx, h.auth)
+	if signer == nil {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "no signer")
+	}
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, err
+	}
+	cd.Deposit, cd.Depositor, err = updateDeposit(store, h.ctrl, signer.Address(), cd.Depositor, cd.Deposit, conf.CountdownDeposit, cd.Size())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.b.Put(store, cd); err != nil {
+		return nil, errors.Wrapf(err, "cannot update lyrics of countdown with ID %s", cd.ID)
+	}
+
+	return &weave.DeliverResult{}, nil
+}
+
+// ------------------- PauseCountdownHandler -------------------
+
+// PauseCountdownHandler will handle PauseCountdownMsg
+type PauseCountdownHandler struct {
+	auth      x.Authenticator
+	b         *CountdownBucket
+	scheduler weave.Scheduler
+}
+
+var _ weave.Handler = PauseCountdownHandler{}
+
+// NewPauseCountdownHandler creates a pause countdown message handler
+func NewPauseCountdownHandler(auth x.Authenticator, scheduler weave.Scheduler) weave.Handler {
+	return PauseCountdownHandler{
+		auth:      auth,
+		b:         NewCountdownBucket(),
+		scheduler: scheduler,
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h PauseCountdownHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*PauseCountdownMsg, *Countdown, error) {
+	var msg PauseCountdownMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var cd Countdown
+	if err := h.b.One(store, msg.CountdownID, &cd); err != nil {
+		return nil, nil, errors.Wrapf(err, "cannot retrieve countdown with ID %s", msg.CountdownID)
+	}
+
+	if err := authorize(ctx, h.auth, &cd, EditorRole_Manager); err != nil {
+		return nil, nil, err
+	}
+
+	if cd.CompletedAt != 0 {
+		return nil, nil, errors.Wrapf(errors.ErrState, "countdown with ID %s is completed", cd.ID)
+	}
+	if cd.PausedAt != 0 {
+		return nil, nil, errors.Wrapf(errors.ErrState, "countdown with ID %s is already paused", cd.ID)
+	}
+	if cd.WaitsForPredecessor() {
+		return nil, nil, errors.Wrapf(errors.ErrState, "countdown with ID %s waits for its predecessor", cd.ID)
+	}
+
+	return &msg, &cd, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h PauseCountdownHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver cancels the pending reveal and marks the countdown paused
+func (h PauseCountdownHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, cd, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "no block time in header")
+	}
+
+	if err := cancelReveal(store, h.scheduler, cd); err != nil {
+		return nil, err
+	}
+	cd.PausedAt = weave.AsUnixTime(now)
+
+	if err := h.b.Put(store, cd); err != nil {
+		return nil, errors.Wrapf(err, "cannot pause countdown with ID %s", cd.ID)
+	}
+
+	return &weave.DeliverResult{}, nil
+}
+
+// ------------------- ResumeCountdownHandler -------------------
+
+// ResumeCountdownHandler will handle ResumeCountdownMsg
+type ResumeCountdownHandler struct {
+	auth      x.Authenticator
+	b         *CountdownBucket
+	scheduler weave.Scheduler
+}
+
+var _ weave.Handler = ResumeCountdownHandler{}
+
+// NewResumeCountdownHandler creates a resume countdown message handler
+func NewResumeCountdownHandler(auth x.Authenticator, scheduler weave.Scheduler) weave.Handler {
+	return ResumeCountdownHandler{
+		auth:      auth,
+		b:         NewCountdownBucket(),
+		scheduler: scheduler,
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h ResumeCountdownHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*ResumeCountdownMsg, *Countdown, error) {
+	var msg ResumeCountdownMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var cd Countdown
+	if err := h.b.One(store, msg.CountdownID, &cd); err != nil {
+		return nil, nil, errors.Wrapf(err, "cannot retrieve countdown with ID %s", msg.CountdownID)
+	}
+
+	if err := authorize(ctx, h.auth, &cd, EditorRole_Manager); err != nil {
+		return nil, nil, err
+	}
+
+	if cd.PausedAt == 0 {
+		return nil, nil, errors.Wrapf(errors.ErrState, "countdown with ID %s is not paused", cd.ID)
+	}
+
+	return &msg, &cd, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h ResumeCountdownHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver schedules the next reveal of a paused countdown. The reveal
+// schedule is moved forward by the time the countdown was paused.
+func (h ResumeCountdownHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, cd, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "no block time in header")
+	}
+
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, err
+	}
+
+	cd.ScheduleStart = cd.ScheduleStart.Add(now.Sub(cd.PausedAt.Time()))
+	cd.PausedAt = 0
+
+	if err := scheduleReveal(store, h.scheduler, cd, nextRevealAt(cd, int(cd.RevealedCount), now, conf.RevealInterval.Duration())); err != nil {
+		return nil, err
+	}
+
+	if err := h.b.Put(store, cd); err != nil {
+		return nil, errors.Wrapf(err, "cannot resume countdown with ID %s", cd.ID)
+	}
+
+	return &weave.DeliverResult{}, nil
+}
+
+// ------------------- RestartCountdownHandler -------------------
+
+// RestartCountdownHandler will handle RestartCountdownMsg
+type RestartCountdownHandler struct {
+	auth      x.Authenticator
+	b         *CountdownBucket
+	scheduler weave.Scheduler
+}
+
+var _ weave.Handler = RestartCountdownHandler{}
+
+// NewRestartCountdownHandler creates a restart countdown message handler
+func NewRestartCountdownHandler(auth x.Authenticator, scheduler weave.Scheduler) weave.Handler {
+	return RestartCountdownHandler{
+		auth:      auth,
+		b:         NewCountdownBucket(),
+		scheduler: scheduler,
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h RestartCountdownHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*RestartCountdownMsg, *Countdown, error) {
+	var msg RestartCountdownMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var cd Countdown
+	if err := h.b.One(store, msg.CountdownID, &cd); err != nil {
+		return nil, nil, errors.Wrapf(err, "cannot retrieve countdown with ID %s", msg.CountdownID)
+	}
+
+	if err := authorize(ctx, h.auth, &cd, EditorRole_Manager); err != nil {
+		return nil, nil, err
+	}
+
+	if cd.CompletedAt == 0 {
+		return nil, nil, errors.Wrapf(errors.ErrState, "countdown with ID %s is not completed", cd.ID)
+	}
+	if !cd.CanRepeat() {
+		return nil, nil, errors.Wrapf(errors.ErrState, "countdown with ID %s cannot be repeated", cd.ID)
+	}
+
+	return &msg, &cd, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h RestartCountdownHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver starts the next iteration of a completed countdown at the block
+// time. The iteration that was scheduled at the completion is cancelled.
+func (h RestartCountdownHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, cd, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "no block time in header")
+	}
+
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cancelReveal(store, h.scheduler, cd); err != nil {
+		return nil, err
+	}
+	cd.Restart(weave.AsUnixTime(now))
+	if err := scheduleReveal(store, h.scheduler, cd, now.Add(conf.RevealInterval.Duration())); err != nil {
+		return nil, err
+	}
+
+	if err := h.b.Put(store, cd); err != nil {
+		return nil, errors.Wrapf(err, "cannot restart countdown with ID %s", cd.ID)
+	}
+
+	return &weave.DeliverResult{}, nil
+}
+
+// ------------------- TransferCountdownHandler -------------------
+
+// TransferCountdownHandler will handle TransferCountdownMsg
+type TransferCountdownHandler struct {
+	auth      x.Authenticator
+	b         *CountdownBucket
+	scheduler weave.Scheduler
+}
+
+var _ weave.Handler = TransferCountdownHandler{}
+
+// NewTransferCountdownHandler creates a transfer countdown message handler
+func NewTransferCountdownHandler(auth x.Authenticator, scheduler weave.Scheduler) weave.Handler {
+	return TransferCountdownHandler{
+		auth:      auth,
+		b:         NewCountdownBucket(),
+		scheduler: scheduler,
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h TransferCountdownHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*TransferCountdownMsg, *Countdown, error) {
+	var msg TransferCountdownMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var cd Countdown
+	if err := h.b.One(store, msg.CountdownID, &cd); err != nil {
+		return nil, nil, errors.Wrapf(err, "cannot retrieve countdown with ID %s", msg.CountdownID)
+	}
+
+	if err := authorizeOwner(ctx, h.auth, &cd); err != nil {
+		return nil, nil, err
+	}
+
+	if cd.Owner.Equals(msg.NewOwner) {
+		return nil, nil, errors.Wrap(errors.ErrInput, "countdown is already owned by the new owner")
+	}
+
+	return &msg, &cd, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h TransferCountdownHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver transfers the countdown to the new owner. If the transfer requires
+// to be accepted, the new owner is only recorded as pending owner. A new
+// transfer replaces a pending one.
+func (h TransferCountdownHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, cd, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.RequireAccept {
+		cd.PendingOwner = msg.NewOwner
+	} else if err := setOwner(store, h.scheduler, cd, msg.NewOwner); err != nil {
+		return nil, err
+	}
+
+	if err := h.b.Put(store, cd); err != nil {
+		return nil, errors.Wrapf(err, "cannot transfer countdown with ID %s", cd.ID)
+	}
+
+	return &weave.DeliverResult{}, nil
+}
+
+// ------------------- AcceptCountdownTransferHandler -------------------
+
+// AcceptCountdownTransferHandler will handle AcceptCountdownTransferMsg
+type AcceptCountdownTransferHandler struct {
+	auth      x.Authenticator
+	b         *CountdownBucket
+	scheduler weave.Scheduler
+}
+
+var _ weave.Handler = AcceptCountdownTransferHandler{}
+
+// NewAcceptCountdownTransferHandler creates an accept countdown transfer message handler
+func NewAcceptCountdownTransferHandler(auth x.Authenticator, scheduler weave.Scheduler) weave.Handler {
+	return AcceptCountdownTransferHandler{
+		auth:      auth,
+		b:         NewCountdownBucket(),
+		scheduler: scheduler,
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h AcceptCountdownTransferHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*AcceptCountdownTransferMsg, *Countdown, error) {
+	var msg AcceptCountdownTransferMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var cd Countdown
+	if err := h.b.One(store, msg.CountdownID, &cd); err != nil {
+		return nil, nil, errors.Wrapf(err, "cannot retrieve countdown with ID %s", msg.CountdownID)
+	}
+
+	if len(cd.PendingOwner) == 0 {
+		return nil, nil, errors.Wrapf(errors.ErrState, "countdown with ID %s has no pending transfer", cd.ID)
+	}
+	if !h.auth.HasAddress(ctx, cd.PendingOwner) {
+		return nil, nil, errors.Wrapf(errors.ErrUnauthorized, "pending owner of countdown with ID %s did not authorize the transaction", cd.ID)
+	}
+
+	return &msg, &cd, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h AcceptCountdownTransferHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver completes the pending transfer of the countdown
+func (h AcceptCountdownTransferHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, cd, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := setOwner(store, h.scheduler, cd, cd.PendingOwner); err != nil {
+		return nil, err
+	}
+
+	if err := h.b.Put(store, cd); err != nil {
+		return nil, errors.Wrapf(err, "cannot transfer countdown with ID %s", cd.ID)
+	}
+
+	return &weave.DeliverResult{}, nil
+}
+
+// ------------------- TipCountdownHandler -------------------
+
+// TipCountdownHandler will handle TipCountdownMsg
+type TipCountdownHandler struct {
+	auth    x.Authenticator
+	b       *CountdownBucket
+	tippers *TipperBucket
+	ctrl    cash.Controller
+}
+
+var _ weave.Handler = TipCountdownHandler{}
+
+// NewTipCountdownHandler creates a tip countdown message handler
+func NewTipCountdownHandler(auth x.Authenticator, ctrl cash.Controller) weave.Handler {
+	return TipCountdownHandler{
+		auth:    auth,
+		b:       NewCountdownBucket(),
+		tippers: NewTipperBucket(),
+		ctrl:    ctrl,
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h TipCountdownHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*TipCountdownMsg, *Countdown, error) {
+	var msg TipCountdownMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	if len(msg.Tipper) == 0 {
+		signer := x.MainSigner(ctx, h.auth)
+		if signer == nil {
+			return nil, nil, errors.Field("Tipper", errors.ErrEmpty, "no signer")
+		}
+		msg.Tipper = signer.Address()
+	} else if !h.auth.HasAddress(ctx, msg.Tipper) {
+		return nil, nil, errors.Wrapf(errors.ErrUnauthorized, "tipper %s did not authorize the tip", msg.Tipper)
+	}
+
+	var cd Countdown
+	if err := h.b.One(store, msg.CountdownID, &cd); err != nil {
+		return nil, nil, errors.Wrapf(err, "cannot retrieve countdown with ID %s", msg.CountdownID)
+	}
+
+	if cd.HiddenAt != 0 {
+		return nil, nil, errors.Wrapf(errors.ErrState, "countdown with ID %s is hidden", cd.ID)
+	}
+	if cd.Owner.Equals(msg.Tipper) {
+		return nil, nil, errors.Wrap(errors.ErrInput, "owner cannot tip their own countdown")
+	}
+
+	return &msg, &cd, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h TipCountdownHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver moves the tip to the countdown owner and records it on the
+// countdown and the tipper totals
+func (h TipCountdownHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, cd, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.ctrl.MoveCoins(store, msg.Tipper, cd.Owner, *msg.Amount); err != nil {
+		return nil, errors.Wrap(err, "cannot move tip")
+	}
+
+	tipper := Tipper{
+		Metadata:    &weave.Metadata{Schema: 1},
+		ID:          tipperID(cd.ID, msg.Tipper),
+		CountdownID: cd.ID,
+		Address:     msg.Tipper,
+	}
+	if err := h.tippers.One(store, tipper.ID, &tipper); err != nil && !errors.ErrNotFound.Is(err) {
+		return nil, errors.Wrapf(err, "cannot retrieve tipper %s", msg.Tipper)
+	}
+	if tipper.Total, err = coin.Coins(tipper.Total).Add(*msg.Amount); err != nil {
+		return nil, errors.Wrap(err, "cannot add tip to tipper total")
+	}
+	if err := h.tippers.Put(store, &tipper); err != nil {
+		return nil, errors.Wrapf(err, "cannot store tipper %s", msg.Tipper)
+	}
+
+	if cd.TipTotal, err = coin.Coins(cd.TipTotal).Add(*msg.Amount); err != nil {
+		return nil, errors.Wrap(err, "cannot add tip to countdown total")
+	}
+	for _, c := range tipper.Total {
+		if c.Ticker == msg.Amount.Ticker {
+			cd.TopTippers = updateTopTippers(cd.TopTippers, msg.Tipper, c)
+		}
+	}
+	if err := h.b.Put(store, cd); err != nil {
+		return nil, errors.Wrapf(err, "cannot tip countdown with ID %s", cd.ID)
+	}
+
+	return &weave.DeliverResult{
+		Tags: []common.KVPair{
+			{Key: []byte("countdown.tip.countdown"), Value: []byte(strings.ToUpper(hex.EncodeToString(cd.ID)))},
+			{Key: []byte("countdown.tip.tipper"), Value: []byte(msg.Tipper.String())},
+			{Key: []byte("countdown.tip.owner"), Value: []byte(cd.Owner.String())},
+			{Key: []byte("countdown.tip.amount"), Value: []byte(msg.Amount.String())},
+		},
+	}, nil
+}
+
+// updateTopTippers records the new total an address tipped in a currency and
+// keeps only the largest totals of each currency, ordered by currency and
+// then by decreasing total.
+func updateTopTippers(top []*TopTipper, addr weave.Address, total *coin.Coin) []*TopTipper {
+	all := make([]*TopTipper, 0, len(top)+1)
+	for _, t := range top {
+		if t.Total.Ticker != total.Ticker || !t.Address.Equals(addr) {
+			all = append(all, t)
+		}
+	}
+	all = append(all, &TopTipper{Address: addr, Total: total})
+
+	sort.SliceStable(all, func(i, j int) bool {
+		a, b := all[i].Total, all[j].Total
+		if a.Ticker != b.Ticker {
+			return a.Ticker < b.Ticker
+		}
+		return a.Compare(*b) > 0
+	})
+
+	updated := make([]*TopTipper, 0, len(all))
+	var ticker string
+	var count int
+	for _, t := range all {
+		if t.Total.Ticker != ticker {
+			ticker, count = t.Total.Ticker, 0
+		}
+		if count < maxTopTippers {
+			updated = append(updated, t)
+		}
+		count++
+	}
+	return updated
+}
+
+// ------------------- SetUnlockPriceHandler -------------------
+
+// SetUnlockPriceHandler will handle SetUnlockPriceMsg
+type SetUnlockPriceHandler struct {
+	auth x.Authenticator
+	b    *CountdownBucket
+}
+
+var _ weave.Handler = SetUnlockPriceHandler{}
+
+// NewSetUnlockPriceHandler creates a set unlock price message handler
+func NewSetUnlockPriceHandler(auth x.Authenticator) weave.Handler {
+	return SetUnlockPriceHandler{
+		auth: auth,
+		b:    NewCountdownBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h SetUnlockPriceHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*SetUnlockPriceMsg, *Countdown, error) {
+	var msg SetUnlockPriceMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var cd Countdown
+	if err := h.b.One(store, msg.CountdownID, &cd); err != nil {
+		return nil, nil, errors.Wrapf(err, "cannot retrieve countdown with ID %s", msg.CountdownID)
+	}
+
+	// the price is paid to the owner, so only the owner can set it
+	if err := authorizeOwner(ctx, h.auth, &cd); err != nil {
+		return nil, nil, err
+	}
+
+	return &msg, &cd, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h SetUnlockPriceHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver sets the unlock price and saves the countdown
+func (h SetUnlockPriceHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, cd, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	cd.UnlockPrice = msg.Price
+
+	if err := h.b.Put(store, cd); err != nil {
+		return nil, errors.Wrapf(err, "cannot set unlock price of countdown with ID %s", cd.ID)
+	}
+
+	return &weave.DeliverResult{}, nil
+}
+
+// ------------------- UnlockNextLineHandler -------------------
+
+// UnlockNextLineHandler will handle UnlockNextLineMsg
+type UnlockNextLineHandler struct {
+	auth      x.Authenticator
+	b         *CountdownBucket
+	scheduler weave.Scheduler
+	ctrl      cash.Controller
+}
+
+var _ weave.Handler = UnlockNextLineHandler{}
+
+// NewUnlockNextLineHandler creates an unlock next line message handler
+func NewUnlockNextLineHandler(auth x.Authenticator, scheduler weave.Scheduler, ctrl cash.Controller) weave.Handler {
+	return UnlockNextLineHandler{
+		auth:      auth,
+		b:         NewCountdownBucket(),
+		scheduler: scheduler,
+		ctrl:      ctrl,
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h UnlockNextLineHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*UnlockNextLineMsg, *Countdown, error) {
+	var msg UnlockNextLineMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	if len(msg.Payer) == 0 {
+		signer := x.MainSigner(ctx, h.auth)
+		if signer == nil {
+			return nil, nil, errors.Field("Payer", errors.ErrEmpty, "no signer")
+		}
+		msg.Payer = signer.Address()
+	} else if !h.auth.HasAddress(ctx, msg.Payer) {
+		return nil, nil, errors.Wrapf(errors.ErrUnauthorized, "payer %s did not authorize the unlock", msg.Payer)
+	}
+
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "no block time in header")
+	}
+
+	var cd Countdown
+	if err := h.b.One(store, msg.CountdownID, &cd); err != nil {
+		return nil, nil, errors.Wrapf(err, "cannot retrieve countdown with ID %s", msg.CountdownID)
+	}
+
+	switch {
+	case cd.HiddenAt != 0:
+		return nil, nil, errors.Wrapf(errors.ErrState, "countdown with ID %s is hidden", cd.ID)
+	case cd.UnlockPrice == nil:
+		return nil, nil, errors.Wrapf(errors.ErrState, "countdown with ID %s cannot be unlocked early", cd.ID)
+	case cd.PausedAt != 0:
+		return nil, nil, errors.Wrapf(errors.ErrState, "countdown with ID %s is paused", cd.ID)
+	case cd.CompletedAt != 0:
+		return nil, nil, errors.Wrapf(errors.ErrState, "countdown with ID %s is completed", cd.ID)
+	case len(cd.TaskID) == 0:
+		return nil, nil, errors.Wrapf(errors.ErrState, "countdown with ID %s has no pending reveal", cd.ID)
+	case !cd.NextRevealAt.Time().After(blockTime):
+		return nil, nil, errors.Wrapf(errors.ErrState, "next line of countdown with ID %s is already due", cd.ID)
+	}
+
+	return &msg, &cd, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h UnlockNextLineHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver pays the unlock price to the owner and reschedules the pending
+// reveal to be executed right away
+func (h UnlockNextLineHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, cd, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "no block time in header")
+	}
+
+	if err := h.ctrl.MoveCoins(store, msg.Payer, cd.Owner, *cd.UnlockPrice); err != nil {
+		return nil, errors.Wrap(err, "cannot pay unlock price")
+	}
+
+	cd.UnlockedRevealAt = cd.NextRevealAt
+	if err := cancelReveal(store, h.scheduler, cd); err != nil {
+		return nil, err
+	}
+	if err := scheduleReveal(store, h.scheduler, cd, now); err != nil {
+		return nil, err
+	}
+
+	if err := h.b.Put(store, cd); err != nil {
+		return nil, errors.Wrapf(err, "cannot unlock next line of countdown with ID %s", cd.ID)
+	}
+
+	return &weave.DeliverResult{}, nil
+}
+
+// ------------------- FlagCountdownHandler -------------------
+
+// FlagCountdownHandler will handle FlagCountdownMsg
+type FlagCountdownHandler struct {
+	auth  x.Authenticator
+	b     *CountdownBucket
+	flags *FlagBucket
+}
+
+var _ weave.Handler = FlagCountdownHandler{}
+
+// NewFlagCountdownHandler creates a flag countdown message handler
+func NewFlagCountdownHandler(auth x.Authenticator) weave.Handler {
+	return FlagCountdownHandler{
+		auth:  auth,
+		b:     NewCountdownBucket(),
+		flags: NewFlagBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h FlagCountdownHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*FlagCountdownMsg, *Countdown, error) {
+	var msg FlagCountdownMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	if len(msg.Reporter) == 0 {
+		signer := x.MainSigner(ctx, h.auth)
+		if signer == nil {
+			return nil, nil, errors.Field("Reporter", errors.ErrEmpty, "no signer")
+		}
+		msg.Reporter = signer.Address()
+	} else if !h.auth.HasAddress(ctx, msg.Reporter) {
+		return nil, nil, errors.Wrapf(errors.ErrUnauthorized, "reporter %s did not authorize the flag", msg.Reporter)
+	}
+
+	var cd Countdown
+	if err := h.b.One(store, msg.CountdownID, &cd); err != nil {
+		return nil, nil, errors.Wrapf(err, "cannot retrieve countdown with ID %s", msg.CountdownID)
+	}
+
+	var flag Flag
+	switch err := h.flags.One(store, flagID(cd.ID, msg.Reporter), &flag); {
+	case err == nil:
+		return nil, nil, errors.Wrapf(errors.ErrDuplicate, "%s already flagged countdown with ID %s", msg.Reporter, cd.ID)
+	case !errors.ErrNotFound.Is(err):
+		return nil, nil, errors.Wrapf(err, "cannot retrieve flag of %s", msg.Reporter)
+	}
+
+	return &msg, &cd, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h FlagCountdownHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver stores the flag and counts it on the countdown
+func (h FlagCountdownHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, cd, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "no block time in header")
+	}
+
+	flag := Flag{
+		Metadata:    &weave.Metadata{Schema: 1},
+		ID:          flagID(cd.ID, msg.Reporter),
+		CountdownID: cd.ID,
+		Reporter:    msg.Reporter,
+		Reason:      msg.Reason,
+		FlaggedAt:   weave.AsUnixTime(now),
+	}
+	if err := h.flags.Put(store, &flag); err != nil {
+		return nil, errors.Wrapf(err, "cannot store flag of %s", msg.Reporter)
+	}
+
+	cd.FlagCount++
+	if err := h.b.Put(store, cd); err != nil {
+		return nil, errors.Wrapf(err, "cannot flag countdown with ID %s", cd.ID)
+	}
+
+	return &weave.DeliverResult{
+		Tags: []common.KVPair{
+			{Key: []byte("countdown.flag.countdown"), Value: []byte(strings.ToUpper(hex.EncodeToString(cd.ID)))},
+			{Key: []byte("countdown.flag.reporter"), Value: []byte(msg.Reporter.String())},
+		},
+	}, nil
+}
+
+// ------------------- ModerateCountdownHandler -------------------
+
+// ModerateCountdownHandler will handle ModerateCountdownMsg
+type ModerateCountdownHandler struct {
+	auth      x.Authenticator
+	b         *CountdownBucket
+	flags     *FlagBucket
+	scheduler weave.Scheduler
+	ctrl      cash.Controller
+}
+
+var _ weave.Handler = ModerateCountdownHandler{}
+
+// NewModerateCountdownHandler creates a moderate countdown message handler
+func NewModerateCountdownHandler(auth x.Authenticator, scheduler weave.Scheduler, ctrl cash.Controller) weave.Handler {
+	return ModerateCountdownHandler{
+		auth:      auth,
+		b:         NewCountdownBucket(),
+		flags:     NewFlagBucket(),
+		scheduler: scheduler,
+		ctrl:      ctrl,
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h ModerateCountdownHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*ModerateCountdownMsg, *Countdown, error) {
+	var msg ModerateCountdownMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := authorizeModerator(ctx, h.auth, conf); err != nil {
+		return nil, nil, err
+	}
+
+	var cd Countdown
+	if err := h.b.One(store, msg.CountdownID, &cd); err != nil {
+		return nil, nil, errors.Wrapf(err, "cannot retrieve countdown with ID %s", msg.CountdownID)
+	}
+
+	switch {
+	case msg.Action == ModerationAction_Hide && cd.HiddenAt != 0:
+		return nil, nil, errors.Wrapf(errors.ErrState, "countdown with ID %s is already hidden", cd.ID)
+	case msg.Action == ModerationAction_Show && cd.HiddenAt == 0:
+		return nil, nil, errors.Wrapf(errors.ErrState, "countdown with ID %s is not hidden", cd.ID)
+	}
+
+	return &msg, &cd, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h ModerateCountdownHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver applies the moderation action. Any decision other than showing a
+// hidden countdown again means the pending flags were reviewed, so they are
+// cleared.
+func (h ModerateCountdownHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, cd, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Action != ModerationAction_Show {
+		if err := deleteFlags(store, h.flags, cd.ID); err != nil {
+			return nil, err
+		}
+		cd.FlagCount = 0
+	}
+
+	switch msg.Action {
+	case ModerationAction_Hide:
+		now, err := weave.BlockTime(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "no block time in header")
+		}
+		cd.HiddenAt = weave.AsUnixTime(now)
+	case ModerationAction_Show:
+		cd.HiddenAt = 0
+	case ModerationAction_Remove:
+		now, err := weave.BlockTime(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "no block time in header")
+		}
+		if err := deleteCountdown(store, h.scheduler, h.ctrl, h.b, cd, now); err != nil {
+			return nil, err
+		}
+		return moderationResult(cd, msg), nil
+	}
+
+	if err := h.b.Put(store, cd); err != nil {
+		return nil, errors.Wrapf(err, "cannot moderate countdown with ID %s", cd.ID)
+	}
+
+	return moderationResult(cd, msg), nil
+}
+
+// moderationResult tags the moderation decision so that it can be audited.
+func moderationResult(cd *Countdown, msg *ModerateCountdownMsg) *weave.DeliverResult {
+	return &weave.DeliverResult{
+		Tags: []common.KVPair{
+			{Key: []byte("countdown.moderate.countdown"), Value: []byte(strings.ToUpper(hex.EncodeToString(cd.ID)))},
+			{Key: []byte("countdown.moderate.action"), Value: []byte(msg.Action.String())},
+		},
+	}
+}
+
+// deleteFlags deletes all flags of the countdown with the given ID.
+func deleteFlags(store weave.KVStore, flags *FlagBucket, countdownID []byte) error {
+	var all []*Flag
+	if err := flags.ByIndex(store, "countdown", countdownID, &all); err != nil {
+		return errors.Wrapf(err, "cannot retrieve flags of countdown with ID %s", countdownID)
+	}
+	for _, f := range all {
+		if err := flags.Delete(store, f.ID); err != nil {
+			return errors.Wrapf(err, "cannot delete flag of %s", f.Reporter)
+		}
+	}
+	return nil
+}
+
+// ------------------- ForkCountdownHandler -------------------
+
+// ForkCountdownHandler will handle ForkCountdownMsg
+type ForkCountdownHandler struct {
+	auth      x.Authenticator
+	b         *CountdownBucket
+	scheduler weave.Scheduler
+	ctrl      cash.Controller
+}
+
+var _ weave.Handler = ForkCountdownHandler{}
+
+// NewForkCountdownHandler creates a fork countdown message handler
+func NewForkCountdownHandler(auth x.Authenticator, scheduler weave.Scheduler, ctrl cash.Controller) weave.Handler {
+	return ForkCountdownHandler{
+		auth:      auth,
+		b:         NewCountdownBucket(),
+		scheduler: scheduler,
+		ctrl:      ctrl,
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver. Only
+// revealed lines of the source can be forked, so that a fork reveals nothing
+// ahead of the source schedule.
+func (h ForkCountdownHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*ForkCountdownMsg, *Countdown, error) {
+	var msg ForkCountdownMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "no block time in header")
+	}
+	now := weave.AsUnixTime(blockTime)
+
+	var source Countdown
+	if err := h.b.One(store, msg.SourceID, &source); err != nil {
+		return nil, nil, errors.Wrapf(err, "cannot retrieve countdown with ID %s", msg.SourceID)
+	}
+	if source.HiddenAt != 0 {
+		return nil, nil, errors.Wrapf(errors.ErrState, "countdown with ID %s is hidden", source.ID)
+	}
+	sourceLines, err := source.Lines()
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "countdown with ID %s", source.ID)
+	}
+
+	selected := msg.Lines
+	if len(selected) == 0 {
+		for i := int32(0); i < source.RevealedCount; i++ {
+			selected = append(selected, i)
+		}
+	}
+	if len(selected) == 0 {
+		return nil, nil, errors.Field("Lines", errors.ErrEmpty, "no revealed line to fork")
+	}
+
+	lines := make([]string, 0, len(selected))
+	var attachments []*Attachment
+	for _, line := range selected {
+		if line >= source.RevealedCount {
+			return nil, nil, errors.Field("Lines", errors.ErrInput, "line "+strconv.Itoa(int(line))+" is not revealed")
+		}
+		if a := source.LineAttachment(int(line)); a != nil {
+			attachments = append(attachments, &Attachment{
+				Line:     int32(len(lines)),
+				Hash:     copyBytes(a.Hash),
+				MimeType: a.MimeType,
+			})
+		}
+		lines = append(lines, sourceLines[line])
+	}
+	for i, e := range msg.Edits {
+		if int(e.Line) >= len(lines) {
+			return nil, nil, errors.Field("Edits."+strconv.Itoa(i), errors.ErrInput, "line is not selected")
+		}
+		lines[e.Line] = e.Text
+	}
+
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := conf.validateLines(lines); err != nil {
+		return nil, nil, err
+	}
+	raw, err := json.Marshal(lines)
+	if err != nil {
+		return nil, nil, errors.Wrap(errors.ErrHuman, "cannot marshal lyrics")
+	}
+
+	owner, err := countdownOwner(ctx, h.auth, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := checkQuota(store, h.b, conf, owner, blockTime); err != nil {
+		return nil, nil, err
+	}
+
+	cd := &Countdown{
+		Metadata:           &weave.Metadata{Schema: countdownSchema},
+		Owner:              owner,
+		Title:              msg.Title,
+		CreatedAt:          now,
+		MissedRevealPolicy: msg.MissedRevealPolicy,
+		ScheduleStart:      now,
+		Attachments:        attachments,
+		SourceID:           source.ID,
+		SourceOwner:        source.Owner,
+	}
+	if err := cd.SetLyrics(raw); err != nil {
+		return nil, nil, err
+	}
+
+	return &msg, cd, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h ForkCountdownHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, cd, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := cd.LyricsJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{GasAllocated: conf.countdownCost(raw)}, nil
+}
+
+// Deliver stores the fork and starts its schedule like a newly created
+// countdown
+func (h ForkCountdownHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, cd, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := startCountdown(store, h.scheduler, h.ctrl, h.b, cd); err != nil {
+		return nil, err
+	}
+
+	// Returns generated countdown ID as response
+	return &weave.DeliverResult{Data: cd.ID}, nil
+}
+
+// ------------------- SetSuccessorHandler -------------------
+
+// SetSuccessorHandler will handle SetSuccessorMsg
+type SetSuccessorHandler struct {
+	auth      x.Authenticator
+	b         *CountdownBucket
+	scheduler weave.Scheduler
+}
+
+var _ weave.Handler = SetSuccessorHandler{}
+
+// NewSetSuccessorHandler creates a set successor message handler
+func NewSetSuccessorHandler(auth x.Authenticator, scheduler weave.Scheduler) weave.Handler {
+	return SetSuccessorHandler{
+		auth:      auth,
+		b:         NewCountdownBucket(),
+		scheduler: scheduler,
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver. The
+// returned successor is nil if the message removes the successor.
+func (h SetSuccessorHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*SetSuccessorMsg, *Countdown, *Countdown, error) {
+	var msg SetSuccessorMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var cd Countdown
+	if err := h.b.One(store, msg.CountdownID, &cd); err != nil {
+		return nil, nil, nil, errors.Wrapf(err, "cannot retrieve countdown with ID %s", msg.CountdownID)
+	}
+	if err := authorize(ctx, h.auth, &cd, EditorRole_Manager); err != nil {
+		return nil, nil, nil, err
+	}
+	if cd.CompletedAt != 0 {
+		return nil, nil, nil, errors.Wrapf(errors.ErrState, "countdown with ID %s is completed", cd.ID)
+	}
+
+	if len(msg.SuccessorID) == 0 {
+		if len(cd.SuccessorID) == 0 {
+			return nil, nil, nil, errors.Wrapf(errors.ErrState, "countdown with ID %s has no successor", cd.ID)
+		}
+		return &msg, &cd, nil, nil
+	}
+	if cd.RepeatPolicy == RepeatPolicy_Forever {
+		return nil, nil, nil, errors.Wrapf(errors.ErrState, "countdown with ID %s repeats forever", cd.ID)
+	}
+	if len(cd.SuccessorID) != 0 {
+		return nil, nil, nil, errors.Wrapf(errors.ErrState, "countdown with ID %s already has a successor", cd.ID)
+	}
+
+	var successor Countdown
+	if err := h.b.One(store, msg.SuccessorID, &successor); err != nil {
+		return nil, nil, nil, errors.Wrapf(err, "cannot retrieve countdown with ID %s", msg.SuccessorID)
+	}
+	if err := authorize(ctx, h.auth, &successor, EditorRole_Manager); err != nil {
+		return nil, nil, nil, err
+	}
+	switch {
+	case successor.WaitsForPredecessor():
+		return nil, nil, nil, errors.Wrapf(errors.ErrState, "countdown with ID %s already has a predecessor", successor.ID)
+	case successor.RevealedCount != 0, successor.CompletedAt != 0:
+		return nil, nil, nil, errors.Wrapf(errors.ErrState, "countdown with ID %s already started", successor.ID)
+	case successor.PausedAt != 0:
+		return nil, nil, nil, errors.Wrapf(errors.ErrState, "countdown with ID %s is paused", successor.ID)
+	}
+
+	// the chain following the successor must not lead back to the countdown
+	for id := successor.SuccessorID; len(id) != 0; {
+		if bytes.Equal(id, cd.ID) {
+			return nil, nil, nil, errors.Field("SuccessorID", errors.ErrInput, "successor chain cannot be circular")
+		}
+		var next Countdown
+		if err := h.b.One(store, id, &next); err != nil {
+			if errors.ErrNotFound.Is(err) {
+				break
+			}
+			return nil, nil, nil, errors.Wrapf(err, "cannot retrieve countdown with ID %s", id)
+		}
+		id = next.SuccessorID
+	}
+
+	return &msg, &cd, &successor, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h SetSuccessorHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver cancels the pending first reveal of the successor, which waits for
+// the countdown to complete from now on. A removed successor starts right
+// away.
+func (h SetSuccessorHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, cd, successor, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if successor == nil {
+		now, err := weave.BlockTime(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "no block time in header")
+		}
+		if err := releaseSuccessor(store, h.scheduler, h.b, cd, now); err != nil {
+			return nil, err
+		}
+		cd.SuccessorID = nil
+	} else {
+		if err := cancelReveal(store, h.scheduler, successor); err != nil {
+			return nil, err
+		}
+		successor.PredecessorID = cd.ID
+		cd.SuccessorID = successor.ID
+		if err := h.b.Put(store, successor); err != nil {
+			return nil, errors.Wrapf(err, "cannot update countdown with ID %s", successor.ID)
+		}
+	}
+
+	if err := h.b.Put(store, cd); err != nil {
+		return nil, errors.Wrapf(err, "cannot update countdown with ID %s", cd.ID)
+	}
+
+	return &weave.DeliverResult{}, nil
+}
+
+// releaseSuccessor starts the schedule of the countdown waiting for cd, if
+// any. Its first line is revealed one reveal interval after now.
+func releaseSuccessor(store weave.KVStore, scheduler weave.Scheduler, b *CountdownBucket, cd *Countdown, now time.Time) error {
+	if len(cd.SuccessorID) == 0 {
+		return nil
+	}
+	var successor Countdown
+	if err := b.One(store, cd.SuccessorID, &successor); err != nil {
+		if errors.ErrNotFound.Is(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "cannot retrieve countdown with ID %s", cd.SuccessorID)
+	}
+	if !bytes.Equal(successor.PredecessorID, cd.ID) {
+		return nil
+	}
+
+	conf, err := loadConf(store)
+	if err != nil {
+		return err
+	}
+	successor.PredecessorID = nil
+	successor.ScheduleStart = weave.AsUnixTime(now)
+	if err := scheduleReveal(store, scheduler, &successor, now.Add(conf.RevealInterval.Duration())); err != nil {
+		return err
+	}
+	if err := b.Put(store, &successor); err != nil {
+		return errors.Wrapf(err, "cannot start countdown with ID %s", successor.ID)
+	}
+	return nil
+}
+
+// ------------------- CreateSeriesHandler -------------------
+
+// CreateSeriesHandler will handle CreateSeriesMsg
+type CreateSeriesHandler struct {
+	auth   x.Authenticator
+	b      *CountdownBucket
+	series *SeriesBucket
+}
+
+var _ weave.Handler = CreateSeriesHandler{}
+
+// NewCreateSeriesHandler creates a series message handler
+func NewCreateSeriesHandler(auth x.Authenticator) weave.Handler {
+	return CreateSeriesHandler{
+		auth:   auth,
+		b:      NewCountdownBucket(),
+		series: NewSeriesBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h CreateSeriesHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*CreateSeriesMsg, *Series, error) {
+	var msg CreateSeriesMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "no block time in header")
+	}
+
+	owner, err := countdownOwner(ctx, h.auth, msg.Owner)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, id := range msg.CountdownIDs {
+		var cd Countdown
+		if err := h.b.One(store, id, &cd); err != nil {
+			return nil, nil, errors.Wrapf(err, "cannot retrieve countdown with ID %s", id)
+		}
+	}
+
+	series := &Series{
+		Metadata:     msg.Metadata,
+		Owner:        owner,
+		Title:        msg.Title,
+		CountdownIDs: msg.CountdownIDs,
+		CreatedAt:    weave.AsUnixTime(blockTime),
+	}
+
+	return &msg, series, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h CreateSeriesHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver stores the series and returns its ID
+func (h CreateSeriesHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, series, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.series.Put(store, series); err != nil {
+		return nil, errors.Wrap(err, "cannot store series")
+	}
+
+	return &weave.DeliverResult{Data: series.ID}, nil
+}
+
+// ------------------- AddSeriesCountdownHandler -------------------
+
+// AddSeriesCountdownHandler will handle AddSeriesCountdownMsg
+type AddSeriesCountdownHandler struct {
+	auth   x.Authenticator
+	b      *CountdownBucket
+	series *SeriesBucket
+}
+
+var _ weave.Handler = AddSeriesCountdownHandler{}
+
+// NewAddSeriesCountdownHandler creates an add series countdown message handler
+func NewAddSeriesCountdownHandler(auth x.Authenticator) weave.Handler {
+	return AddSeriesCountdownHandler{
+		auth:   auth,
+		b:      NewCountdownBucket(),
+		series: NewSeriesBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h AddSeriesCountdownHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*AddSeriesCountdownMsg, *Series, error) {
+	var msg AddSeriesCountdownMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	series, err := loadOwnSeries(ctx, h.auth, store, h.series, msg.SeriesID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if series.Index(msg.CountdownID) >= 0 {
+		return nil, nil, errors.Wrapf(errors.ErrDuplicate, "countdown with ID %s is already part of the series", msg.CountdownID)
+	}
+	if len(series.CountdownIDs) >= maxSeriesCountdowns {
+		return nil, nil, errors.Wrapf(errors.ErrState, "series with ID %s is full", series.ID)
+	}
+
+	var cd Countdown
+	if err := h.b.One(store, msg.CountdownID, &cd); err != nil {
+		return nil, nil, errors.Wrapf(err, "cannot retrieve countdown with ID %s", msg.CountdownID)
+	}
+
+	return &msg, series, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h AddSeriesCountdownHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver inserts the countdown at the requested position
+func (h AddSeriesCountdownHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, series, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	pos := int(msg.Position)
+	if pos > len(series.CountdownIDs) {
+		pos = len(series.CountdownIDs)
+	}
+	ids := make([][]byte, 0, len(series.CountdownIDs)+1)
+	ids = append(ids, series.CountdownIDs[:pos]...)
+	ids = append(ids, msg.CountdownID)
+	series.CountdownIDs = append(ids, series.CountdownIDs[pos:]...)
+
+	if err := h.series.Put(store, series); err != nil {
+		return nil, errors.Wrapf(err, "cannot update series with ID %s", series.ID)
+	}
+
+	return &weave.DeliverResult{}, nil
+}
+
+// ------------------- RemoveSeriesCountdownHandler -------------------
+
+// RemoveSeriesCountdownHandler will handle RemoveSeriesCountdownMsg
+type RemoveSeriesCountdownHandler struct {
+	auth   x.Authenticator
+	series *SeriesBucket
+}
+
+var _ weave.Handler = RemoveSeriesCountdownHandler{}
+
+// NewRemoveSeriesCountdownHandler creates a remove series countdown message
+// handler
+func NewRemoveSeriesCountdownHandler(auth x.Authenticator) weave.Handler {
+	return RemoveSeriesCountdownHandler{
+		auth:   auth,
+		series: NewSeriesBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h RemoveSeriesCountdownHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*RemoveSeriesCountdownMsg, *Series, error) {
+	var msg RemoveSeriesCountdownMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	series, err := loadOwnSeries(ctx, h.auth, store, h.series, msg.SeriesID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if series.Index(msg.CountdownID) < 0 {
+		return nil, nil, errors.Wrapf(errors.ErrNotFound, "countdown with ID %s is not part of the series", msg.CountdownID)
+	}
+
+	return &msg, series, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h RemoveSeriesCountdownHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver removes the countdown from the series, the following members move
+// forward
+func (h RemoveSeriesCountdownHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, series, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	removeSeriesCountdown(series, msg.CountdownID)
+	if err := h.series.Put(store, series); err != nil {
+		return nil, errors.Wrapf(err, "cannot update series with ID %s", series.ID)
+	}
+
+	return &weave.DeliverResult{}, nil
+}
+
+// ------------------- ReorderSeriesHandler -------------------
+
+// ReorderSeriesHandler will handle ReorderSeriesMsg
+type ReorderSeriesHandler struct {
+	auth   x.Authenticator
+	series *SeriesBucket
+}
+
+var _ weave.Handler = ReorderSeriesHandler{}
+
+// NewReorderSeriesHandler creates a reorder series message handler
+func NewReorderSeriesHandler(auth x.Authenticator) weave.Handler {
+	return ReorderSeriesHandler{
+		auth:   auth,
+		series: NewSeriesBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h ReorderSeriesHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*ReorderSeriesMsg, *Series, error) {
+	var msg ReorderSeriesMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	series, err := loadOwnSeries(ctx, h.auth, store, h.series, msg.SeriesID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the message lists each ID once, so the same number of known IDs
+	// means the same members
+	if len(msg.CountdownIDs) != len(series.CountdownIDs) {
+		return nil, nil, errors.Field("CountdownIDs", errors.ErrInput, "must list all members of the series")
+	}
+	for _, id := range msg.CountdownIDs {
+		if series.Index(id) < 0 {
+			return nil, nil, errors.Field("CountdownIDs", errors.ErrInput, "must list only members of the series")
+		}
+	}
+
+	return &msg, series, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h ReorderSeriesHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver stores the new order of the series
+func (h ReorderSeriesHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, series, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	series.CountdownIDs = msg.CountdownIDs
+	if err := h.series.Put(store, series); err != nil {
+		return nil, errors.Wrapf(err, "cannot update series with ID %s", series.ID)
+	}
+
+	return &weave.DeliverResult{}, nil
+}
+
+// loadOwnSeries returns the series with the given ID if its owner authorized
+// the transaction.
+func loadOwnSeries(ctx weave.Context, auth x.Authenticator, store weave.KVStore, b *SeriesBucket, id []byte) (*Series, error) {
+	var series Series
+	if err := b.One(store, id, &series); err != nil {
+		return nil, errors.Wrapf(err, "cannot retrieve series with ID %s", id)
+	}
+	if !auth.HasAddress(ctx, series.Owner) {
+		return nil, errors.Wrapf(errors.ErrUnauthorized, "owner of series with ID %s did not authorize the transaction", id)
+	}
+	return &series, nil
+}
+
+// removeSeriesCountdown removes a countdown from the members of a series. The
+// series must be stored afterwards.
+func removeSeriesCountdown(series *Series, countdownID []byte) {
+	i := series.Index(countdownID)
+	if i < 0 {
+		return
+	}
+	ids := make([][]byte, 0, len(series.CountdownIDs)-1)
+	ids = append(ids, series.CountdownIDs[:i]...)
+	series.CountdownIDs = append(ids, series.CountdownIDs[i+1:]...)
+}
+
+// ------------------- MigrateCountdownsHandler -------------------
+
+// MigrateCountdownsHandler will handle MigrateCountdownsMsg
+type MigrateCountdownsHandler struct {
+	auth x.Authenticator
+	b    *CountdownBucket
+}
+
+var _ weave.Handler = MigrateCountdownsHandler{}
+
+// NewMigrateCountdownsHandler creates a migrate countdowns message handler
+func NewMigrateCountdownsHandler(auth x.Authenticator) weave.Handler {
+	return MigrateCountdownsHandler{
+		auth: auth,
+		b:    NewCountdownBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h MigrateCountdownsHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*MigrateCountdownsMsg, error) {
+	var msg MigrateCountdownsMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeMigration(ctx, h.auth, store, conf); err != nil {
+		return nil, err
+	}
+
+	return &msg, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h MigrateCountdownsHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver stores the countdowns with IDs from StartID on again. Loading
+// migrates them to the current schema and storing them writes the entries of
+// indexes added after they were last stored. IDs without a countdown are
+// skipped.
+func (h MigrateCountdownsHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	start := binary.BigEndian.Uint64(msg.StartID)
+	for i := uint64(0); i < uint64(msg.Limit); i++ {
+		id := make([]byte, 8)
+		binary.BigEndian.PutUint64(id, start+i)
+
+		var cd Countdown
+		if err := h.b.One(store, id, &cd); err != nil {
+			if errors.ErrNotFound.Is(err) {
+				continue
+			}
+			return nil, errors.Wrapf(err, "cannot retrieve countdown with ID %s", id)
+		}
+		if err := h.b.Put(store, &cd); err != nil {
+			return nil, errors.Wrapf(err, "cannot migrate countdown with ID %s", id)
+		}
+	}
+
+	return &weave.DeliverResult{}, nil
+}
+
+// ------------------- CronAddLyricsHandler -------------------
+
+// CronAddLyricsHandler will handle scheduled CountdownTask
+type CronAddLyricsHandler struct {
+	auth      x.Authenticator
+	b         *CountdownBucket
+	scheduler weave.Scheduler
+	ctrl      cash.Controller
+}
+
+var _ weave.Handler = CronAddLyricsHandler{}
+
+// NewCronAddLyricsHandler creates a countdown task handler
+func NewCronAddLyricsHandler(auth x.Authenticator, scheduler weave.Scheduler, ctrl cash.Controller) weave.Handler {
+	return CronAddLyricsHandler{
+		auth:      auth,
+		b:         NewCountdownBucket(),
+		scheduler: scheduler,
+		ctrl:      ctrl,
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h CronAddLyricsHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*CountdownTask, *Countdown, error) {
+	var msg CountdownTask
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var cd Countdown
+	if err := h.b.One(store, msg.CountdownID, &cd); err != nil {
+		return nil, nil, errors.Wrapf(err, "cannot retrieve countdown with ID %s", msg.CountdownID)
+	}
+
+	return &msg, &cd, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h CronAddLyricsHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver reveals the lyrics lines that are due and schedules the next reveal.
+// If the chain was down when a reveal was due, the countdown's
+// MissedRevealPolicy decides whether all missed lines are revealed at once or
+// the remaining schedule is shifted.
+func (h CronAddLyricsHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, cd, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// a paused countdown has no pending task, this one is a leftover
+	if cd.PausedAt != 0 {
+		return &weave.DeliverResult{}, nil
+	}
+	// the task that is being executed is no longer pending
+	cd.TaskID = nil
+	cd.NextRevealAt = 0
+
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "no block time in header")
+	}
+	height, ok := weave.GetHeight(ctx)
+	if !ok {
+		return nil, errors.Wrap(errors.ErrHuman, "no block height in context")
+	}
+
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, err
+	}
+	interval := conf.RevealInterval.Duration()
+
+	// the task scheduled when a repeating countdown completed starts its
+	// next iteration
+	if cd.CompletedAt != 0 && cd.CanRepeat() {
+		cd.Restart(cd.CompletedAt)
+	}
+
+	lyrics, err := cd.Lines()
+	if err != nil {
+		return nil, errors.Wrapf(err, "countdown with ID %s", cd.ID)
+	}
+	revealed := int(cd.RevealedCount)
+
+	if revealed < len(lyrics) {
+		due := dueReveals(cd, revealed, len(lyrics), now, interval)
+		for ; revealed < due; revealed++ {
+			cd.Reveals = append(cd.Reveals, &Reveal{
+				Line:       int32(revealed),
+				Height:     height,
+				RevealedAt: weave.AsUnixTime(now),
+			})
+		}
+		cd.RevealedCount = int32(revealed)
+	}
+
+	if revealed < len(lyrics) {
+		// schedule next task to be executed
+		if err := scheduleReveal(store, h.scheduler, cd, nextRevealAt(cd, revealed, now, interval)); err != nil {
+			return nil, err
+		}
+		cd.UnlockedRevealAt = 0
+	} else if cd.CompletedAt == 0 {
+		// the countdown has reached its final line and is marked completed
+		cd.CompletedAt = weave.AsUnixTime(now)
+		if err := releaseBounty(store, h.ctrl, cd, cd.Beneficiary); err != nil {
+			return nil, err
+		}
+		if cd.CanRepeat() {
+			// the next iteration reveals its first line one interval later
+			if err := scheduleReveal(store, h.scheduler, cd, now.Add(interval)); err != nil {
+				return nil, err
+			}
+		} else if err := releaseSuccessor(store, h.scheduler, h.b, cd, now); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := h.b.Put(store, cd); err != nil {
+		return nil, errors.Wrapf(err, "cannot add lyrics to countdown with ID %s", cd.ID)
+	}
+
+	return &weave.DeliverResult{}, nil
+}
+
+// ------------------- CronExpireCountdownHandler -------------------
+
+// CronExpireCountdownHandler will handle scheduled ExpireCountdownTask
+type CronExpireCountdownHandler struct {
+	auth      x.Authenticator
+	b         *CountdownBucket
+	scheduler weave.Scheduler
+	ctrl      cash.Controller
+}
+
+var _ weave.Handler = CronExpireCountdownHandler{}
+
+// NewCronExpireCountdownHandler creates a countdown expiration task handler
+func NewCronExpireCountdownHandler(auth x.Authenticator, scheduler weave.Scheduler, ctrl cash.Controller) weave.Handler {
+	return CronExpireCountdownHandler{
+		auth:      auth,
+		b:         NewCountdownBucket(),
+		scheduler: scheduler,
+		ctrl:      ctrl,
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver. The
+// returned countdown is nil if it was deleted before its expiration.
+func (h CronExpireCountdownHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*ExpireCountdownTask, *Countdown, error) {
+	var msg ExpireCountdownTask
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var cd Countdown
+	if err := h.b.One(store, msg.CountdownID, &cd); err != nil {
+		if errors.ErrNotFound.Is(err) {
+			return &msg, nil, nil
+		}
+		return nil, nil, errors.Wrapf(err, "cannot retrieve countdown with ID %s", msg.CountdownID)
+	}
+
+	return &msg, &cd, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h CronExpireCountdownHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver deletes the expired countdown and refunds its deposit
+func (h CronExpireCountdownHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, cd, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the countdown was already deleted
+	if cd == nil {
+		return &weave.DeliverResult{}, nil
+	}
+
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "no block time in header")
+	}
+	if err := deleteCountdown(store, h.scheduler, h.ctrl, h.b, cd, now); err != nil {
+		return nil, err
+	}
+
+	return &weave.DeliverResult{}, nil
+}
+
+// dueReveals returns the number of lines that must be revealed after a reveal
+// executed at the given time. At least one new line is revealed. With the
+// catch up policy, all lines that were due since the schedule start are
+// revealed as well.
+func dueReveals(cd *Countdown, revealed, total int, now time.Time, interval time.Duration) int {
+	due := revealed + 1
+	if cd.MissedRevealPolicy == MissedRevealPolicy_CatchUp {
+		if n := int(now.Sub(cd.ScheduleStart.Time()) / interval); n > due {
+			due = n
+		}
+	}
+	if due > total {
+		due = total
+	}
+	return due
+}
+
+// nextRevealAt returns the time of the reveal that follows the given number
+// of revealed lines. The catch up policy keeps the schedule anchored to the
+// schedule start, while the shift policy counts from the last reveal, or from
+// its scheduled time if it was unlocked early.
+func nextRevealAt(cd *Countdown, revealed int, now time.Time, interval time.Duration) time.Time {
+	if cd.MissedRevealPolicy == MissedRevealPolicy_CatchUp {
+		next := cd.ScheduleStart.Time().Add(time.Duration(revealed+1) * interval)
+		if next.After(now) {
+			return next
+		}
+	}
+	// a line unlocked early does not move the free schedule forward
+	if unlocked := cd.UnlockedRevealAt.Time(); cd.UnlockedRevealAt != 0 && unlocked.After(now) {
+		return unlocked.Add(interval)
+	}
+	return now.Add(interval)
+}
+
+// scheduleReveal schedules the task revealing the next line of the countdown
+// and keeps track of its ID. The countdown must be stored afterwards.
+func scheduleReveal(store weave.KVStore, scheduler weave.Scheduler, cd *Countdown, runAt time.Time) error {
+	task := &CountdownTask{
+		Metadata:    &weave.Metadata{Schema: 1},
+		CountdownID: cd.ID,
+		TaskOwner:   cd.Owner,
+	}
+	id, err := scheduler.Schedule(store, runAt, nil, task)
+	if err != nil {
+		return errors.Wrap(err, "could not schedule task")
+	}
+	cd.TaskID = id
+	cd.NextRevealAt = weave.AsUnixTime(runAt)
+	return nil
+}
+
+// cancelReveal deletes the pending reveal task of the countdown, if any.
+// The countdown must be stored afterwards.
+func cancelReveal(store weave.KVStore, scheduler weave.Scheduler, cd *Countdown) error {
+	if len(cd.TaskID) == 0 {
+		return nil
+	}
+	if err := scheduler.Delete(store, cd.TaskID); err != nil && !errors.ErrNotFound.Is(err) {
+		return errors.Wrap(err, "could not delete task")
+	}
+	cd.TaskID = nil
+	cd.NextRevealAt = 0
+	return nil
+}
+
+// setOwner hands the countdown over to a new owner. The new owner loses any
+// editor role it had and the pending reveal task, which is executed on behalf
+// of the owner, is rescheduled at the same time with the new task owner.
+// The countdown must be stored afterwards.
+func setOwner(store weave.KVStore, scheduler weave.Scheduler, cd *Countdown, owner weave.Address) error {
+	cd.Owner = owner
+	cd.PendingOwner = nil
+
+	editors := cd.Editors[:0]
+	for _, e := range cd.Editors {
+		if !e.Address.Equals(owner) {
+			editors = append(editors, e)
+		}
+	}
+	cd.Editors = editors
+
+	if len(cd.TaskID) == 0 {
+		return nil
+	}
+	runAt := cd.NextRevealAt.Time()
+	if err := cancelReveal(store, scheduler, cd); err != nil {
+		return err
+	}
+	return scheduleReveal(store, scheduler, cd, runAt)
+}
+
+// startCountdown stores a new countdown, locks its bounty, schedules its first
+// reveal and its expiration, takes its storage deposit from the owner and
+// records its creation in the creation log of the owner.
+func startCountdown(store weave.KVStore, scheduler weave.Scheduler, ctrl cash.Controller, b *CountdownBucket, cd *Countdown) error {
+	// the countdown must be stored first so that it gets an ID
+	if err := b.Put(store, cd); err != nil {
+		return errors.Wrap(err, "cannot store countdown")
+	}
+
+	// lock the bounty until the countdown is completed
+	for _, c := range cd.Bounty {
+		if err := ctrl.MoveCoins(store, cd.Sponsor, BountyAddress(cd.ID), *c); err != nil {
+			return errors.Wrap(err, "cannot lock bounty")
+		}
+	}
+
+	conf, err := loadConf(store)
+	if err != nil {
+		return err
+	}
+
+	// schedule first task to be executed for this countdown
+	if err := scheduleReveal(store, scheduler, cd, cd.ScheduleStart.Time().Add(conf.RevealInterval.Duration())); err != nil {
+		return err
+	}
+
+	if cd.DeleteAt != 0 {
+		task := &ExpireCountdownTask{
+			Metadata:    &weave.Metadata{Schema: 1},
+			CountdownID: cd.ID,
+		}
+		if _, err := scheduler.Schedule(store, cd.DeleteAt.Time(), nil, task); err != nil {
+			return errors.Wrap(err, "could not schedule expiration")
+		}
+	}
+
+	// the owner pays the storage deposit of the countdown
+	deposit, err := takeDeposit(store, ctrl, cd.Owner, conf.CountdownDeposit, cd.Size())
+	if err != nil {
+		return err
+	}
+	if deposit != nil {
+		cd.Deposit = deposit
+		cd.Depositor = cd.Owner
+	}
+
+	if err := recordCreation(store, conf, cd.Owner, cd.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := b.Put(store, cd); err != nil {
+		return errors.Wrap(err, "cannot store countdown")
+	}
+	return nil
+}
+
+// countdownOwner returns the owner of a new countdown. It defaults to the main
+// signer, any other owner must authorize the transaction.
+func countdownOwner(ctx weave.Context, auth x.Authenticator, owner weave.Address) (weave.Address, error) {
+	if len(owner) == 0 {
+		signer := x.MainSigner(ctx, auth)
+		if signer == nil {
+			return nil, errors.Field("Owner", errors.ErrEmpty, "no signer")
+		}
+		return signer.Address(), nil
+	}
+	if !auth.HasAddress(ctx, owner) {
+		return nil, errors.Wrapf(errors.ErrUnauthorized, "owner %s did not authorize the creation", owner)
+	}
+	return owner, nil
+}
+
+// bountySponsor returns the address a bounty is taken from, nil if there is no
+// bounty. It defaults to the main signer, any other sponsor must authorize the
+// transaction.
+func bountySponsor(ctx weave.Context, auth x.Authenticator, bounty []*coin.Coin, sponsor weave.Address) (weave.Address, error) {
+	if len(bounty) == 0 {
+		return nil, nil
+	}
+	if len(sponsor) == 0 {
+		signer := x.MainSigner(ctx, auth)
+		if signer == nil {
+			return nil, errors.Field("Sponsor", errors.ErrEmpty, "no signer")
+		}
+		return signer.Address(), nil
+	}
+	if !auth.HasAddress(ctx, sponsor) {
+		return nil, errors.Wrapf(errors.ErrUnauthorized, "sponsor %s did not authorize the bounty", sponsor)
+	}
+	return sponsor, nil
+}
+
+// deleteCountdown cancels the pending reveal of the countdown, refunds its
+// deposit and the bounty that was not paid out yet, removes it from its series
+// and its successor chain and deletes it. A successor waiting for the deleted
+// countdown starts at now.
+func deleteCountdown(store weave.KVStore, scheduler weave.Scheduler, ctrl cash.Controller, b *CountdownBucket, cd *Countdown, now time.Time) error {
+	if err := cancelReveal(store, scheduler, cd); err != nil {
+		return err
+	}
+
+	// a bounty that was not paid out yet is refunded to the sponsor
+	if cd.CompletedAt == 0 {
+		if err := releaseBounty(store, ctrl, cd, cd.Sponsor); err != nil {
+			return err
+		}
+	}
+
+	if err := refundDeposit(store, ctrl, cd.Depositor, cd.Deposit); err != nil {
+		return err
+	}
+
+	// a deleted countdown leaves every series it was part of
+	seriesBucket := NewSeriesBucket()
+	var series []*Series
+	if err := seriesBucket.ByIndex(store, "countdown", cd.ID, &series); err != nil {
+		return errors.Wrapf(err, "cannot retrieve series of countdown with ID %s", cd.ID)
+	}
+	for _, s := range series {
+		removeSeriesCountdown(s, cd.ID)
+		if err := seriesBucket.Put(store, s); err != nil {
+			return errors.Wrapf(err, "cannot update series with ID %s", s.ID)
+		}
+	}
+
+	if err := releaseSuccessor(store, scheduler, b, cd, now); err != nil {
+		return err
+	}
+	if len(cd.PredecessorID) != 0 {
+		var predecessor Countdown
+		switch err := b.One(store, cd.PredecessorID, &predecessor); {
+		case errors.ErrNotFound.Is(err):
+		case err != nil:
+			return errors.Wrapf(err, "cannot retrieve countdown with ID %s", cd.PredecessorID)
+		case bytes.Equal(predecessor.SuccessorID, cd.ID):
+			predecessor.SuccessorID = nil
+			if err := b.Put(store, &predecessor); err != nil {
+				return errors.Wrapf(err, "cannot update countdown with ID %s", predecessor.ID)
+			}
+		}
+	}
+
+	if err := b.Delete(store, cd.ID); err != nil {
+		return errors.Wrapf(err, "cannot delete countdown with ID %s", cd.ID)
+	}
+	return nil
+}
+
+// takeDeposit moves the storage deposit of an object with the given size
+// from the payer to the deposit address. It returns nil if no deposit is
+// required.
+func takeDeposit(store weave.KVStore, ctrl cash.Controller, payer weave.Address, rate coin.Coin, size int) (*coin.Coin, error) {
+	deposit, err := depositFor(rate, size)
+	if err != nil {
+		return nil, err
+	}
+	if deposit.IsZero() {
+		return nil, nil
+	}
+	if err := ctrl.MoveCoins(store, payer, DepositAddress, deposit); err != nil {
+		return nil, errors.Wrap(err, "cannot take storage deposit")
+	}
+	return &deposit, nil
+}
+
+// refundDeposit moves a storage deposit back to the address that paid it.
+func refundDeposit(store weave.KVStore, ctrl cash.Controller, depositor weave.Address, deposit *coin.Coin) error {
+	if deposit == nil || deposit.IsZero() {
+		return nil
+	}
+	if err := ctrl.MoveCoins(store, DepositAddress, depositor, *deposit); err != nil {
+		return errors.Wrap(err, "cannot refund storage deposit")
+	}
+	return nil
+}
+
+// updateDeposit adjusts a storage deposit to the current size of its object.
+// The difference is taken from the payer when the object grew and refunded to
+// the depositor when it shrank. A payer other than the depositor takes over
+// the whole deposit and the previous depositor is refunded, so that every
+// address gets back what it paid. It returns the new deposit and depositor,
+// both nil if no deposit is required.
+func updateDeposit(store weave.KVStore, ctrl cash.Controller, payer, depositor weave.Address, deposit *coin.Coin, rate coin.Coin, size int) (*coin.Coin, weave.Address, error) {
+	want, err := depositFor(rate, size)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch {
+	case deposit == nil || deposit.IsZero():
+		deposit, err := takeDeposit(store, ctrl, payer, rate, size)
+		if err != nil || deposit == nil {
+			return nil, nil, err
+		}
+		return deposit, payer, nil
+	case want.IsZero():
+		if err := refundDeposit(store, ctrl, depositor, deposit); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, nil
+	case want.SameType(*deposit) && deposit.IsGTE(want):
+		refund, err := deposit.Subtract(want)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "cannot compute deposit refund")
+		}
+		if err := refundDeposit(store, ctrl, depositor, &refund); err != nil {
+			return nil, nil, err
+		}
+		return &want, depositor, nil
+	case want.SameType(*deposit) && depositor.Equals(payer):
+		topUp, err := want.Subtract(*deposit)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "cannot compute deposit top-up")
+		}
+		if err := ctrl.MoveCoins(store, payer, DepositAddress, topUp); err != nil {
+			return nil, nil, errors.Wrap(err, "cannot take storage deposit")
+		}
+		return &want, depositor, nil
+	default:
+		// the payer takes over, also when the deposit rate changed currency
+		if err := ctrl.MoveCoins(store, payer, DepositAddress, want); err != nil {
+			return nil, nil, errors.Wrap(err, "cannot take storage deposit")
+		}
+		if err := refundDeposit(store, ctrl, depositor, deposit); err != nil {
+			return nil, nil, err
+		}
+		return &want, payer, nil
+	}
+}
+
+// releaseBounty moves all funds held by the bounty address of the countdown
+// to the given destination.
+func releaseBounty(store weave.KVStore, ctrl cash.Controller, cd *Countdown, dst weave.Address) error {
+	if len(cd.Bounty) == 0 {
+		return nil
+	}
+	src := BountyAddress(cd.ID)
+	balance, err := ctrl.Balance(store, src)
+	if err != nil {
+		if errors.ErrNotFound.Is(err) {
+			return nil
+		}
+		return errors.Wrap(err, "cannot get bounty balance")
+	}
+	for _, c := range balance {
+		if err := ctrl.MoveCoins(store, src, dst, *c); err != nil {
+			return errors.Wrap(err, "cannot release bounty")
+		}
+	}
+	return nil
+}
+
+// checkQuota returns an error if the owner reached the configured number of
+// countdowns that are not completed yet, or of countdowns created within the
+// creation window. Creations are counted from the creation log of the owner,
+// so that deleting or transferring a countdown does not make room for another
+// one within the same window.
+func checkQuota(store weave.KVStore, b *CountdownBucket, conf *Configuration, owner weave.Address, now time.Time) error {
+	if conf.MaxActiveCountdowns == 0 && conf.MaxCountdownsPerWindow == 0 {
+		return nil
+	}
+
+	var owned []*Countdown
+	if err := b.ByIndex(store, "user", owner, &owned); err != nil {
+		return errors.Wrapf(err, "cannot retrieve countdowns of %s", owner)
+	}
+
+	windowStart := now.Add(-conf.CreationWindow.Duration())
+	var active, recent int32
+	for _, cd := range owned {
+		if cd.CompletedAt == 0 {
+			active++
+		}
+		if cd.CreatedAt.Time().After(windowStart) {
+			recent++
+		}
+	}
+
+	// countdowns created before the creation was limited are not logged
+	log, err := loadCreationLog(store, owner)
+	if err != nil {
+		return err
+	}
+	if logged := int32(len(creationsSince(log.CreatedAt, windowStart))); logged > recent {
+		recent = logged
+	}
+
+	if conf.MaxActiveCountdowns > 0 && active >= conf.MaxActiveCountdowns {
+		return errors.Wrapf(ErrLimit, "%s already has %d active countdowns", owner, active)
+	}
+	if conf.MaxCountdownsPerWindow > 0 && recent >= conf.MaxCountdownsPerWindow {
+		return errors.Wrapf(ErrLimit, "%s already created %d countdowns within %s", owner, recent, conf.CreationWindow.Duration())
+	}
+	return nil
+}
+
+// loadCreationLog returns the creation log of the owner, an empty log if the
+// owner did not create any countdown yet.
+func loadCreationLog(store weave.ReadOnlyKVStore, owner weave.Address) (*CreationLog, error) {
+	log := CreationLog{
+		Metadata: &weave.Metadata{Schema: 1},
+		ID:       owner,
+	}
+	if err := NewCreationLogBucket().One(store, owner, &log); err != nil && !errors.ErrNotFound.Is(err) {
+		return nil, errors.Wrapf(err, "cannot retrieve creation log of %s", owner)
+	}
+	return &log, nil
+}
+
+// recordCreation adds a countdown creation to the creation log of the owner
+// and drops the creations that left the creation window. Nothing is recorded
+// while the number of creations per window is not limited.
+func recordCreation(store weave.KVStore, conf *Configuration, owner weave.Address, createdAt weave.UnixTime) error {
+	if conf.MaxCountdownsPerWindow == 0 {
+		return nil
+	}
+	log, err := loadCreationLog(store, owner)
+	if err != nil {
+		return err
+	}
+	windowStart := createdAt.Time().Add(-conf.CreationWindow.Duration())
+	log.CreatedAt = append(creationsSince(log.CreatedAt, windowStart), createdAt)
+	if err := NewCreationLogBucket().Put(store, log); err != nil {
+		return errors.Wrapf(err, "cannot store creation log of %s", owner)
+	}
+	return nil
+}
+
+// creationsSince returns the creations of a log that happened after the
+// given time. The log is ordered by creation time.
+func creationsSince(createdAt []weave.UnixTime, since time.Time) []weave.UnixTime {
+	for i, t := range createdAt {
+		if t.Time().After(since) {
+			return createdAt[i:]
+		}
+	}
+	return nil
+}
+
+// authorize returns an error unless the countdown owner or an editor with at
+// least the given role authorized the transaction. Owners and editors can be
+// any condition supported by the authenticator, for example a multisig
+// contract.
+func authorize(ctx weave.Context, auth x.Authenticator, cd *Countdown, role EditorRole) error {
+	if auth.HasAddress(ctx, cd.Owner) {
+		return nil
+	}
+	for _, e := range cd.Editors {
+		if e.Role >= role && auth.HasAddress(ctx, e.Address) {
+			return nil
+		}
+	}
+	return errors.Wrapf(errors.ErrUnauthorized, "not allowed to modify countdown with ID %s", cd.ID)
+}
+
+// authorizeModerator returns an error unless the configuration owner or one of
+// the moderators authorized the transaction.
+func authorizeModerator(ctx weave.Context, auth x.Authenticator, conf *Configuration) error {
+	if len(conf.Owner) != 0 && auth.HasAddress(ctx, conf.Owner) {
+		return nil
+	}
+	for _, m := range conf.Moderators {
+		if auth.HasAddress(ctx, m) {
+			return nil
+		}
+	}
+	return errors.Wrap(errors.ErrUnauthorized, "only moderators can moderate countdowns")
+}
+
+// authorizeMigration returns an error unless the configuration owner or the
+// admin of the migration module authorized the transaction.
+func authorizeMigration(ctx weave.Context, auth x.Authenticator, store weave.ReadOnlyKVStore, conf *Configuration) error {
+	if len(conf.Owner) != 0 && auth.HasAddress(ctx, conf.Owner) {
+		return nil
+	}
+	var mconf migration.Configuration
+	if err := gconf.Load(store, "migration", &mconf); err != nil && !errors.ErrNotFound.Is(err) {
+		return errors.Wrap(err, "cannot load migration configuration")
+	}
+	if len(mconf.Admin) != 0 && auth.HasAddress(ctx, mconf.Admin) {
+		return nil
+	}
+	return errors.Wrap(errors.ErrUnauthorized, "only the configuration owner or the migration admin can migrate countdowns")
+}
+
+// authorizeOwner returns an error unless the countdown owner authorized the
+// transaction.
+func authorizeOwner(ctx weave.Context, auth x.Authenticator, cd *Countdown) error {
+	if !auth.HasAddress(ctx, cd.Owner) {
+		return errors.Wrapf(errors.ErrUnauthorized, "owner of countdown with ID %s did not authorize the transaction", cd.ID)
+	}
+	return nil
 }
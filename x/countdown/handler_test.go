@@ -2,20 +2,26 @@ package countdown
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/x/cash"
 
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/weavetest/assert"
 )
 
-const lyrics := []string{
+var lyrics = []string{
 	"(Ten, nine, eight, seven, six, five, four, three, two, one)",
 	"We're leaving together",
 	"But still it's farewell",
@@ -45,6 +51,8 @@ const lyrics := []string{
 }
 
 func TestCreateUser(t *testing.T) {
+	signer := weavetest.NewCondition()
+
 	cases := map[string]struct {
 		msg             weave.Msg
 		expected        *User
@@ -60,6 +68,7 @@ func TestCreateUser(t *testing.T) {
 				Metadata: &weave.Metadata{Schema: 1},
 				ID:       weavetest.SequenceID(1),
 				Username: "enigma",
+				Owner:    signer.Address(),
 			},
 			wantCheckErrs: map[string]*errors.Error{
 				"Metadata": nil,
@@ -88,14 +97,15 @@ func TestCreateUser(t *testing.T) {
 	}
 	for testName, tc := range cases {
 		t.Run(testName, func(t *testing.T) {
-			auth := &weavetest.Auth{}
+			auth := &weavetest.Auth{Signer: signer}
 
 			rt := app.NewRouter()
 
 			scheduler := &weavetest.Cron{}
-			RegisterRoutes(rt, auth, scheduler)
+			RegisterRoutes(rt, auth, scheduler, cash.NewController(cash.NewBucket()))
 
 			kv := store.MemStore()
+			saveConf(t, kv, testConf())
 			bucket := NewUserBucket()
 
 			tx := &weavetest.Tx{Msg: tc.msg}
@@ -149,17 +159,19 @@ func TestCreateCountdown(t *testing.T) {
 	}{
 		"success": {
 			msg: &CreateCountdownMsg{
-				Metadata: &weave.Metadata{Schema: 1},
-				Title:    "final countdown",
-				Lyrics:   b,
+				Metadata:           &weave.Metadata{Schema: 1},
+				Title:              "final countdown",
+				Lyrics:             b,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
 			},
 			owner: owner,
 			expected: &Countdown{
-				Metadata: &weave.Metadata{Schema: 1},
-				ID:       weavetest.SequenceID(1),
-				Owner:    owner.Address(),
-				Title:    "final countdown",
-				Lyrics:   b,
+				Metadata:           &weave.Metadata{Schema: countdownSchema},
+				ID:                 weavetest.SequenceID(1),
+				Owner:              owner.Address(),
+				Title:              "final countdown",
+				CompressedLyrics:   compressLyrics(t, b),
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
 			},
 			wantCheckErrs: map[string]*errors.Error{
 				"Metadata":  nil,
@@ -181,9 +193,10 @@ func TestCreateCountdown(t *testing.T) {
 		// TODO add metadata test
 		"failure no signer": {
 			msg: &CreateCountdownMsg{
-				Metadata: &weave.Metadata{Schema: 1},
-				Title:    "final countdown",
-				Lyrics:   b,
+				Metadata:           &weave.Metadata{Schema: 1},
+				Title:              "final countdown",
+				Lyrics:             b,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
 			},
 			owner: nil,
 			wantCheckErrs: map[string]*errors.Error{
@@ -205,8 +218,9 @@ func TestCreateCountdown(t *testing.T) {
 		},
 		"failure missing title": {
 			msg: &CreateCountdownMsg{
-				Metadata: &weave.Metadata{Schema: 1},
-				Lyrics:   b,
+				Metadata:           &weave.Metadata{Schema: 1},
+				Lyrics:             b,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
 			},
 			owner: owner,
 			wantCheckErrs: map[string]*errors.Error{
@@ -236,9 +250,10 @@ func TestCreateCountdown(t *testing.T) {
 			rt := app.NewRouter()
 
 			scheduler := &weavetest.Cron{}
-			RegisterRoutes(rt, auth, scheduler)
+			RegisterRoutes(rt, auth, scheduler, cash.NewController(cash.NewBucket()))
 
 			kv := store.MemStore()
+			saveConf(t, kv, testConf())
 			bucket := NewCountdownBucket()
 
 			tx := &weavetest.Tx{Msg: tc.msg}
@@ -269,6 +284,11 @@ func TestCreateCountdown(t *testing.T) {
 
 				// avoid registered at missing error
 				tc.expected.CreatedAt = createdAt
+				tc.expected.ScheduleStart = createdAt
+				tc.expected.NextRevealAt = createdAt.Add(revealInterval)
+
+				// task ID is assigned by the scheduler
+				tc.expected.TaskID = stored.TaskID
 
 				assert.Nil(t, err)
 				assert.Equal(t, tc.expected, &stored)
@@ -277,6 +297,235 @@ func TestCreateCountdown(t *testing.T) {
 	}
 }
 
+// compressLyrics returns JSON encoded lyrics in their stored form.
+func compressLyrics(t testing.TB, raw []byte) []byte {
+	t.Helper()
+	var cd Countdown
+	if err := cd.SetLyrics(raw); err != nil {
+		t.Fatalf("cannot compress lyrics: %s", err)
+	}
+	return cd.CompressedLyrics
+}
+
+func TestCountdownQuota(t *testing.T) {
+	owner := weavetest.NewCondition()
+
+	b, err := json.Marshal(lyrics)
+	assert.Nil(t, err)
+
+	now := weave.AsUnixTime(time.Now().Round(time.Second))
+
+	cases := map[string]struct {
+		maxActive    int32
+		maxPerWindow int32
+		existing     []*Countdown
+		wantErr      *errors.Error
+	}{
+		"no limits": {
+			existing: []*Countdown{{CreatedAt: now}, {CreatedAt: now}},
+		},
+		"active countdowns limit reached": {
+			maxActive: 2,
+			existing:  []*Countdown{{CreatedAt: now.Add(-48 * time.Hour)}, {CreatedAt: now.Add(-48 * time.Hour)}},
+			wantErr:   ErrLimit,
+		},
+		"completed countdowns are not active": {
+			maxActive: 2,
+			existing:  []*Countdown{{CreatedAt: now.Add(-48 * time.Hour), CompletedAt: now}, {CreatedAt: now.Add(-48 * time.Hour)}},
+		},
+		"creation window limit reached": {
+			maxPerWindow: 1,
+			existing:     []*Countdown{{CreatedAt: now.Add(-time.Hour), CompletedAt: now}},
+			wantErr:      ErrLimit,
+		},
+		"creations before the window are not counted": {
+			maxPerWindow: 1,
+			existing:     []*Countdown{{CreatedAt: now.Add(-48 * time.Hour)}},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{Signer: owner}
+			rt := app.NewRouter()
+			RegisterRoutes(rt, auth, &weavetest.Cron{}, cash.NewController(cash.NewBucket()))
+
+			kv := store.MemStore()
+			conf := testConf()
+			conf.MaxActiveCountdowns = tc.maxActive
+			conf.MaxCountdownsPerWindow = tc.maxPerWindow
+			conf.CreationWindow = weave.AsUnixDuration(24 * time.Hour)
+			saveConf(t, kv, conf)
+
+			bucket := NewCountdownBucket()
+			for _, cd := range tc.existing {
+				cd.Metadata = &weave.Metadata{Schema: 1}
+				cd.Owner = owner.Address()
+				cd.Title = "final countdown"
+				cd.Lyrics = b
+				cd.MissedRevealPolicy = MissedRevealPolicy_CatchUp
+				cd.ScheduleStart = cd.CreatedAt
+				err := bucket.Put(kv, cd)
+				assert.Nil(t, err)
+			}
+
+			tx := &weavetest.Tx{Msg: &CreateCountdownMsg{
+				Metadata:           &weave.Metadata{Schema: 1},
+				Title:              "final countdown",
+				Lyrics:             b,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+			}}
+			ctx := weave.WithBlockTime(context.Background(), now.Time())
+			if _, err := rt.Check(ctx, kv, tx); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected check error: %+v", err)
+			}
+			if _, err := rt.Deliver(ctx, kv, tx); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestCountdownQuotaCreationLog(t *testing.T) {
+	owner := weavetest.NewCondition()
+	recipient := weavetest.NewCondition()
+
+	b, err := json.Marshal(lyrics)
+	assert.Nil(t, err)
+
+	cases := map[string]struct {
+		release func(id []byte) weave.Msg
+	}{
+		"deleting a countdown does not free the window": {
+			release: func(id []byte) weave.Msg {
+				return &DeleteCountdownMsg{
+					Metadata: &weave.Metadata{Schema: 1},
+					ID:       id,
+				}
+			},
+		},
+		"transferring a countdown does not free the window": {
+			release: func(id []byte) weave.Msg {
+				return &TransferCountdownMsg{
+					Metadata:    &weave.Metadata{Schema: 1},
+					CountdownID: id,
+					NewOwner:    recipient.Address(),
+				}
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{Signer: owner}
+			rt := app.NewRouter()
+			RegisterRoutes(rt, auth, &weavetest.Cron{}, cash.NewController(cash.NewBucket()))
+
+			kv := store.MemStore()
+			conf := testConf()
+			conf.MaxCountdownsPerWindow = 1
+			conf.CreationWindow = weave.AsUnixDuration(24 * time.Hour)
+			saveConf(t, kv, conf)
+
+			create := &weavetest.Tx{Msg: &CreateCountdownMsg{
+				Metadata:           &weave.Metadata{Schema: 1},
+				Title:              "final countdown",
+				Lyrics:             b,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+			}}
+			now := time.Now().Round(time.Second)
+			res, err := rt.Deliver(weave.WithBlockTime(context.Background(), now), kv, create)
+			assert.Nil(t, err)
+
+			ctx := weave.WithBlockTime(context.Background(), now.Add(time.Hour))
+			_, err = rt.Deliver(ctx, kv, &weavetest.Tx{Msg: tc.release(res.Data)})
+			assert.Nil(t, err)
+
+			if _, err := rt.Check(ctx, kv, create); !ErrLimit.Is(err) {
+				t.Fatalf("want check to fail with ErrLimit, got %+v", err)
+			}
+			if _, err := rt.Deliver(ctx, kv, create); !ErrLimit.Is(err) {
+				t.Fatalf("want deliver to fail with ErrLimit, got %+v", err)
+			}
+
+			// once the window passed, the owner can create a countdown again
+			ctx = weave.WithBlockTime(context.Background(), now.Add(25*time.Hour))
+			_, err = rt.Deliver(ctx, kv, create)
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestCreateCountdownWithOwner(t *testing.T) {
+	signer := weavetest.NewCondition()
+	contract := weavetest.NewCondition()
+
+	b, err := json.Marshal(lyrics)
+	assert.Nil(t, err)
+
+	cases := map[string]struct {
+		signers   []weave.Condition
+		owner     weave.Address
+		wantOwner weave.Address
+		wantErr   *errors.Error
+	}{
+		"owner defaults to the main signer": {
+			signers:   []weave.Condition{signer},
+			wantOwner: signer.Address(),
+		},
+		"owner authorized by a contract": {
+			signers:   []weave.Condition{signer, contract},
+			owner:     contract.Address(),
+			wantOwner: contract.Address(),
+		},
+		"owner not authorized": {
+			signers: []weave.Condition{signer},
+			owner:   contract.Address(),
+			wantErr: errors.ErrUnauthorized,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{
+				Signers: tc.signers,
+			}
+
+			rt := app.NewRouter()
+
+			scheduler := &weavetest.Cron{}
+			RegisterRoutes(rt, auth, scheduler, cash.NewController(cash.NewBucket()))
+
+			kv := store.MemStore()
+			saveConf(t, kv, testConf())
+			bucket := NewCountdownBucket()
+
+			tx := &weavetest.Tx{Msg: &CreateCountdownMsg{
+				Metadata:           &weave.Metadata{Schema: 1},
+				Title:              "final countdown",
+				Lyrics:             b,
+				MissedRevealPolicy: MissedRevealPolicy_Shift,
+				Owner:              tc.owner,
+			}}
+
+			ctx := weave.WithBlockTime(context.Background(), time.Now().Round(time.Second))
+
+			if _, err := rt.Check(ctx, kv, tx); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected check error: %+v", err)
+			}
+			res, err := rt.Deliver(ctx, kv, tx)
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %+v", err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+
+			var stored Countdown
+			err = bucket.One(kv, res.Data, &stored)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantOwner, stored.Owner)
+		})
+	}
+}
+
 func TestDeleteCountdown(t *testing.T) {
 	bob := weavetest.NewCondition()
 	signer := weavetest.NewCondition()
@@ -289,26 +538,28 @@ func TestDeleteCountdown(t *testing.T) {
 
 	ownedCDID := weavetest.SequenceID(1)
 	ownedCD := &Countdown{
-		Metadata:  &weave.Metadata{Schema: 1},
-		ID:        ownedCDID,
-		ID:        weavetest.SequenceID(1),
-		Owner:     signer.Address(),
-		Title:     "owner's countdown",
-		Lyrics:    b,
-		CreatedAt: now,
-		DeleteAt:  future,
+		Metadata:           &weave.Metadata{Schema: 1},
+		ID:                 ownedCDID,
+		Owner:              signer.Address(),
+		Title:              "owner's countdown",
+		Lyrics:             b,
+		CreatedAt:          now,
+		DeleteAt:           future,
+		MissedRevealPolicy: MissedRevealPolicy_Shift,
+		ScheduleStart:      now,
 	}
 
 	notOwnedCDID := weavetest.SequenceID(2)
 	notOwnedCD := &Countdown{
-		Metadata:  &weave.Metadata{Schema: 1},
-		ID:        notOwnedCDID,
-		ID:        weavetest.SequenceID(2),
-		Owner:     bob.Address(),
-		Title:     "hacker's countdown",
-		Lyrics:    b,
-		CreatedAt: now,
-		DeleteAt:  future,
+		Metadata:           &weave.Metadata{Schema: 1},
+		ID:                 notOwnedCDID,
+		Owner:              bob.Address(),
+		Title:              "hacker's countdown",
+		Lyrics:             b,
+		CreatedAt:          now,
+		DeleteAt:           future,
+		MissedRevealPolicy: MissedRevealPolicy_Shift,
+		ScheduleStart:      now,
 	}
 
 	cases := map[string]struct {
@@ -328,7 +579,6 @@ func TestDeleteCountdown(t *testing.T) {
 			wantCheckErrs: map[string]*errors.Error{
 				"Metadata":    nil,
 				"ID":          nil,
-				"ID":          nil,
 				"Owner":       nil,
 				"Title":       nil,
 				"Lyrics":      nil,
@@ -340,7 +590,6 @@ func TestDeleteCountdown(t *testing.T) {
 			wantDeliverErrs: map[string]*errors.Error{
 				"Metadata":    nil,
 				"ID":          nil,
-				"ID":          nil,
 				"Owner":       nil,
 				"Title":       nil,
 				"Lyrics":      nil,
@@ -360,7 +609,6 @@ func TestDeleteCountdown(t *testing.T) {
 			wantCheckErrs: map[string]*errors.Error{
 				"Metadata":    nil,
 				"ID":          nil,
-				"ID":          nil,
 				"Owner":       nil,
 				"Title":       nil,
 				"Lyrics":      nil,
@@ -372,7 +620,6 @@ func TestDeleteCountdown(t *testing.T) {
 			wantDeliverErrs: map[string]*errors.Error{
 				"Metadata":    nil,
 				"ID":          nil,
-				"ID":          nil,
 				"Owner":       nil,
 				"Title":       nil,
 				"Lyrics":      nil,
@@ -393,7 +640,7 @@ func TestDeleteCountdown(t *testing.T) {
 			rt := app.NewRouter()
 
 			scheduler := &weavetest.Cron{}
-			RegisterRoutes(rt, auth, scheduler)
+			RegisterRoutes(rt, auth, scheduler, cash.NewController(cash.NewBucket()))
 
 			kv := store.MemStore()
 
@@ -430,3 +677,2686 @@ func TestDeleteCountdown(t *testing.T) {
 		})
 	}
 }
+
+func TestCronAddLyrics(t *testing.T) {
+	owner := weavetest.NewCondition()
+
+	b, err := json.Marshal(lyrics)
+	assert.Nil(t, err)
+
+	createdAt := time.Now().Round(time.Second)
+
+	cases := map[string]struct {
+		policy        MissedRevealPolicy
+		revealed      int
+		blockTime     time.Time
+		wantRevealed  int
+		wantCompleted bool
+	}{
+		"catch up reveals the next line on schedule": {
+			policy:       MissedRevealPolicy_CatchUp,
+			revealed:     0,
+			blockTime:    createdAt.Add(revealInterval),
+			wantRevealed: 1,
+		},
+		"catch up reveals all missed lines after downtime": {
+			policy:       MissedRevealPolicy_CatchUp,
+			revealed:     1,
+			blockTime:    createdAt.Add(5*revealInterval + time.Hour),
+			wantRevealed: 5,
+		},
+		"catch up completes the countdown after a long downtime": {
+			policy:        MissedRevealPolicy_CatchUp,
+			revealed:      1,
+			blockTime:     createdAt.Add(100 * revealInterval),
+			wantRevealed:  len(lyrics),
+			wantCompleted: true,
+		},
+		"shift reveals a single line after downtime": {
+			policy:       MissedRevealPolicy_Shift,
+			revealed:     1,
+			blockTime:    createdAt.Add(5*revealInterval + time.Hour),
+			wantRevealed: 2,
+		},
+		"shift completes the countdown with the last line": {
+			policy:        MissedRevealPolicy_Shift,
+			revealed:      len(lyrics) - 1,
+			blockTime:     createdAt.Add(100 * revealInterval),
+			wantRevealed:  len(lyrics),
+			wantCompleted: true,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{}
+
+			rt := app.NewRouter()
+
+			scheduler := &weavetest.Cron{}
+			RegisterCronRoutes(rt, auth, scheduler, cash.NewController(cash.NewBucket()))
+
+			kv := store.MemStore()
+			saveConf(t, kv, testConf())
+			bucket := NewCountdownBucket()
+
+			revealed, err := json.Marshal(lyrics[:tc.revealed])
+			assert.Nil(t, err)
+
+			cd := &Countdown{
+				Metadata:           &weave.Metadata{Schema: 1},
+				ID:                 weavetest.SequenceID(1),
+				Owner:              owner.Address(),
+				Title:              "final countdown",
+				Lyrics:             b,
+				Countdown:          revealed,
+				CreatedAt:          weave.AsUnixTime(createdAt),
+				MissedRevealPolicy: tc.policy,
+				ScheduleStart:      weave.AsUnixTime(createdAt),
+			}
+			err = bucket.Put(kv, cd)
+			assert.Nil(t, err)
+
+			tx := &weavetest.Tx{Msg: &CountdownTask{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: cd.ID,
+				TaskOwner:   cd.Owner,
+			}}
+
+			ctx := weave.WithBlockTime(context.Background(), tc.blockTime)
+			ctx = weave.WithHeight(ctx, 42)
+
+			_, err = rt.Deliver(ctx, kv, tx)
+			assert.Nil(t, err)
+
+			var stored Countdown
+			err = bucket.One(kv, cd.ID, &stored)
+			assert.Nil(t, err)
+
+			got, err := stored.RevealedLines()
+			assert.Nil(t, err)
+			assert.Equal(t, lyrics[:tc.wantRevealed], got)
+
+			// only lines revealed by this task are part of the history
+			assert.Equal(t, tc.wantRevealed-tc.revealed, len(stored.Reveals))
+			for i, r := range stored.Reveals {
+				assert.Equal(t, int32(tc.revealed+i), r.Line)
+				assert.Equal(t, int64(42), r.Height)
+				assert.Equal(t, weave.AsUnixTime(tc.blockTime), r.RevealedAt)
+			}
+
+			if tc.wantCompleted {
+				assert.Equal(t, weave.AsUnixTime(tc.blockTime), stored.CompletedAt)
+				assert.Equal(t, weave.UnixTime(0), stored.NextRevealAt)
+			} else {
+				assert.Equal(t, weave.UnixTime(0), stored.CompletedAt)
+				assert.Equal(t, true, stored.NextRevealAt.Time().After(tc.blockTime))
+			}
+		})
+	}
+}
+
+func TestNextRevealAt(t *testing.T) {
+	createdAt := time.Now().Round(time.Second)
+	now := createdAt.Add(5*revealInterval + time.Hour)
+
+	cases := map[string]struct {
+		policy   MissedRevealPolicy
+		revealed int
+		unlocked time.Time
+		expected time.Time
+	}{
+		"catch up keeps the original schedule": {
+			policy:   MissedRevealPolicy_CatchUp,
+			revealed: 5,
+			expected: createdAt.Add(6 * revealInterval),
+		},
+		"catch up ignores early unlocks": {
+			policy:   MissedRevealPolicy_CatchUp,
+			revealed: 6,
+			unlocked: createdAt.Add(6 * revealInterval),
+			expected: createdAt.Add(7 * revealInterval),
+		},
+		"shift counts from the last reveal": {
+			policy:   MissedRevealPolicy_Shift,
+			revealed: 2,
+			expected: now.Add(revealInterval),
+		},
+		"shift counts from the schedule of an early unlocked reveal": {
+			policy:   MissedRevealPolicy_Shift,
+			revealed: 3,
+			unlocked: now.Add(time.Hour),
+			expected: now.Add(time.Hour + revealInterval),
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			cd := &Countdown{
+				MissedRevealPolicy: tc.policy,
+				ScheduleStart:      weave.AsUnixTime(createdAt),
+			}
+			if !tc.unlocked.IsZero() {
+				cd.UnlockedRevealAt = weave.AsUnixTime(tc.unlocked)
+			}
+			assert.Equal(t, tc.expected, nextRevealAt(cd, tc.revealed, now, revealInterval))
+		})
+	}
+}
+
+func TestEditorRoles(t *testing.T) {
+	owner := weavetest.NewCondition()
+	editor := weavetest.NewCondition()
+	manager := weavetest.NewCondition()
+	stranger := weavetest.NewCondition()
+
+	b, err := json.Marshal(lyrics)
+	assert.Nil(t, err)
+	revealed, err := json.Marshal(lyrics[:1])
+	assert.Nil(t, err)
+
+	updated := append([]string{}, lyrics...)
+	updated[len(updated)-1] = "It's the final countdown - Oh"
+	updatedLyrics, err := json.Marshal(updated)
+	assert.Nil(t, err)
+
+	changed := append([]string{}, lyrics...)
+	changed[0] = "(One, two, three)"
+	changedLyrics, err := json.Marshal(changed)
+	assert.Nil(t, err)
+
+	short := append([]string{}, lyrics...)
+	short[len(short)-1] = "Oh"
+	shortLyrics, err := json.Marshal(short)
+	assert.Nil(t, err)
+
+	now := weave.AsUnixTime(time.Now().Round(time.Second))
+	cdID := weavetest.SequenceID(1)
+
+	// countdown owned by a contract, for example a multisig
+	contract := weavetest.NewCondition()
+	contractCDID := weavetest.SequenceID(2)
+
+	cases := map[string]struct {
+		msg     weave.Msg
+		signer  weave.Condition
+		signers []weave.Condition
+		wantErr *errors.Error
+	}{
+		"owner grants a role": {
+			msg: &GrantRoleMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: cdID,
+				Address:     stranger.Address(),
+				Role:        EditorRole_Editor,
+			},
+			signer: owner,
+		},
+		"owner cannot be granted a role": {
+			msg: &GrantRoleMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: cdID,
+				Address:     owner.Address(),
+				Role:        EditorRole_Editor,
+			},
+			signer:  owner,
+			wantErr: errors.ErrInput,
+		},
+		"manager cannot grant a role": {
+			msg: &GrantRoleMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: cdID,
+				Address:     stranger.Address(),
+				Role:        EditorRole_Manager,
+			},
+			signer:  manager,
+			wantErr: errors.ErrUnauthorized,
+		},
+		"owner revokes a role": {
+			msg: &RevokeRoleMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: cdID,
+				Address:     editor.Address(),
+			},
+			signer: owner,
+		},
+		"revoking a missing role fails": {
+			msg: &RevokeRoleMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: cdID,
+				Address:     stranger.Address(),
+			},
+			signer:  owner,
+			wantErr: errors.ErrNotFound,
+		},
+		"editor updates unrevealed lines": {
+			msg: &UpdateLyricsMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: cdID,
+				Lyrics:      updatedLyrics,
+			},
+			signer: editor,
+		},
+		"editor cannot change revealed lines": {
+			msg: &UpdateLyricsMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: cdID,
+				Lyrics:      changedLyrics,
+			},
+			signer:  editor,
+			wantErr: errors.ErrInput,
+		},
+		"editor cannot add a line shorter than configured": {
+			msg: &UpdateLyricsMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: cdID,
+				Lyrics:      shortLyrics,
+			},
+			signer:  editor,
+			wantErr: errors.ErrInput,
+		},
+		"stranger cannot update lyrics": {
+			msg: &UpdateLyricsMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: cdID,
+				Lyrics:      updatedLyrics,
+			},
+			signer:  stranger,
+			wantErr: errors.ErrUnauthorized,
+		},
+		"editor cannot pause": {
+			msg: &PauseCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: cdID,
+			},
+			signer:  editor,
+			wantErr: errors.ErrUnauthorized,
+		},
+		"manager pauses": {
+			msg: &PauseCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: cdID,
+			},
+			signer: manager,
+		},
+		"manager cannot resume a running countdown": {
+			msg: &ResumeCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: cdID,
+			},
+			signer:  manager,
+			wantErr: errors.ErrState,
+		},
+		"manager deletes": {
+			msg: &DeleteCountdownMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				ID:       cdID,
+			},
+			signer: manager,
+		},
+		"contract owner deletes": {
+			msg: &DeleteCountdownMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				ID:       contractCDID,
+			},
+			signers: []weave.Condition{stranger, contract},
+		},
+		"contract owned countdown cannot be deleted without the contract": {
+			msg: &DeleteCountdownMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				ID:       contractCDID,
+			},
+			signer:  stranger,
+			wantErr: errors.ErrUnauthorized,
+		},
+		"editor cannot delete": {
+			msg: &DeleteCountdownMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				ID:       cdID,
+			},
+			signer:  editor,
+			wantErr: errors.ErrUnauthorized,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{
+				Signer:  tc.signer,
+				Signers: tc.signers,
+			}
+
+			rt := app.NewRouter()
+
+			scheduler := &weavetest.Cron{}
+			RegisterRoutes(rt, auth, scheduler, cash.NewController(cash.NewBucket()))
+
+			kv := store.MemStore()
+			saveConf(t, kv, testConf())
+			bucket := NewCountdownBucket()
+
+			contractCD := &Countdown{
+				Metadata:           &weave.Metadata{Schema: 1},
+				ID:                 contractCDID,
+				Owner:              contract.Address(),
+				Title:              "team countdown",
+				Lyrics:             b,
+				CreatedAt:          now,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				ScheduleStart:      now,
+			}
+			err := bucket.Put(kv, contractCD)
+			assert.Nil(t, err)
+
+			cd := &Countdown{
+				Metadata:           &weave.Metadata{Schema: 1},
+				ID:                 cdID,
+				Owner:              owner.Address(),
+				Title:              "final countdown",
+				Lyrics:             b,
+				Countdown:          revealed,
+				CreatedAt:          now,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				ScheduleStart:      now,
+				Editors: []*Editor{
+					{Address: editor.Address(), Role: EditorRole_Editor},
+					{Address: manager.Address(), Role: EditorRole_Manager},
+				},
+			}
+			err = bucket.Put(kv, cd)
+			assert.Nil(t, err)
+
+			tx := &weavetest.Tx{Msg: tc.msg}
+
+			ctx := weave.WithBlockTime(context.Background(), now.Time().Add(time.Hour))
+
+			if _, err := rt.Check(ctx, kv, tx); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected check error: %+v", err)
+			}
+			if _, err := rt.Deliver(ctx, kv, tx); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestPauseResumeCountdown(t *testing.T) {
+	owner := weavetest.NewCondition()
+
+	b, err := json.Marshal(lyrics)
+	assert.Nil(t, err)
+
+	auth := &weavetest.Auth{Signer: owner}
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth, &weavetest.Cron{}, cash.NewController(cash.NewBucket()))
+
+	kv := store.MemStore()
+	saveConf(t, kv, testConf())
+	bucket := NewCountdownBucket()
+
+	now := weave.AsUnixTime(time.Now().Round(time.Second))
+	cd := &Countdown{
+		Metadata:           &weave.Metadata{Schema: 1},
+		ID:                 weavetest.SequenceID(1),
+		Owner:              owner.Address(),
+		Title:              "final countdown",
+		Lyrics:             b,
+		CreatedAt:          now,
+		MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+		ScheduleStart:      now,
+	}
+	err = bucket.Put(kv, cd)
+	assert.Nil(t, err)
+
+	pausedAt := now.Add(time.Hour)
+	ctx := weave.WithBlockTime(context.Background(), pausedAt.Time())
+	_, err = rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &PauseCountdownMsg{
+		Metadata:    &weave.Metadata{Schema: 1},
+		CountdownID: cd.ID,
+	}})
+	assert.Nil(t, err)
+
+	var stored Countdown
+	err = bucket.One(kv, cd.ID, &stored)
+	assert.Nil(t, err)
+	assert.Equal(t, pausedAt, stored.PausedAt)
+
+	// the reveal schedule is moved forward by the pause length
+	resumedAt := pausedAt.Add(3 * revealInterval)
+	ctx = weave.WithBlockTime(context.Background(), resumedAt.Time())
+	_, err = rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &ResumeCountdownMsg{
+		Metadata:    &weave.Metadata{Schema: 1},
+		CountdownID: cd.ID,
+	}})
+	assert.Nil(t, err)
+
+	err = bucket.One(kv, cd.ID, &stored)
+	assert.Nil(t, err)
+	assert.Equal(t, weave.UnixTime(0), stored.PausedAt)
+	assert.Equal(t, now.Add(3*revealInterval), stored.ScheduleStart)
+}
+
+func TestUpdateLyricsAttachments(t *testing.T) {
+	owner := weavetest.NewCondition()
+
+	b, err := json.Marshal(lyrics)
+	assert.Nil(t, err)
+	revealed, err := json.Marshal(lyrics[:2])
+	assert.Nil(t, err)
+
+	auth := &weavetest.Auth{Signer: owner}
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth, &weavetest.Cron{}, cash.NewController(cash.NewBucket()))
+
+	kv := store.MemStore()
+	saveConf(t, kv, testConf())
+	bucket := NewCountdownBucket()
+
+	cover := sha256.Sum256([]byte("cover"))
+	clip := sha256.Sum256([]byte("clip"))
+
+	now := weave.AsUnixTime(time.Now().Round(time.Second))
+	cd := &Countdown{
+		Metadata:           &weave.Metadata{Schema: 1},
+		ID:                 weavetest.SequenceID(1),
+		Owner:              owner.Address(),
+		Title:              "final countdown",
+		Lyrics:             b,
+		Countdown:          revealed,
+		CreatedAt:          now,
+		MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+		ScheduleStart:      now,
+		Attachments: []*Attachment{
+			{Line: 0, Hash: cover[:], MimeType: "image/png"},
+			{Line: 3, Hash: cover[:], MimeType: "image/png"},
+		},
+	}
+	err = bucket.Put(kv, cd)
+	assert.Nil(t, err)
+
+	ctx := weave.WithBlockTime(context.Background(), now.Add(time.Hour).Time())
+
+	// attachments of revealed lines cannot be changed
+	_, err = rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &UpdateLyricsMsg{
+		Metadata:    &weave.Metadata{Schema: 1},
+		CountdownID: cd.ID,
+		Lyrics:      b,
+		Attachments: []*Attachment{
+			{Line: 1, Hash: clip[:], MimeType: "audio/mpeg"},
+		},
+	}})
+	if !errors.ErrInput.Is(err) {
+		t.Fatalf("want a revealed line attachment to fail, got %+v", err)
+	}
+
+	_, err = rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &UpdateLyricsMsg{
+		Metadata:    &weave.Metadata{Schema: 1},
+		CountdownID: cd.ID,
+		Lyrics:      b,
+		Attachments: []*Attachment{
+			{Line: 5, Hash: clip[:], MimeType: "audio/mpeg"},
+		},
+	}})
+	assert.Nil(t, err)
+
+	var stored Countdown
+	err = bucket.One(kv, cd.ID, &stored)
+	assert.Nil(t, err)
+	assert.Equal(t, []*Attachment{
+		{Line: 0, Hash: cover[:], MimeType: "image/png"},
+		{Line: 5, Hash: clip[:], MimeType: "audio/mpeg"},
+	}, stored.Attachments)
+}
+
+func TestCountdownDraft(t *testing.T) {
+	owner := weavetest.NewCondition()
+	stranger := weavetest.NewCondition()
+
+	auth := &weavetest.Auth{Signer: owner}
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth, &weavetest.Cron{}, cash.NewController(cash.NewBucket()))
+
+	kv := store.MemStore()
+	saveConf(t, kv, testConf())
+
+	now := weave.AsUnixTime(time.Now().Round(time.Second))
+	ctx := weave.WithBlockTime(context.Background(), now.Time())
+
+	res, err := rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &CreateCountdownDraftMsg{
+		Metadata:           &weave.Metadata{Schema: 1},
+		Title:              "final countdown",
+		MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+	}})
+	assert.Nil(t, err)
+	draftID := res.Data
+
+	chunk := func(index int32, lines []string) *AppendLyricsChunkMsg {
+		raw, err := json.Marshal(lines)
+		assert.Nil(t, err)
+		hash := sha256.Sum256(raw)
+		return &AppendLyricsChunkMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			DraftID:  draftID,
+			Index:    index,
+			Lyrics:   raw,
+			Hash:     hash[:],
+		}
+	}
+
+	var hash []byte
+	for i, lines := range [][]string{lyrics[:10], lyrics[10:20], lyrics[20:]} {
+		msg := chunk(int32(i), lines)
+		_, err := rt.Deliver(ctx, kv, &weavetest.Tx{Msg: msg})
+		assert.Nil(t, err)
+		hash = NextLyricsHash(hash, msg.Lyrics)
+	}
+
+	// chunks must be appended in order
+	_, err = rt.Deliver(ctx, kv, &weavetest.Tx{Msg: chunk(1, lyrics[:1])})
+	if !errors.ErrInput.Is(err) {
+		t.Fatalf("want an out of order chunk to fail, got %+v", err)
+	}
+
+	// a chunk must match its hash
+	corrupted := chunk(3, lyrics[:1])
+	corrupted.Hash = hash
+	_, err = rt.Deliver(ctx, kv, &weavetest.Tx{Msg: corrupted})
+	if !errors.ErrInput.Is(err) {
+		t.Fatalf("want a chunk with a wrong hash to fail, got %+v", err)
+	}
+
+	// only the owner can append chunks
+	strangerRt := app.NewRouter()
+	RegisterRoutes(strangerRt, &weavetest.Auth{Signer: stranger}, &weavetest.Cron{}, cash.NewController(cash.NewBucket()))
+	_, err = strangerRt.Deliver(ctx, kv, &weavetest.Tx{Msg: chunk(3, lyrics[:1])})
+	if !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want a stranger chunk to fail, got %+v", err)
+	}
+
+	// the publisher must confirm all chunks were appended
+	_, err = rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &PublishCountdownMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		DraftID:    draftID,
+		LyricsHash: NextLyricsHash(nil, []byte("other")),
+	}})
+	if !errors.ErrInput.Is(err) {
+		t.Fatalf("want a wrong lyrics hash to fail, got %+v", err)
+	}
+
+	res, err = rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &PublishCountdownMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		DraftID:    draftID,
+		LyricsHash: hash,
+	}})
+	assert.Nil(t, err)
+
+	var cd Countdown
+	err = NewCountdownBucket().One(kv, res.Data, &cd)
+	assert.Nil(t, err)
+	lines, err := cd.Lines()
+	assert.Nil(t, err)
+	assert.Equal(t, lyrics, lines)
+	assert.Equal(t, owner.Address(), cd.Owner)
+	assert.Equal(t, now.Add(revealInterval), cd.NextRevealAt)
+	if len(cd.TaskID) == 0 {
+		t.Fatal("want the first reveal to be scheduled")
+	}
+
+	var draft Draft
+	err = NewDraftBucket().One(kv, draftID, &draft)
+	if !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want the draft to be deleted, got %+v", err)
+	}
+}
+
+func TestDraftLyricsSize(t *testing.T) {
+	owner := weavetest.NewCondition()
+
+	auth := &weavetest.Auth{Signer: owner}
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth, &weavetest.Cron{}, cash.NewController(cash.NewBucket()))
+
+	kv := store.MemStore()
+	saveConf(t, kv, testConf())
+
+	now := weave.AsUnixTime(time.Now().Round(time.Second))
+	ctx := weave.WithBlockTime(context.Background(), now.Time())
+
+	// fill the draft lyrics up to a few bytes below the size limit
+	line := strings.Repeat("a", 1000)
+	lines := make([]string, (maxLyricsSize-2000)/(len(line)+3))
+	for i := range lines {
+		lines[i] = line
+	}
+	raw, err := json.Marshal(lines)
+	assert.Nil(t, err)
+	lines = append(lines, strings.Repeat("a", maxLyricsSize-len(raw)-8))
+	raw, err = json.Marshal(lines)
+	assert.Nil(t, err)
+	assert.Equal(t, maxLyricsSize-5, len(raw))
+
+	draft := &Draft{
+		Metadata:           &weave.Metadata{Schema: 1},
+		ID:                 weavetest.SequenceID(1),
+		Owner:              owner.Address(),
+		Title:              "final countdown",
+		MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+		CreatedAt:          now,
+		Lyrics:             raw,
+		LyricsHash:         NextLyricsHash(nil, raw),
+		Chunks:             1,
+	}
+	err = NewDraftBucket().Put(kv, draft)
+	assert.Nil(t, err)
+
+	chunk, err := json.Marshal(lyrics[:1])
+	assert.Nil(t, err)
+	hash := sha256.Sum256(chunk)
+	tx := &weavetest.Tx{Msg: &AppendLyricsChunkMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		DraftID:  draft.ID,
+		Index:    1,
+		Lyrics:   chunk,
+		Hash:     hash[:],
+	}}
+	if _, err := rt.Check(ctx, kv, tx); !errors.ErrInput.Is(err) {
+		t.Fatalf("want a chunk exceeding the lyrics size to fail, got %+v", err)
+	}
+	if _, err := rt.Deliver(ctx, kv, tx); !errors.ErrInput.Is(err) {
+		t.Fatalf("want a chunk exceeding the lyrics size to fail, got %+v", err)
+	}
+}
+
+func TestTransferCountdown(t *testing.T) {
+	owner := weavetest.NewCondition()
+	newOwner := weavetest.NewCondition()
+	stranger := weavetest.NewCondition()
+
+	b, err := json.Marshal(lyrics)
+	assert.Nil(t, err)
+
+	now := weave.AsUnixTime(time.Now().Round(time.Second))
+
+	cases := map[string]struct {
+		transferSigner weave.Condition
+		requireAccept  bool
+		acceptSigner   weave.Condition
+		wantTransfer   *errors.Error
+		wantAccept     *errors.Error
+		wantOwner      weave.Condition
+	}{
+		"direct transfer": {
+			transferSigner: owner,
+			wantOwner:      newOwner,
+		},
+		"transfer accepted by the new owner": {
+			transferSigner: owner,
+			requireAccept:  true,
+			acceptSigner:   newOwner,
+			wantOwner:      newOwner,
+		},
+		"transfer cannot be accepted by a stranger": {
+			transferSigner: owner,
+			requireAccept:  true,
+			acceptSigner:   stranger,
+			wantAccept:     errors.ErrUnauthorized,
+			wantOwner:      owner,
+		},
+		"transfer not accepted yet": {
+			transferSigner: owner,
+			requireAccept:  true,
+			wantOwner:      owner,
+		},
+		"only the owner can transfer": {
+			transferSigner: stranger,
+			wantTransfer:   errors.ErrUnauthorized,
+			wantOwner:      owner,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{}
+			rt := app.NewRouter()
+			RegisterRoutes(rt, auth, &weavetest.Cron{}, cash.NewController(cash.NewBucket()))
+
+			kv := store.MemStore()
+			bucket := NewCountdownBucket()
+
+			cd := &Countdown{
+				Metadata:           &weave.Metadata{Schema: 1},
+				ID:                 weavetest.SequenceID(1),
+				Owner:              owner.Address(),
+				Title:              "final countdown",
+				Lyrics:             b,
+				CreatedAt:          now,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				Editors: []*Editor{
+					{Address: newOwner.Address(), Role: EditorRole_Editor},
+				},
+				ScheduleStart: now,
+			}
+			// the pending reveal task is rescheduled on transfer
+			err = scheduleReveal(kv, &weavetest.Cron{}, cd, now.Add(revealInterval).Time())
+			assert.Nil(t, err)
+			err = bucket.Put(kv, cd)
+			assert.Nil(t, err)
+
+			ctx := weave.WithBlockTime(context.Background(), now.Time())
+
+			auth.Signer = tc.transferSigner
+			_, err = rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &TransferCountdownMsg{
+				Metadata:      &weave.Metadata{Schema: 1},
+				CountdownID:   cd.ID,
+				NewOwner:      newOwner.Address(),
+				RequireAccept: tc.requireAccept,
+			}})
+			if !tc.wantTransfer.Is(err) {
+				t.Fatalf("unexpected transfer error: %+v", err)
+			}
+
+			if tc.acceptSigner != nil {
+				auth.Signer = tc.acceptSigner
+				_, err = rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &AcceptCountdownTransferMsg{
+					Metadata:    &weave.Metadata{Schema: 1},
+					CountdownID: cd.ID,
+				}})
+				if !tc.wantAccept.Is(err) {
+					t.Fatalf("unexpected accept error: %+v", err)
+				}
+			}
+
+			var stored Countdown
+			err = bucket.One(kv, cd.ID, &stored)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantOwner.Address(), stored.Owner)
+			assert.Equal(t, now.Add(revealInterval), stored.NextRevealAt)
+
+			if tc.wantOwner.Equals(newOwner) {
+				assert.Equal(t, 0, len(stored.PendingOwner))
+				// the new owner does not keep its editor role
+				assert.Equal(t, 0, len(stored.Editors))
+			}
+		})
+	}
+}
+
+func TestTipCountdown(t *testing.T) {
+	owner := weavetest.NewCondition()
+	tipper := weavetest.NewCondition()
+
+	b, err := json.Marshal(lyrics)
+	assert.Nil(t, err)
+
+	now := weave.AsUnixTime(time.Now().Round(time.Second))
+
+	cases := map[string]struct {
+		signer      weave.Condition
+		msg         *TipCountdownMsg
+		wantErr     *errors.Error
+		wantBalance coin.Coins
+	}{
+		"tip from the main signer": {
+			signer: tipper,
+			msg: &TipCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: weavetest.SequenceID(1),
+				Amount:      coin.NewCoinp(5, 0, "IOV"),
+			},
+			wantBalance: coin.Coins{coin.NewCoinp(5, 0, "IOV")},
+		},
+		"tip from an explicit tipper": {
+			signer: tipper,
+			msg: &TipCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: weavetest.SequenceID(1),
+				Tipper:      tipper.Address(),
+				Amount:      coin.NewCoinp(5, 0, "IOV"),
+			},
+			wantBalance: coin.Coins{coin.NewCoinp(5, 0, "IOV")},
+		},
+		"tipper must authorize the tip": {
+			signer: owner,
+			msg: &TipCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: weavetest.SequenceID(1),
+				Tipper:      tipper.Address(),
+				Amount:      coin.NewCoinp(5, 0, "IOV"),
+			},
+			wantErr: errors.ErrUnauthorized,
+		},
+		"owner cannot tip own countdown": {
+			signer: owner,
+			msg: &TipCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: weavetest.SequenceID(1),
+				Amount:      coin.NewCoinp(5, 0, "IOV"),
+			},
+			wantErr: errors.ErrInput,
+		},
+		"insufficient funds": {
+			signer: tipper,
+			msg: &TipCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: weavetest.SequenceID(1),
+				Amount:      coin.NewCoinp(500, 0, "IOV"),
+			},
+			wantErr: errors.ErrAmount,
+		},
+		"countdown not found": {
+			signer: tipper,
+			msg: &TipCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: weavetest.SequenceID(2),
+				Amount:      coin.NewCoinp(5, 0, "IOV"),
+			},
+			wantErr: errors.ErrNotFound,
+		},
+		"hidden countdown cannot be tipped": {
+			signer: tipper,
+			msg: &TipCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: weavetest.SequenceID(3),
+				Amount:      coin.NewCoinp(5, 0, "IOV"),
+			},
+			wantErr: errors.ErrState,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{Signer: tc.signer}
+			ctrl := cash.NewController(cash.NewBucket())
+			rt := app.NewRouter()
+			RegisterRoutes(rt, auth, &weavetest.Cron{}, ctrl)
+
+			kv := store.MemStore()
+			bucket := NewCountdownBucket()
+
+			cd := &Countdown{
+				Metadata:           &weave.Metadata{Schema: 1},
+				ID:                 weavetest.SequenceID(1),
+				Owner:              owner.Address(),
+				Title:              "final countdown",
+				Lyrics:             b,
+				CreatedAt:          now,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				ScheduleStart:      now,
+			}
+			err = bucket.Put(kv, cd)
+			assert.Nil(t, err)
+
+			hidden := cd.Copy().(*Countdown)
+			hidden.ID = weavetest.SequenceID(3)
+			hidden.HiddenAt = now
+			err = bucket.Put(kv, hidden)
+			assert.Nil(t, err)
+
+			err = ctrl.CoinMint(kv, tipper.Address(), coin.NewCoin(100, 0, "IOV"))
+			assert.Nil(t, err)
+
+			ctx := weave.WithBlockTime(context.Background(), now.Time())
+			res, err := rt.Deliver(ctx, kv, &weavetest.Tx{Msg: tc.msg})
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+
+			balance, err := ctrl.Balance(kv, owner.Address())
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantBalance, balance)
+
+			var stored Countdown
+			err = bucket.One(kv, cd.ID, &stored)
+			assert.Nil(t, err)
+			assert.Equal(t, []*coin.Coin(tc.wantBalance), stored.TipTotal)
+			assert.Equal(t, []*TopTipper{{Address: tipper.Address(), Total: tc.msg.Amount}}, stored.TopTippers)
+
+			var tipperTotal Tipper
+			err = NewTipperBucket().One(kv, tipperID(cd.ID, tipper.Address()), &tipperTotal)
+			assert.Nil(t, err)
+			assert.Equal(t, []*coin.Coin(tc.wantBalance), tipperTotal.Total)
+
+			assert.Equal(t, 4, len(res.Tags))
+		})
+	}
+}
+
+func TestUpdateTopTippers(t *testing.T) {
+	addrs := make([]weave.Address, maxTopTippers+1)
+	for i := range addrs {
+		addrs[i] = weavetest.NewCondition().Address()
+	}
+
+	var top []*TopTipper
+	for i, addr := range addrs {
+		top = updateTopTippers(top, addr, coin.NewCoinp(int64(i+1), 0, "IOV"))
+	}
+	top = updateTopTippers(top, addrs[0], coin.NewCoinp(1, 0, "ETH"))
+
+	// the smallest IOV tipper dropped out of the ranking
+	assert.Equal(t, maxTopTippers+1, len(top))
+	assert.Equal(t, "ETH", top[0].Total.Ticker)
+	assert.Equal(t, addrs[0], top[0].Address)
+	assert.Equal(t, addrs[maxTopTippers], top[1].Address)
+	assert.Equal(t, addrs[1], top[maxTopTippers].Address)
+
+	// a new total replaces the previous entry of the tipper
+	top = updateTopTippers(top, addrs[1], coin.NewCoinp(100, 0, "IOV"))
+	assert.Equal(t, maxTopTippers+1, len(top))
+	assert.Equal(t, addrs[1], top[1].Address)
+	assert.Equal(t, addrs[2], top[maxTopTippers].Address)
+}
+
+func TestCountdownBounty(t *testing.T) {
+	sponsor := weavetest.NewCondition()
+	beneficiary := weavetest.NewCondition()
+
+	// a single line countdown is completed by the first reveal
+	b, err := json.Marshal(lyrics[:1])
+	assert.Nil(t, err)
+
+	bounty := coin.NewCoin(10, 0, "IOV")
+
+	cases := map[string]struct {
+		complete        bool
+		wantBeneficiary coin.Coins
+		wantSponsor     coin.Coins
+	}{
+		"bounty is released to the beneficiary on completion": {
+			complete:        true,
+			wantBeneficiary: coin.Coins{&bounty},
+			wantSponsor:     nil,
+		},
+		"bounty is refunded to the sponsor on delete": {
+			complete:        false,
+			wantBeneficiary: nil,
+			wantSponsor:     coin.Coins{&bounty},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{Signer: sponsor}
+			ctrl := cash.NewController(cash.NewBucket())
+			scheduler := &weavetest.Cron{}
+
+			rt := app.NewRouter()
+			RegisterRoutes(rt, auth, scheduler, ctrl)
+			RegisterCronRoutes(rt, auth, scheduler, ctrl)
+
+			kv := store.MemStore()
+			saveConf(t, kv, testConf())
+			err := ctrl.CoinMint(kv, sponsor.Address(), bounty)
+			assert.Nil(t, err)
+
+			now := time.Now().Round(time.Second)
+			ctx := weave.WithBlockTime(context.Background(), now)
+			ctx = weave.WithHeight(ctx, 1)
+
+			res, err := rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &CreateCountdownMsg{
+				Metadata:           &weave.Metadata{Schema: 1},
+				Title:              "final countdown",
+				Lyrics:             b,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				Bounty:             []*coin.Coin{&bounty},
+				Beneficiary:        beneficiary.Address(),
+			}})
+			assert.Nil(t, err)
+			id := res.Data
+
+			locked, err := ctrl.Balance(kv, BountyAddress(id))
+			assert.Nil(t, err)
+			assert.Equal(t, coin.Coins{&bounty}, locked)
+
+			if tc.complete {
+				_, err = rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &CountdownTask{
+					Metadata:    &weave.Metadata{Schema: 1},
+					CountdownID: id,
+					TaskOwner:   sponsor.Address(),
+				}})
+				assert.Nil(t, err)
+			}
+
+			_, err = rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &DeleteCountdownMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				ID:       id,
+			}})
+			assert.Nil(t, err)
+
+			assert.Equal(t, tc.wantBeneficiary, balance(t, ctrl, kv, beneficiary.Address()))
+			assert.Equal(t, tc.wantSponsor, balance(t, ctrl, kv, sponsor.Address()))
+		})
+	}
+}
+
+// balance returns the funds of the given address, nil for a missing account.
+func balance(t testing.TB, ctrl cash.Controller, kv weave.KVStore, addr weave.Address) coin.Coins {
+	t.Helper()
+	coins, err := ctrl.Balance(kv, addr)
+	if errors.ErrNotFound.Is(err) {
+		return nil
+	}
+	assert.Nil(t, err)
+	if len(coins) == 0 {
+		return nil
+	}
+	return coins
+}
+
+func TestUnlockNextLine(t *testing.T) {
+	owner := weavetest.NewCondition()
+	fan := weavetest.NewCondition()
+
+	b, err := json.Marshal(lyrics)
+	assert.Nil(t, err)
+
+	createdAt := time.Now().Round(time.Second)
+	price := coin.NewCoinp(3, 0, "IOV")
+
+	cases := map[string]struct {
+		price     *coin.Coin
+		funds     coin.Coin
+		blockTime time.Time
+		hidden    bool
+		wantErr   *errors.Error
+	}{
+		"unlock pays the owner": {
+			price:     price,
+			funds:     coin.NewCoin(10, 0, "IOV"),
+			blockTime: createdAt.Add(time.Hour),
+		},
+		"unlock disabled without a price": {
+			price:     nil,
+			funds:     coin.NewCoin(10, 0, "IOV"),
+			blockTime: createdAt.Add(time.Hour),
+			wantErr:   errors.ErrState,
+		},
+		"next line is already due": {
+			price:     price,
+			funds:     coin.NewCoin(10, 0, "IOV"),
+			blockTime: createdAt.Add(revealInterval),
+			wantErr:   errors.ErrState,
+		},
+		"insufficient funds": {
+			price:     price,
+			funds:     coin.NewCoin(1, 0, "IOV"),
+			blockTime: createdAt.Add(time.Hour),
+			wantErr:   errors.ErrAmount,
+		},
+		"hidden countdown cannot be unlocked": {
+			price:     price,
+			funds:     coin.NewCoin(10, 0, "IOV"),
+			blockTime: createdAt.Add(time.Hour),
+			hidden:    true,
+			wantErr:   errors.ErrState,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{Signer: owner}
+			ctrl := cash.NewController(cash.NewBucket())
+			rt := app.NewRouter()
+			RegisterRoutes(rt, auth, &weavetest.Cron{}, ctrl)
+
+			kv := store.MemStore()
+			bucket := NewCountdownBucket()
+
+			cd := &Countdown{
+				Metadata:           &weave.Metadata{Schema: 1},
+				ID:                 weavetest.SequenceID(1),
+				Owner:              owner.Address(),
+				Title:              "final countdown",
+				Lyrics:             b,
+				CreatedAt:          weave.AsUnixTime(createdAt),
+				MissedRevealPolicy: MissedRevealPolicy_Shift,
+				ScheduleStart:      weave.AsUnixTime(createdAt),
+			}
+			if tc.hidden {
+				cd.HiddenAt = weave.AsUnixTime(createdAt)
+			}
+			err = scheduleReveal(kv, &weavetest.Cron{}, cd, createdAt.Add(revealInterval))
+			assert.Nil(t, err)
+			err = bucket.Put(kv, cd)
+			assert.Nil(t, err)
+
+			err = ctrl.CoinMint(kv, fan.Address(), tc.funds)
+			assert.Nil(t, err)
+
+			ctx := weave.WithBlockTime(context.Background(), createdAt)
+			_, err = rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &SetUnlockPriceMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: cd.ID,
+				Price:       tc.price,
+			}})
+			assert.Nil(t, err)
+
+			auth.Signer = fan
+			ctx = weave.WithBlockTime(context.Background(), tc.blockTime)
+			_, err = rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &UnlockNextLineMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: cd.ID,
+			}})
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+
+			assert.Equal(t, coin.Coins{price}, balance(t, ctrl, kv, owner.Address()))
+
+			var stored Countdown
+			err = bucket.One(kv, cd.ID, &stored)
+			assert.Nil(t, err)
+			assert.Equal(t, weave.AsUnixTime(tc.blockTime), stored.NextRevealAt)
+			assert.Equal(t, weave.AsUnixTime(createdAt.Add(revealInterval)), stored.UnlockedRevealAt)
+		})
+	}
+}
+
+func TestStorageDeposit(t *testing.T) {
+	owner := weavetest.NewCondition()
+
+	b, err := json.Marshal(lyrics)
+	assert.Nil(t, err)
+
+	auth := &weavetest.Auth{Signer: owner}
+	ctrl := cash.NewController(cash.NewBucket())
+	scheduler := &weavetest.Cron{}
+
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth, scheduler, ctrl)
+	RegisterCronRoutes(rt, auth, scheduler, ctrl)
+
+	kv := store.MemStore()
+	conf := testConf()
+	conf.UserDeposit = coin.NewCoin(0, 1000, "IOV")
+	conf.CountdownDeposit = coin.NewCoin(0, 10000, "IOV")
+	saveConf(t, kv, conf)
+	funds := coin.NewCoin(100, 0, "IOV")
+	err = ctrl.CoinMint(kv, owner.Address(), funds)
+	assert.Nil(t, err)
+
+	now := time.Now().Round(time.Second)
+	ctx := weave.WithBlockTime(context.Background(), now)
+
+	res, err := rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &CreateUserMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Username: "enigma",
+	}})
+	assert.Nil(t, err)
+	userID := res.Data
+
+	var user User
+	err = NewUserBucket().One(kv, userID, &user)
+	assert.Nil(t, err)
+	if user.Deposit == nil || !user.Deposit.IsPositive() {
+		t.Fatalf("want a positive user deposit, got %v", user.Deposit)
+	}
+
+	res, err = rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &CreateCountdownMsg{
+		Metadata:           &weave.Metadata{Schema: 1},
+		Title:              "final countdown",
+		Lyrics:             b,
+		MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+		DeleteAt:           weave.AsUnixTime(now.Add(time.Hour)),
+	}})
+	assert.Nil(t, err)
+	countdownID := res.Data
+
+	var cd Countdown
+	err = NewCountdownBucket().One(kv, countdownID, &cd)
+	assert.Nil(t, err)
+	assert.Equal(t, owner.Address(), cd.Depositor)
+
+	// the deposit is proportional to the stored bytes
+	if cd.Deposit == nil || cd.Deposit.Compare(*user.Deposit) <= 0 {
+		t.Fatalf("want countdown deposit larger than %v, got %v", user.Deposit, cd.Deposit)
+	}
+
+	held, err := coin.Coins{user.Deposit}.Add(*cd.Deposit)
+	assert.Nil(t, err)
+	assert.Equal(t, held, balance(t, ctrl, kv, DepositAddress))
+
+	_, err = rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &DeleteUserMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		ID:       userID,
+	}})
+	assert.Nil(t, err)
+
+	// expiration deletes the countdown
+	ctx = weave.WithBlockTime(context.Background(), now.Add(time.Hour))
+	_, err = rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &ExpireCountdownTask{
+		Metadata:    &weave.Metadata{Schema: 1},
+		CountdownID: countdownID,
+	}})
+	assert.Nil(t, err)
+
+	err = NewCountdownBucket().One(kv, countdownID, &cd)
+	if !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want expired countdown to be deleted, got %+v", err)
+	}
+
+	// all deposits are refunded
+	assert.Equal(t, coin.Coins{&funds}, balance(t, ctrl, kv, owner.Address()))
+	assert.Equal(t, coin.Coins(nil), balance(t, ctrl, kv, DepositAddress))
+}
+
+func TestUpdateLyricsDeposit(t *testing.T) {
+	owner := weavetest.NewCondition()
+	editor := weavetest.NewCondition()
+
+	short, err := json.Marshal(lyrics[:2])
+	assert.Nil(t, err)
+	long, err := json.Marshal(lyrics)
+	assert.Nil(t, err)
+	longer, err := json.Marshal(append(append([]string{}, lyrics...), "one more line"))
+	assert.Nil(t, err)
+
+	auth := &weavetest.Auth{Signer: owner}
+	ctrl := cash.NewController(cash.NewBucket())
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth, &weavetest.Cron{}, ctrl)
+
+	kv := store.MemStore()
+	conf := testConf()
+	conf.CountdownDeposit = coin.NewCoin(0, 10000, "IOV")
+	saveConf(t, kv, conf)
+	funds := coin.NewCoin(100, 0, "IOV")
+	err = ctrl.CoinMint(kv, owner.Address(), funds)
+	assert.Nil(t, err)
+
+	now := time.Now().Round(time.Second)
+	ctx := weave.WithBlockTime(context.Background(), now)
+
+	res, err := rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &CreateCountdownMsg{
+		Metadata:           &weave.Metadata{Schema: 1},
+		Title:              "final countdown",
+		Lyrics:             short,
+		MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+	}})
+	assert.Nil(t, err)
+	cdID := res.Data
+
+	_, err = rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &GrantRoleMsg{
+		Metadata:    &weave.Metadata{Schema: 1},
+		CountdownID: cdID,
+		Address:     editor.Address(),
+		Role:        EditorRole_Editor,
+	}})
+	assert.Nil(t, err)
+
+	update := func(signer weave.Condition, raw []byte) error {
+		auth.Signer = signer
+		_, err := rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &UpdateLyricsMsg{
+			Metadata:    &weave.Metadata{Schema: 1},
+			CountdownID: cdID,
+			Lyrics:      raw,
+		}})
+		return err
+	}
+	// deposit returns the countdown deposit after checking it is all the
+	// deposit address holds
+	deposit := func() coin.Coin {
+		var cd Countdown
+		err := NewCountdownBucket().One(kv, cdID, &cd)
+		assert.Nil(t, err)
+		if cd.Deposit == nil {
+			t.Fatal("want a countdown deposit")
+		}
+		assert.Equal(t, coin.Coins{cd.Deposit}, balance(t, ctrl, kv, DepositAddress))
+		return *cd.Deposit
+	}
+	remaining := func(funds, deposit coin.Coin) coin.Coins {
+		c, err := funds.Subtract(deposit)
+		assert.Nil(t, err)
+		return coin.Coins{&c}
+	}
+
+	created := deposit()
+
+	// growing lyrics take the difference from the owner
+	err = update(owner, long)
+	assert.Nil(t, err)
+	grown := deposit()
+	if grown.Compare(created) <= 0 {
+		t.Fatalf("want the deposit to grow from %v, got %v", created, grown)
+	}
+	assert.Equal(t, remaining(funds, grown), balance(t, ctrl, kv, owner.Address()))
+
+	// the update is rejected if the signer cannot pay for the growth
+	editorFunds := coin.NewCoin(0, 1, "IOV")
+	err = ctrl.CoinMint(kv, editor.Address(), editorFunds)
+	assert.Nil(t, err)
+	err = update(editor, longer)
+	if !errors.ErrAmount.Is(err) {
+		t.Fatalf("want an unpaid growth to fail, got %+v", err)
+	}
+	assert.Equal(t, grown, deposit())
+
+	// an editor paying for the growth takes over the deposit, the owner is
+	// refunded
+	err = ctrl.CoinMint(kv, editor.Address(), funds)
+	assert.Nil(t, err)
+	editorFunds, err = editorFunds.Add(funds)
+	assert.Nil(t, err)
+	err = update(editor, longer)
+	assert.Nil(t, err)
+	takenOver := deposit()
+	if takenOver.Compare(grown) <= 0 {
+		t.Fatalf("want the deposit to grow from %v, got %v", grown, takenOver)
+	}
+	assert.Equal(t, coin.Coins{&funds}, balance(t, ctrl, kv, owner.Address()))
+	assert.Equal(t, remaining(editorFunds, takenOver), balance(t, ctrl, kv, editor.Address()))
+
+	// shrinking lyrics refund the difference to the depositor
+	err = update(owner, short)
+	assert.Nil(t, err)
+	shrunk := deposit()
+	if shrunk.Compare(takenOver) >= 0 {
+		t.Fatalf("want the deposit to shrink from %v, got %v", takenOver, shrunk)
+	}
+	assert.Equal(t, coin.Coins{&funds}, balance(t, ctrl, kv, owner.Address()))
+	assert.Equal(t, remaining(editorFunds, shrunk), balance(t, ctrl, kv, editor.Address()))
+}
+
+func TestDraftDeposit(t *testing.T) {
+	owner := weavetest.NewCondition()
+
+	auth := &weavetest.Auth{Signer: owner}
+	ctrl := cash.NewController(cash.NewBucket())
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth, &weavetest.Cron{}, ctrl)
+
+	kv := store.MemStore()
+	conf := testConf()
+	conf.CountdownDeposit = coin.NewCoin(0, 10000, "IOV")
+	saveConf(t, kv, conf)
+	funds := coin.NewCoin(100, 0, "IOV")
+	err := ctrl.CoinMint(kv, owner.Address(), funds)
+	assert.Nil(t, err)
+
+	now := time.Now().Round(time.Second)
+	ctx := weave.WithBlockTime(context.Background(), now)
+
+	res, err := rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &CreateCountdownDraftMsg{
+		Metadata:           &weave.Metadata{Schema: 1},
+		Title:              "final countdown",
+		MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+	}})
+	assert.Nil(t, err)
+	draftID := res.Data
+
+	// deposit returns the draft deposit after checking it is all the deposit
+	// address holds
+	deposit := func() coin.Coin {
+		var draft Draft
+		err := NewDraftBucket().One(kv, draftID, &draft)
+		assert.Nil(t, err)
+		if draft.Deposit == nil {
+			t.Fatal("want a draft deposit")
+		}
+		assert.Equal(t, owner.Address(), draft.Depositor)
+		assert.Equal(t, coin.Coins{draft.Deposit}, balance(t, ctrl, kv, DepositAddress))
+		return *draft.Deposit
+	}
+
+	prev := deposit()
+	var hash []byte
+	for i, lines := range [][]string{lyrics[:10], lyrics[10:]} {
+		raw, err := json.Marshal(lines)
+		assert.Nil(t, err)
+		sum := sha256.Sum256(raw)
+		_, err = rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &AppendLyricsChunkMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			DraftID:  draftID,
+			Index:    int32(i),
+			Lyrics:   raw,
+			Hash:     sum[:],
+		}})
+		assert.Nil(t, err)
+		hash = NextLyricsHash(hash, raw)
+
+		// every appended chunk tops up the deposit
+		grown := deposit()
+		if grown.Compare(prev) <= 0 {
+			t.Fatalf("want the deposit to grow from %v, got %v", prev, grown)
+		}
+		prev = grown
+	}
+
+	res, err = rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &PublishCountdownMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		DraftID:    draftID,
+		LyricsHash: hash,
+	}})
+	assert.Nil(t, err)
+
+	// the draft deposit is refunded and replaced by the countdown deposit
+	var cd Countdown
+	err = NewCountdownBucket().One(kv, res.Data, &cd)
+	assert.Nil(t, err)
+	assert.Equal(t, coin.Coins{cd.Deposit}, balance(t, ctrl, kv, DepositAddress))
+	remaining, err := funds.Subtract(*cd.Deposit)
+	assert.Nil(t, err)
+	assert.Equal(t, coin.Coins{&remaining}, balance(t, ctrl, kv, owner.Address()))
+}
+
+func TestDeleteCountdownDraft(t *testing.T) {
+	owner := weavetest.NewCondition()
+	stranger := weavetest.NewCondition()
+
+	auth := &weavetest.Auth{Signer: owner}
+	ctrl := cash.NewController(cash.NewBucket())
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth, &weavetest.Cron{}, ctrl)
+
+	kv := store.MemStore()
+	conf := testConf()
+	conf.CountdownDeposit = coin.NewCoin(0, 10000, "IOV")
+	saveConf(t, kv, conf)
+	funds := coin.NewCoin(100, 0, "IOV")
+	err := ctrl.CoinMint(kv, owner.Address(), funds)
+	assert.Nil(t, err)
+
+	now := time.Now().Round(time.Second)
+	ctx := weave.WithBlockTime(context.Background(), now)
+
+	res, err := rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &CreateCountdownDraftMsg{
+		Metadata:           &weave.Metadata{Schema: 1},
+		Title:              "final countdown",
+		MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+	}})
+	assert.Nil(t, err)
+	draftID := res.Data
+
+	raw, err := json.Marshal(lyrics[:10])
+	assert.Nil(t, err)
+	sum := sha256.Sum256(raw)
+	_, err = rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &AppendLyricsChunkMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		DraftID:  draftID,
+		Lyrics:   raw,
+		Hash:     sum[:],
+	}})
+	assert.Nil(t, err)
+	if balance(t, ctrl, kv, DepositAddress) == nil {
+		t.Fatal("want a draft deposit")
+	}
+
+	del := &weavetest.Tx{Msg: &DeleteCountdownDraftMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		DraftID:  draftID,
+	}}
+
+	// only the owner can delete the draft
+	strangerRt := app.NewRouter()
+	RegisterRoutes(strangerRt, &weavetest.Auth{Signer: stranger}, &weavetest.Cron{}, ctrl)
+	_, err = strangerRt.Deliver(ctx, kv, del)
+	if !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want a stranger deletion to fail, got %+v", err)
+	}
+
+	_, err = rt.Deliver(ctx, kv, del)
+	assert.Nil(t, err)
+
+	// the draft is gone and its deposit refunded
+	var draft Draft
+	err = NewDraftBucket().One(kv, draftID, &draft)
+	if !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want the draft to be deleted, got %+v", err)
+	}
+	assert.Equal(t, coin.Coins(nil), balance(t, ctrl, kv, DepositAddress))
+	assert.Equal(t, coin.Coins{&funds}, balance(t, ctrl, kv, owner.Address()))
+}
+
+func TestUpdateConfiguration(t *testing.T) {
+	admin := weavetest.NewCondition()
+	stranger := weavetest.NewCondition()
+
+	cases := map[string]struct {
+		signer   weave.Condition
+		patch    Configuration
+		wantErr  *errors.Error
+		expected Configuration
+	}{
+		"owner changes the limits": {
+			signer: admin,
+			patch: Configuration{
+				MaxLineLength:  200,
+				RevealInterval: weave.AsUnixDuration(time.Hour),
+			},
+			expected: Configuration{
+				Owner:             admin.Address(),
+				NewUserCost:       1,
+				NewCountdownCost:  10,
+				CountdownCostUnit: 1000,
+				MinLineLength:     4,
+				MaxLineLength:     200,
+				RevealInterval:    weave.AsUnixDuration(time.Hour),
+			},
+		},
+		"invalid configuration is rejected": {
+			signer: admin,
+			patch: Configuration{
+				MaxLineLength: 2,
+			},
+			wantErr: errors.ErrInput,
+		},
+		"stranger cannot change the configuration": {
+			signer: stranger,
+			patch: Configuration{
+				MaxLineLength: 200,
+			},
+			wantErr: errors.ErrUnauthorized,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{Signer: tc.signer}
+			rt := app.NewRouter()
+			RegisterRoutes(rt, auth, &weavetest.Cron{}, cash.NewController(cash.NewBucket()))
+
+			kv := store.MemStore()
+			conf := testConf()
+			conf.Owner = admin.Address()
+			saveConf(t, kv, conf)
+
+			tx := &weavetest.Tx{Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch:    &tc.patch,
+			}}
+			if _, err := rt.Deliver(context.Background(), kv, tx); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %+v", err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+
+			stored, err := loadConf(kv)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, *stored)
+		})
+	}
+}
+
+func TestCountdownCost(t *testing.T) {
+	conf := testConf()
+	assert.Equal(t, int64(10), conf.countdownCost(make([]byte, 999)))
+	assert.Equal(t, int64(12), conf.countdownCost(make([]byte, 2000)))
+
+	conf.CountdownCostUnit = 0
+	assert.Equal(t, int64(10), conf.countdownCost(make([]byte, 2000)))
+}
+
+func TestFlagCountdown(t *testing.T) {
+	owner := weavetest.NewCondition()
+	reporter := weavetest.NewCondition()
+
+	b, err := json.Marshal(lyrics)
+	assert.Nil(t, err)
+
+	now := weave.AsUnixTime(time.Now().Round(time.Second))
+
+	cases := map[string]struct {
+		signer    weave.Condition
+		msg       *FlagCountdownMsg
+		flagged   bool
+		wantErr   *errors.Error
+		wantCount int32
+	}{
+		"flag from the main signer": {
+			signer: reporter,
+			msg: &FlagCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: weavetest.SequenceID(1),
+				Reason:      "spam",
+			},
+			wantCount: 1,
+		},
+		"reporter must authorize the flag": {
+			signer: owner,
+			msg: &FlagCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: weavetest.SequenceID(1),
+				Reporter:    reporter.Address(),
+				Reason:      "spam",
+			},
+			wantErr: errors.ErrUnauthorized,
+		},
+		"reporter can flag a countdown only once": {
+			signer: reporter,
+			msg: &FlagCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: weavetest.SequenceID(1),
+				Reason:      "spam",
+			},
+			flagged: true,
+			wantErr: errors.ErrDuplicate,
+		},
+		"countdown not found": {
+			signer: reporter,
+			msg: &FlagCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: weavetest.SequenceID(2),
+				Reason:      "spam",
+			},
+			wantErr: errors.ErrNotFound,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{Signer: tc.signer}
+			rt := app.NewRouter()
+			RegisterRoutes(rt, auth, &weavetest.Cron{}, cash.NewController(cash.NewBucket()))
+
+			kv := store.MemStore()
+			bucket := NewCountdownBucket()
+			flags := NewFlagBucket()
+
+			cd := &Countdown{
+				Metadata:           &weave.Metadata{Schema: 1},
+				ID:                 weavetest.SequenceID(1),
+				Owner:              owner.Address(),
+				Title:              "final countdown",
+				Lyrics:             b,
+				CreatedAt:          now,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				ScheduleStart:      now,
+			}
+			if tc.flagged {
+				cd.FlagCount = 1
+				err := flags.Put(kv, &Flag{
+					Metadata:    &weave.Metadata{Schema: 1},
+					ID:          flagID(cd.ID, reporter.Address()),
+					CountdownID: cd.ID,
+					Reporter:    reporter.Address(),
+					Reason:      "spam",
+					FlaggedAt:   now,
+				})
+				assert.Nil(t, err)
+			}
+			err = bucket.Put(kv, cd)
+			assert.Nil(t, err)
+
+			ctx := weave.WithBlockTime(context.Background(), now.Time())
+			if _, err := rt.Deliver(ctx, kv, &weavetest.Tx{Msg: tc.msg}); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+
+			var stored Countdown
+			err = bucket.One(kv, cd.ID, &stored)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantCount, stored.FlagCount)
+
+			var flag Flag
+			err = flags.One(kv, flagID(cd.ID, reporter.Address()), &flag)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.msg.Reason, flag.Reason)
+			assert.Equal(t, now, flag.FlaggedAt)
+		})
+	}
+}
+
+func TestModerateCountdown(t *testing.T) {
+	admin := weavetest.NewCondition()
+	moderator := weavetest.NewCondition()
+	owner := weavetest.NewCondition()
+	reporter := weavetest.NewCondition()
+
+	b, err := json.Marshal(lyrics)
+	assert.Nil(t, err)
+
+	now := weave.AsUnixTime(time.Now().Round(time.Second))
+
+	cases := map[string]struct {
+		signer     weave.Condition
+		hidden     bool
+		action     ModerationAction
+		wantErr    *errors.Error
+		wantHidden bool
+		wantFlags  int32
+		wantGone   bool
+	}{
+		"moderator hides a countdown": {
+			signer:     moderator,
+			action:     ModerationAction_Hide,
+			wantHidden: true,
+		},
+		"configuration owner can moderate": {
+			signer:     admin,
+			action:     ModerationAction_Hide,
+			wantHidden: true,
+		},
+		"countdown owner cannot moderate": {
+			signer:  owner,
+			action:  ModerationAction_Hide,
+			wantErr: errors.ErrUnauthorized,
+		},
+		"hidden countdown cannot be hidden again": {
+			signer:  moderator,
+			hidden:  true,
+			action:  ModerationAction_Hide,
+			wantErr: errors.ErrState,
+		},
+		"moderator shows a hidden countdown": {
+			signer:    moderator,
+			hidden:    true,
+			action:    ModerationAction_Show,
+			wantFlags: 1,
+		},
+		"visible countdown cannot be shown": {
+			signer:  moderator,
+			action:  ModerationAction_Show,
+			wantErr: errors.ErrState,
+		},
+		"moderator dismisses the flags": {
+			signer: moderator,
+			action: ModerationAction_Dismiss,
+		},
+		"moderator removes a countdown": {
+			signer:   moderator,
+			action:   ModerationAction_Remove,
+			wantGone: true,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{Signer: tc.signer}
+			rt := app.NewRouter()
+			RegisterRoutes(rt, auth, &weavetest.Cron{}, cash.NewController(cash.NewBucket()))
+
+			kv := store.MemStore()
+			conf := testConf()
+			conf.Owner = admin.Address()
+			conf.Moderators = []weave.Address{moderator.Address()}
+			saveConf(t, kv, conf)
+
+			bucket := NewCountdownBucket()
+			flags := NewFlagBucket()
+
+			cd := &Countdown{
+				Metadata:           &weave.Metadata{Schema: 1},
+				ID:                 weavetest.SequenceID(1),
+				Owner:              owner.Address(),
+				Title:              "final countdown",
+				Lyrics:             b,
+				CreatedAt:          now,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				ScheduleStart:      now,
+				FlagCount:          1,
+			}
+			if tc.hidden {
+				cd.HiddenAt = now
+			}
+			err := bucket.Put(kv, cd)
+			assert.Nil(t, err)
+			err = flags.Put(kv, &Flag{
+				Metadata:    &weave.Metadata{Schema: 1},
+				ID:          flagID(cd.ID, reporter.Address()),
+				CountdownID: cd.ID,
+				Reporter:    reporter.Address(),
+				Reason:      "spam",
+				FlaggedAt:   now,
+			})
+			assert.Nil(t, err)
+
+			tx := &weavetest.Tx{Msg: &ModerateCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: cd.ID,
+				Action:      tc.action,
+			}}
+			ctx := weave.WithBlockTime(context.Background(), now.Time())
+			if _, err := rt.Deliver(ctx, kv, tx); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+
+			var remaining []*Flag
+			err = flags.ByIndex(kv, "countdown", cd.ID, &remaining)
+			assert.Nil(t, err)
+			assert.Equal(t, int(tc.wantFlags), len(remaining))
+
+			var stored Countdown
+			err = bucket.One(kv, cd.ID, &stored)
+			if tc.wantGone {
+				if !errors.ErrNotFound.Is(err) {
+					t.Fatalf("want countdown to be removed, got %+v", err)
+				}
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantHidden, stored.HiddenAt != 0)
+			assert.Equal(t, tc.wantFlags, stored.FlagCount)
+		})
+	}
+}
+
+func TestQueryHiddenCountdowns(t *testing.T) {
+	owner := weavetest.NewCondition()
+
+	now := weave.AsUnixTime(time.Now().Round(time.Second))
+
+	kv := store.MemStore()
+	// the second countdown is hidden
+	putCountdowns(t, kv, owner.Address(), 1, now, nil, 0, now)
+
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+
+	cases := map[string]struct {
+		path    string
+		mod     string
+		data    []byte
+		wantIDs [][]byte
+	}{
+		"countdowns by ID skip hidden": {
+			path:    "/countdowns",
+			mod:     weave.PrefixQueryMod,
+			wantIDs: [][]byte{weavetest.SequenceID(1)},
+		},
+		"countdowns by user skip hidden": {
+			path:    "/countdowns/user",
+			data:    owner.Address(),
+			wantIDs: [][]byte{weavetest.SequenceID(1)},
+		},
+		"hidden countdowns": {
+			path:    "/hiddenCountdowns",
+			mod:     weave.PrefixQueryMod,
+			wantIDs: [][]byte{weavetest.SequenceID(2)},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			models, err := qr.Handler(tc.path).Query(kv, tc.mod, tc.data)
+			assert.Nil(t, err)
+
+			var ids [][]byte
+			for _, m := range models {
+				var cd Countdown
+				err := cd.Unmarshal(m.Value)
+				assert.Nil(t, err)
+				ids = append(ids, cd.ID)
+			}
+			assert.Equal(t, tc.wantIDs, ids)
+		})
+	}
+}
+
+func TestQueryCountdownsByStatusAndTime(t *testing.T) {
+	owner := weavetest.NewCondition()
+
+	b, err := json.Marshal(lyrics)
+	assert.Nil(t, err)
+
+	now := weave.AsUnixTime(time.Now().Round(time.Second))
+
+	kv := store.MemStore()
+	bucket := NewCountdownBucket()
+	countdowns := []*Countdown{
+		// scheduled, first reveal in half an hour
+		{NextRevealAt: now.Add(30 * time.Minute)},
+		// active, next reveal in two hours
+		{RevealedCount: 1, NextRevealAt: now.Add(2 * time.Hour)},
+		// active and hidden, next reveal in ten minutes
+		{RevealedCount: 1, NextRevealAt: now.Add(10 * time.Minute), HiddenAt: now},
+		// paused
+		{RevealedCount: 1, PausedAt: now},
+		// completed yesterday
+		{RevealedCount: int32(len(lyrics)), CompletedAt: now.Add(-24 * time.Hour)},
+		// completed a week ago
+		{RevealedCount: int32(len(lyrics)), CompletedAt: now.Add(-7 * 24 * time.Hour)},
+	}
+	for i, cd := range countdowns {
+		cd.Metadata = &weave.Metadata{Schema: countdownSchema}
+		cd.ID = weavetest.SequenceID(uint64(i + 1))
+		cd.Owner = owner.Address()
+		cd.Title = "final countdown"
+		cd.CompressedLyrics = compressLyrics(t, b)
+		cd.CreatedAt = now
+		cd.MissedRevealPolicy = MissedRevealPolicy_CatchUp
+		cd.ScheduleStart = now
+		assert.Nil(t, bucket.Put(kv, cd))
+	}
+
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+
+	cases := map[string]struct {
+		path    string
+		data    []byte
+		wantErr *errors.Error
+		wantIDs [][]byte
+	}{
+		"active countdowns skip hidden": {
+			path:    "/countdowns/status",
+			data:    StatusQuery(CountdownStatus_Active),
+			wantIDs: [][]byte{weavetest.SequenceID(2)},
+		},
+		"scheduled countdowns": {
+			path:    "/countdowns/status",
+			data:    StatusQuery(CountdownStatus_Scheduled),
+			wantIDs: [][]byte{weavetest.SequenceID(1)},
+		},
+		"paused countdowns": {
+			path:    "/countdowns/status",
+			data:    StatusQuery(CountdownStatus_Paused),
+			wantIDs: [][]byte{weavetest.SequenceID(4)},
+		},
+		"revealing in the next hour": {
+			path:    "/revealingCountdowns",
+			data:    TimeRangeQuery(now, now.Add(time.Hour)),
+			wantIDs: [][]byte{weavetest.SequenceID(1)},
+		},
+		"revealing in the next three hours in reveal order": {
+			path:    "/revealingCountdowns",
+			data:    TimeRangeQuery(now, now.Add(3*time.Hour)),
+			wantIDs: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(2)},
+		},
+		"completed in the last two days": {
+			path:    "/completedCountdowns",
+			data:    TimeRangeQuery(now.Add(-48*time.Hour), now),
+			wantIDs: [][]byte{weavetest.SequenceID(5)},
+		},
+		"range end is exclusive": {
+			path:    "/completedCountdowns",
+			data:    TimeRangeQuery(now.Add(-7*24*time.Hour), now.Add(-24*time.Hour)),
+			wantIDs: [][]byte{weavetest.SequenceID(6)},
+		},
+		"inverted range": {
+			path:    "/completedCountdowns",
+			data:    TimeRangeQuery(now, now.Add(-time.Hour)),
+			wantErr: errors.ErrInput,
+		},
+		"invalid range": {
+			path:    "/revealingCountdowns",
+			data:    []byte{1, 2, 3},
+			wantErr: errors.ErrInput,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			models, err := qr.Handler(tc.path).Query(kv, "", tc.data)
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			var ids [][]byte
+			for _, m := range models {
+				var cd Countdown
+				err := cd.Unmarshal(m.Value)
+				assert.Nil(t, err)
+				ids = append(ids, cd.ID)
+			}
+			assert.Equal(t, tc.wantIDs, ids)
+		})
+	}
+}
+
+func TestQueryCountdownProgress(t *testing.T) {
+	owner := weavetest.NewCondition()
+
+	now := weave.AsUnixTime(time.Now().Round(time.Second))
+
+	kv := store.MemStore()
+	saveConf(t, kv, testConf())
+	// the second countdown is hidden
+	putCountdowns(t, kv, owner.Address(), 1, now, func(cd *Countdown) {
+		cd.RevealedCount = 13
+		cd.TaskID = weavetest.SequenceID(1)
+		cd.NextRevealAt = now
+	}, 0, now)
+
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+
+	for _, path := range []string{"/countdownProgress", "/countdownProgress/status"} {
+		models, err := qr.Handler(path).Query(kv, weave.PrefixQueryMod, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(models))
+
+		var p CountdownProgress
+		assert.Nil(t, p.Unmarshal(models[0].Value))
+		assert.Equal(t, weavetest.SequenceID(1), p.CountdownID)
+		assert.Equal(t, CountdownStatus_Active, p.Status)
+		assert.Equal(t, int32(len(lyrics)), p.TotalLines)
+		assert.Equal(t, int32(50), p.PercentComplete)
+		assert.Equal(t, now.Add(time.Duration(len(lyrics)-14)*revealInterval), p.EstimatedCompletionAt)
+	}
+}
+
+func TestSeries(t *testing.T) {
+	owner := weavetest.NewCondition()
+	stranger := weavetest.NewCondition()
+
+	now := weave.AsUnixTime(time.Now().Round(time.Second))
+
+	auth := &weavetest.Auth{}
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth, &weavetest.Cron{}, cash.NewController(cash.NewBucket()))
+
+	kv := store.MemStore()
+	saveConf(t, kv, testConf())
+	// the last countdown is hidden
+	putCountdowns(t, kv, owner.Address(), 1, now, nil, 0, 0, 0, now)
+	id := weavetest.SequenceID
+
+	deliver := func(signer weave.Condition, msg weave.Msg) (*weave.DeliverResult, error) {
+		auth.Signer = signer
+		ctx := weave.WithBlockTime(context.Background(), now.Time())
+		return rt.Deliver(ctx, kv, &weavetest.Tx{Msg: msg})
+	}
+	members := func(seriesID []byte) [][]byte {
+		var series Series
+		err := NewSeriesBucket().One(kv, seriesID, &series)
+		assert.Nil(t, err)
+		return series.CountdownIDs
+	}
+
+	if _, err := deliver(owner, &CreateSeriesMsg{
+		Metadata:     &weave.Metadata{Schema: 1},
+		Title:        "album countdown",
+		CountdownIDs: [][]byte{id(5)},
+	}); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want unknown countdown to fail, got %+v", err)
+	}
+
+	res, err := deliver(owner, &CreateSeriesMsg{
+		Metadata:     &weave.Metadata{Schema: 1},
+		Title:        "album countdown",
+		CountdownIDs: [][]byte{id(1), id(2)},
+	})
+	assert.Nil(t, err)
+	seriesID := res.Data
+	assert.Equal(t, [][]byte{id(1), id(2)}, members(seriesID))
+
+	// only the series owner can change it
+	add := &AddSeriesCountdownMsg{Metadata: &weave.Metadata{Schema: 1}, SeriesID: seriesID, CountdownID: id(3), Position: 1}
+	if _, err := deliver(stranger, add); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+	_, err = deliver(owner, add)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{id(1), id(3), id(2)}, members(seriesID))
+	if _, err := deliver(owner, add); !errors.ErrDuplicate.Is(err) {
+		t.Fatalf("want duplicate error, got %+v", err)
+	}
+
+	// a position past the end appends
+	_, err = deliver(owner, &AddSeriesCountdownMsg{Metadata: &weave.Metadata{Schema: 1}, SeriesID: seriesID, CountdownID: id(4), Position: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{id(1), id(3), id(2), id(4)}, members(seriesID))
+
+	reorder := &ReorderSeriesMsg{Metadata: &weave.Metadata{Schema: 1}, SeriesID: seriesID, CountdownIDs: [][]byte{id(4), id(2), id(1)}}
+	if _, err := deliver(owner, reorder); !errors.ErrInput.Is(err) {
+		t.Fatalf("want incomplete order to fail, got %+v", err)
+	}
+	reorder.CountdownIDs = [][]byte{id(4), id(2), id(1), id(3)}
+	_, err = deliver(owner, reorder)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{id(4), id(2), id(1), id(3)}, members(seriesID))
+
+	// series countdowns are queried in order without hidden ones
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+	models, err := qr.Handler("/seriesCountdowns").Query(kv, "", seriesID)
+	assert.Nil(t, err)
+	var got [][]byte
+	for _, m := range models {
+		var cd Countdown
+		assert.Nil(t, cd.Unmarshal(m.Value))
+		got = append(got, cd.ID)
+	}
+	assert.Equal(t, [][]byte{id(2), id(1), id(3)}, got)
+
+	models, err = qr.Handler("/countdownSeries/countdown").Query(kv, "", id(2))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(models))
+
+	remove := &RemoveSeriesCountdownMsg{Metadata: &weave.Metadata{Schema: 1}, SeriesID: seriesID, CountdownID: id(2)}
+	_, err = deliver(owner, remove)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{id(4), id(1), id(3)}, members(seriesID))
+	if _, err := deliver(owner, remove); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want removed countdown to be missing, got %+v", err)
+	}
+
+	// deleted countdowns leave their series
+	_, err = deliver(owner, &DeleteCountdownMsg{Metadata: &weave.Metadata{Schema: 1}, ID: id(1)})
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{id(4), id(3)}, members(seriesID))
+}
+
+func TestCountdownSuccessor(t *testing.T) {
+	owner := weavetest.NewCondition()
+	stranger := weavetest.NewCondition()
+
+	b, err := json.Marshal(lyrics)
+	assert.Nil(t, err)
+
+	now := weave.AsUnixTime(time.Now().Round(time.Second))
+
+	auth := &weavetest.Auth{}
+	rt := app.NewRouter()
+	scheduler := &weavetest.Cron{}
+	ctrl := cash.NewController(cash.NewBucket())
+	RegisterRoutes(rt, auth, scheduler, ctrl)
+	RegisterCronRoutes(rt, auth, scheduler, ctrl)
+
+	kv := store.MemStore()
+	saveConf(t, kv, testConf())
+	bucket := NewCountdownBucket()
+	// the first countdown is about to reveal its last line
+	for i, revealed := range []int32{int32(len(lyrics) - 1), 0, 0} {
+		err := bucket.Put(kv, &Countdown{
+			Metadata:           &weave.Metadata{Schema: countdownSchema},
+			ID:                 weavetest.SequenceID(uint64(i + 1)),
+			Owner:              owner.Address(),
+			Title:              "final countdown",
+			CompressedLyrics:   compressLyrics(t, b),
+			RevealedCount:      revealed,
+			CreatedAt:          now,
+			MissedRevealPolicy: MissedRevealPolicy_Shift,
+			ScheduleStart:      now,
+		})
+		assert.Nil(t, err)
+	}
+	id := weavetest.SequenceID
+
+	deliver := func(signer weave.Condition, at weave.UnixTime, msg weave.Msg) error {
+		auth.Signer = signer
+		ctx := weave.WithBlockTime(context.Background(), at.Time())
+		_, err := rt.Deliver(ctx, kv, &weavetest.Tx{Msg: msg})
+		return err
+	}
+	load := func(countdownID []byte) *Countdown {
+		var cd Countdown
+		err := bucket.One(kv, countdownID, &cd)
+		assert.Nil(t, err)
+		return &cd
+	}
+	setSuccessor := func(countdownID, successorID []byte) *SetSuccessorMsg {
+		return &SetSuccessorMsg{
+			Metadata:    &weave.Metadata{Schema: 1},
+			CountdownID: countdownID,
+			SuccessorID: successorID,
+		}
+	}
+
+	if err := deliver(stranger, now, setSuccessor(id(2), id(3))); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+	err = deliver(owner, now, setSuccessor(id(2), id(3)))
+	assert.Nil(t, err)
+	assert.Equal(t, id(3), load(id(2)).SuccessorID)
+	assert.Equal(t, id(2), load(id(3)).PredecessorID)
+
+	if err := deliver(owner, now, setSuccessor(id(3), id(2))); !errors.ErrInput.Is(err) {
+		t.Fatalf("want circular chain to fail, got %+v", err)
+	}
+	if err := deliver(owner, now, setSuccessor(id(2), id(1))); !errors.ErrState.Is(err) {
+		t.Fatalf("want second successor to fail, got %+v", err)
+	}
+	if err := deliver(owner, now, setSuccessor(id(3), id(1))); !errors.ErrState.Is(err) {
+		t.Fatalf("want started successor to fail, got %+v", err)
+	}
+	err = deliver(owner, now, setSuccessor(id(1), id(2)))
+	assert.Nil(t, err)
+
+	// waiting countdowns cannot be paused
+	pause := &PauseCountdownMsg{Metadata: &weave.Metadata{Schema: 1}, CountdownID: id(3)}
+	if err := deliver(owner, now, pause); !errors.ErrState.Is(err) {
+		t.Fatalf("want waiting countdown pause to fail, got %+v", err)
+	}
+
+	// completing the first countdown starts the second one
+	completedAt := now.Add(revealInterval)
+	err = deliver(owner, completedAt, &CountdownTask{
+		Metadata:    &weave.Metadata{Schema: 1},
+		CountdownID: id(1),
+		TaskOwner:   owner.Address(),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, completedAt, load(id(1)).CompletedAt)
+	second := load(id(2))
+	assert.Equal(t, 0, len(second.PredecessorID))
+	assert.Equal(t, completedAt, second.ScheduleStart)
+	assert.Equal(t, completedAt.Add(revealInterval), second.NextRevealAt)
+	if len(second.TaskID) == 0 {
+		t.Fatal("want successor reveal to be scheduled")
+	}
+	assert.Equal(t, id(2), load(id(3)).PredecessorID)
+
+	// deleting the second countdown starts the third one right away
+	deletedAt := completedAt.Add(time.Hour)
+	err = deliver(owner, deletedAt, &DeleteCountdownMsg{Metadata: &weave.Metadata{Schema: 1}, ID: id(2)})
+	assert.Nil(t, err)
+	third := load(id(3))
+	assert.Equal(t, 0, len(third.PredecessorID))
+	assert.Equal(t, deletedAt.Add(revealInterval), third.NextRevealAt)
+}
+
+func TestRepeatCountdown(t *testing.T) {
+	owner := weavetest.NewCondition()
+	stranger := weavetest.NewCondition()
+
+	b, err := json.Marshal(lyrics)
+	assert.Nil(t, err)
+
+	now := weave.AsUnixTime(time.Now().Round(time.Second))
+
+	auth := &weavetest.Auth{}
+	rt := app.NewRouter()
+	scheduler := &weavetest.Cron{}
+	ctrl := cash.NewController(cash.NewBucket())
+	RegisterRoutes(rt, auth, scheduler, ctrl)
+	RegisterCronRoutes(rt, auth, scheduler, ctrl)
+
+	kv := store.MemStore()
+	saveConf(t, kv, testConf())
+	bucket := NewCountdownBucket()
+	cd := &Countdown{
+		Metadata:           &weave.Metadata{Schema: countdownSchema},
+		ID:                 weavetest.SequenceID(1),
+		Owner:              owner.Address(),
+		Title:              "final countdown",
+		CompressedLyrics:   compressLyrics(t, b),
+		RevealedCount:      int32(len(lyrics) - 1),
+		CreatedAt:          now,
+		MissedRevealPolicy: MissedRevealPolicy_Shift,
+		ScheduleStart:      now,
+		RepeatPolicy:       RepeatPolicy_Times,
+		RepeatCount:        1,
+	}
+	err = bucket.Put(kv, cd)
+	assert.Nil(t, err)
+
+	deliver := func(signer weave.Condition, at weave.UnixTime, msg weave.Msg) error {
+		auth.Signer = signer
+		ctx := weave.WithBlockTime(context.Background(), at.Time())
+		ctx = weave.WithHeight(ctx, 42)
+		_, err := rt.Deliver(ctx, kv, &weavetest.Tx{Msg: msg})
+		return err
+	}
+	reveal := func(at weave.UnixTime) {
+		err := deliver(owner, at, &CountdownTask{
+			Metadata:    &weave.Metadata{Schema: 1},
+			CountdownID: cd.ID,
+			TaskOwner:   cd.Owner,
+		})
+		assert.Nil(t, err)
+	}
+	load := func() *Countdown {
+		var stored Countdown
+		err := bucket.One(kv, cd.ID, &stored)
+		assert.Nil(t, err)
+		return &stored
+	}
+	restart := &RestartCountdownMsg{Metadata: &weave.Metadata{Schema: 1}, CountdownID: cd.ID}
+
+	if err := deliver(owner, now, restart); !errors.ErrState.Is(err) {
+		t.Fatalf("want restart of a running countdown to fail, got %+v", err)
+	}
+
+	// the completion schedules the next iteration
+	completedAt := now.Add(revealInterval)
+	reveal(completedAt)
+	stored := load()
+	assert.Equal(t, completedAt, stored.CompletedAt)
+	assert.Equal(t, completedAt.Add(revealInterval), stored.NextRevealAt)
+	assert.Equal(t, int32(0), stored.Iteration)
+
+	// the next iteration starts with the first line
+	reveal(completedAt.Add(revealInterval))
+	stored = load()
+	assert.Equal(t, weave.UnixTime(0), stored.CompletedAt)
+	assert.Equal(t, int32(1), stored.Iteration)
+	assert.Equal(t, int32(1), stored.RevealedCount)
+	assert.Equal(t, 1, len(stored.Reveals))
+	assert.Equal(t, completedAt, stored.ScheduleStart)
+
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+	models, err := qr.Handler("/countdownProgress").Query(kv, "", cd.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(models))
+	var progress CountdownProgress
+	assert.Nil(t, progress.Unmarshal(models[0].Value))
+	assert.Equal(t, int32(1), progress.Iteration)
+
+	// the last iteration completes for good
+	stored.RevealedCount = int32(len(lyrics) - 1)
+	err = bucket.Put(kv, stored)
+	assert.Nil(t, err)
+	lastCompletedAt := completedAt.Add(3 * revealInterval)
+	reveal(lastCompletedAt)
+	stored = load()
+	assert.Equal(t, lastCompletedAt, stored.CompletedAt)
+	assert.Equal(t, 0, len(stored.TaskID))
+	if err := deliver(owner, lastCompletedAt, restart); !errors.ErrState.Is(err) {
+		t.Fatalf("want restart without iterations left to fail, got %+v", err)
+	}
+
+	// managers restart a completed countdown right away
+	stored.RepeatPolicy = RepeatPolicy_Forever
+	stored.RepeatCount = 0
+	err = bucket.Put(kv, stored)
+	assert.Nil(t, err)
+	restartedAt := lastCompletedAt.Add(time.Hour)
+	if err := deliver(stranger, restartedAt, restart); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+	err = deliver(owner, restartedAt, restart)
+	assert.Nil(t, err)
+	stored = load()
+	assert.Equal(t, weave.UnixTime(0), stored.CompletedAt)
+	assert.Equal(t, int32(2), stored.Iteration)
+	assert.Equal(t, int32(0), stored.RevealedCount)
+	assert.Equal(t, restartedAt, stored.ScheduleStart)
+	assert.Equal(t, restartedAt.Add(revealInterval), stored.NextRevealAt)
+}
+
+func TestForkCountdown(t *testing.T) {
+	author := weavetest.NewCondition()
+	forker := weavetest.NewCondition()
+
+	mediaHash := sha256.Sum256([]byte("media"))
+
+	now := weave.AsUnixTime(time.Now().Round(time.Second))
+
+	auth := &weavetest.Auth{Signer: forker}
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth, &weavetest.Cron{}, cash.NewController(cash.NewBucket()))
+
+	kv := store.MemStore()
+	saveConf(t, kv, testConf())
+	bucket := NewCountdownBucket()
+	// the second countdown is hidden
+	putCountdowns(t, kv, author.Address(), 1, now, func(cd *Countdown) {
+		cd.RevealedCount = 3
+		cd.Attachments = []*Attachment{
+			{Line: 1, Hash: mediaHash[:], MimeType: "image/png"},
+		}
+	}, 0, now)
+	id := weavetest.SequenceID
+
+	fork := func(msg *ForkCountdownMsg) (*Countdown, error) {
+		msg.Metadata = &weave.Metadata{Schema: 1}
+		msg.Title = "remixed countdown"
+		msg.MissedRevealPolicy = MissedRevealPolicy_Shift
+		ctx := weave.WithBlockTime(context.Background(), now.Time())
+		res, err := rt.Deliver(ctx, kv, &weavetest.Tx{Msg: msg})
+		if err != nil {
+			return nil, err
+		}
+		var cd Countdown
+		err = bucket.One(kv, res.Data, &cd)
+		assert.Nil(t, err)
+		return &cd, nil
+	}
+
+	cd, err := fork(&ForkCountdownMsg{
+		SourceID: id(1),
+		Lines:    []int32{2, 1},
+		Edits:    []*LineEdit{{Line: 0, Text: "But still it's a new start"}},
+	})
+	assert.Nil(t, err)
+	lines, err := cd.Lines()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"But still it's a new start", lyrics[1]}, lines)
+	assert.Equal(t, forker.Address(), cd.Owner)
+	assert.Equal(t, id(1), cd.SourceID)
+	assert.Equal(t, author.Address(), cd.SourceOwner)
+	assert.Equal(t, int32(0), cd.RevealedCount)
+	assert.Equal(t, now.Add(revealInterval), cd.NextRevealAt)
+	assert.Equal(t, []*Attachment{{Line: 1, Hash: mediaHash[:], MimeType: "image/png"}}, cd.Attachments)
+
+	// all revealed lines are copied by default
+	cd, err = fork(&ForkCountdownMsg{SourceID: id(1)})
+	assert.Nil(t, err)
+	lines, err = cd.Lines()
+	assert.Nil(t, err)
+	assert.Equal(t, lyrics[:3], lines)
+
+	if _, err := fork(&ForkCountdownMsg{SourceID: id(1), Lines: []int32{3}}); !errors.ErrInput.Is(err) {
+		t.Fatalf("want unrevealed line to fail, got %+v", err)
+	}
+	if _, err := fork(&ForkCountdownMsg{SourceID: id(2)}); !errors.ErrState.Is(err) {
+		t.Fatalf("want hidden source to fail, got %+v", err)
+	}
+
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+	models, err := qr.Handler("/countdowns/forks").Query(kv, "", id(1))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(models))
+}
+
+func TestQueryCountdownsByTag(t *testing.T) {
+	owner := weavetest.NewCondition()
+
+	b, err := json.Marshal(lyrics)
+	assert.Nil(t, err)
+
+	now := weave.AsUnixTime(time.Now().Round(time.Second))
+
+	auth := &weavetest.Auth{Signer: owner}
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth, &weavetest.Cron{}, cash.NewController(cash.NewBucket()))
+
+	kv := store.MemStore()
+	saveConf(t, kv, testConf())
+
+	ctx := weave.WithBlockTime(context.Background(), now.Time())
+	res, err := rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &CreateCountdownMsg{
+		Metadata:           &weave.Metadata{Schema: 1},
+		Title:              "final countdown",
+		Lyrics:             b,
+		MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+		Tags:               []string{"rock", "80s"},
+	}})
+	assert.Nil(t, err)
+
+	// the second stored countdown is hidden
+	putCountdowns(t, kv, owner.Address(), 2, now, func(cd *Countdown) {
+		cd.Tags = []string{"synth-pop", "80s"}
+	}, 0, now)
+
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+	ids := func(path, tag string) [][]byte {
+		models, err := qr.Handler(path).Query(kv, "", []byte(NormalizeTag(tag)))
+		assert.Nil(t, err)
+		var got [][]byte
+		for _, m := range models {
+			var cd Countdown
+			assert.Nil(t, cd.Unmarshal(m.Value))
+			got = append(got, cd.ID)
+		}
+		return got
+	}
+
+	assert.Equal(t, [][]byte{res.Data, weavetest.SequenceID(2)}, ids("/countdowns/tag", "80s"))
+	assert.Equal(t, [][]byte{weavetest.SequenceID(2)}, ids("/countdowns/tag", "Synth Pop"))
+	assert.Equal(t, [][]byte{res.Data}, ids("/countdowns/tag", "rock"))
+	assert.Equal(t, 0, len(ids("/countdowns/tag", "jazz")))
+
+	models, err := qr.Handler("/countdownProgress/tag").Query(kv, "", []byte("rock"))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(models))
+}
+
+func TestMigrateCountdowns(t *testing.T) {
+	confOwner := weavetest.NewCondition()
+	migrationAdmin := weavetest.NewCondition()
+	moderator := weavetest.NewCondition()
+	owner := weavetest.NewCondition()
+
+	b, err := json.Marshal(lyrics)
+	assert.Nil(t, err)
+
+	now := weave.AsUnixTime(time.Now().Round(time.Second))
+
+	cases := map[string]struct {
+		signer      weave.Condition
+		msg         *MigrateCountdownsMsg
+		wantErr     *errors.Error
+		wantSchemas []uint32
+	}{
+		"all countdowns are migrated": {
+			signer:      confOwner,
+			msg:         &MigrateCountdownsMsg{StartID: weavetest.SequenceID(1), Limit: 3},
+			wantSchemas: []uint32{countdownSchema, countdownSchema},
+		},
+		"countdowns out of range are left": {
+			signer:      confOwner,
+			msg:         &MigrateCountdownsMsg{StartID: weavetest.SequenceID(2), Limit: 1},
+			wantSchemas: []uint32{1, countdownSchema},
+		},
+		"migration admin can migrate": {
+			signer:      migrationAdmin,
+			msg:         &MigrateCountdownsMsg{StartID: weavetest.SequenceID(1), Limit: 3},
+			wantSchemas: []uint32{countdownSchema, countdownSchema},
+		},
+		"moderator cannot migrate": {
+			signer:  moderator,
+			msg:     &MigrateCountdownsMsg{StartID: weavetest.SequenceID(1), Limit: 3},
+			wantErr: errors.ErrUnauthorized,
+		},
+		"countdown owner cannot migrate": {
+			signer:  owner,
+			msg:     &MigrateCountdownsMsg{StartID: weavetest.SequenceID(1), Limit: 3},
+			wantErr: errors.ErrUnauthorized,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{Signer: tc.signer}
+			rt := app.NewRouter()
+			RegisterRoutes(rt, auth, &weavetest.Cron{}, cash.NewController(cash.NewBucket()))
+
+			kv := store.MemStore()
+			conf := testConf()
+			conf.Owner = confOwner.Address()
+			conf.Moderators = []weave.Address{moderator.Address()}
+			saveConf(t, kv, conf)
+			err := gconf.Save(kv, "migration", &migration.Configuration{
+				Metadata: &weave.Metadata{Schema: 1},
+				Admin:    migrationAdmin.Address(),
+			})
+			assert.Nil(t, err)
+
+			bucket := NewCountdownBucket()
+			err = bucket.Put(kv, &Countdown{
+				Metadata:           &weave.Metadata{Schema: 1},
+				ID:                 weavetest.SequenceID(1),
+				Owner:              owner.Address(),
+				Title:              "final countdown",
+				Lyrics:             b,
+				CreatedAt:          now,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				ScheduleStart:      now,
+			})
+			assert.Nil(t, err)
+
+			// a legacy schema 1 record predating the schedule start and
+			// the missed reveal policy, it is not valid for the current
+			// model and can only be written raw
+			legacy := Countdown{
+				Metadata:  &weave.Metadata{Schema: 1},
+				ID:        weavetest.SequenceID(2),
+				Owner:     owner.Address(),
+				Title:     "final countdown",
+				Lyrics:    b,
+				CreatedAt: now,
+			}
+			raw, err := legacy.Marshal()
+			assert.Nil(t, err)
+			assert.Nil(t, kv.Set(append([]byte("countdown:"), legacy.ID...), raw))
+
+			tc.msg.Metadata = &weave.Metadata{Schema: 1}
+			ctx := weave.WithBlockTime(context.Background(), now.Time())
+			if _, err := rt.Deliver(ctx, kv, &weavetest.Tx{Msg: tc.msg}); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+
+			// read the stored records, One would migrate them
+			var schemas []uint32
+			var stored []Countdown
+			for i := 1; i <= 2; i++ {
+				raw, err := kv.Get(append([]byte("countdown:"), weavetest.SequenceID(uint64(i))...))
+				assert.Nil(t, err)
+				var cd Countdown
+				assert.Nil(t, cd.Unmarshal(raw))
+				schemas = append(schemas, cd.Metadata.Schema)
+				stored = append(stored, cd)
+			}
+			assert.Equal(t, tc.wantSchemas, schemas)
+
+			migrated := stored[1]
+			assert.Equal(t, now, migrated.ScheduleStart)
+			assert.Equal(t, MissedRevealPolicy_CatchUp, migrated.MissedRevealPolicy)
+			assert.Nil(t, migrated.Validate())
+		})
+	}
+}
+
+// revealInterval is the reveal interval of the test configuration.
+const revealInterval = 24 * time.Hour
+
+// testConf returns a valid configuration without storage deposits.
+func testConf() Configuration {
+	return Configuration{
+		NewUserCost:       1,
+		NewCountdownCost:  10,
+		CountdownCostUnit: 1000,
+		MinLineLength:     4,
+		MaxLineLength:     1000,
+		RevealInterval:    weave.AsUnixDuration(revealInterval),
+	}
+}
+
+// putCountdowns stores a countdown of the owner with the test lyrics, created
+// and started at now, for each given hiding time. IDs are consecutive from
+// firstID on, countdowns with a zero hiding time are visible. The optional
+// with function sets further fields before a countdown is stored.
+func putCountdowns(t testing.TB, kv weave.KVStore, owner weave.Address, firstID uint64, now weave.UnixTime, with func(*Countdown), hiddenAt ...weave.UnixTime) {
+	t.Helper()
+	raw, err := json.Marshal(lyrics)
+	if err != nil {
+		t.Fatalf("cannot marshal lyrics: %s", err)
+	}
+	bucket := NewCountdownBucket()
+	for i, at := range hiddenAt {
+		cd := &Countdown{
+			Metadata:           &weave.Metadata{Schema: countdownSchema},
+			ID:                 weavetest.SequenceID(firstID + uint64(i)),
+			Owner:              owner,
+			Title:              "final countdown",
+			CompressedLyrics:   compressLyrics(t, raw),
+			CreatedAt:          now,
+			MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+			ScheduleStart:      now,
+			HiddenAt:           at,
+		}
+		if with != nil {
+			with(cd)
+		}
+		if err := bucket.Put(kv, cd); err != nil {
+			t.Fatalf("cannot store countdown: %s", err)
+		}
+	}
+}
+
+// saveConf stores the countdown module configuration.
+func saveConf(t testing.TB, kv weave.KVStore, conf Configuration) {
+	t.Helper()
+	if err := gconf.Save(kv, packageName, &conf); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+}
@@ -0,0 +1,18 @@
+package countdown
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/gconf"
+)
+
+// Initializer fulfils the Initializer interface to load data from the genesis
+// file
+type Initializer struct{}
+
+var _ weave.Initializer = (*Initializer)(nil)
+
+// FromGenesis loads the countdown module configuration from the genesis file
+func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
+	var conf Configuration
+	return gconf.InitConfig(kv, opts, packageName, &conf)
+}
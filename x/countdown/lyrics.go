@@ -0,0 +1,132 @@
+package countdown
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+)
+
+const (
+	// maxLyricsSize is the maximum size of the JSON encoded lyrics of a
+	// countdown. It bounds the memory used to decompress stored lyrics.
+	maxLyricsSize = 1 << 20
+
+	// countdownSchema is the current schema version of the countdown model.
+	// Schema 1 stores the lyrics and the revealed lines as JSON, schema 2
+	// stores compressed lyrics and the number of revealed lines.
+	countdownSchema = 2
+)
+
+func init() {
+	migration.MustRegister(1, &Countdown{}, migration.NoModification)
+	migration.MustRegister(2, &Countdown{}, migrateCountdownLyrics)
+}
+
+// migrateCountdownLyrics compresses the lyrics of a schema 1 countdown,
+// replaces its revealed lines with their number and fills in the schedule
+// fields missing from legacy countdowns.
+func migrateCountdownLyrics(db weave.ReadOnlyKVStore, m migration.Migratable) error {
+	cd, ok := m.(*Countdown)
+	if !ok {
+		return errors.Wrapf(errors.ErrState, "expected countdown, got %T", m)
+	}
+
+	var revealed []string
+	if len(cd.Countdown) != 0 {
+		if err := json.Unmarshal(cd.Countdown, &revealed); err != nil {
+			return errors.Wrap(errors.ErrState, "cannot unmarshal revealed lyrics")
+		}
+	}
+	if err := cd.SetLyrics(cd.Lyrics); err != nil {
+		return err
+	}
+	cd.RevealedCount = int32(len(revealed))
+	cd.Lyrics = nil
+	cd.Countdown = nil
+
+	// schema 1 countdowns may predate the schedule start and the missed
+	// reveal policy, they started when they were created and caught up
+	// missed reveals
+	if cd.ScheduleStart == 0 {
+		cd.ScheduleStart = cd.CreatedAt
+	}
+	if cd.MissedRevealPolicy == MissedRevealPolicy_Invalid {
+		cd.MissedRevealPolicy = MissedRevealPolicy_CatchUp
+	}
+	return nil
+}
+
+// migrateCountdown upgrades a countdown loaded from the store to the current
+// schema. Migrated countdowns are persisted the next time they are stored.
+func migrateCountdown(db weave.ReadOnlyKVStore, cd *Countdown) error {
+	if cd.Metadata == nil || cd.Metadata.Schema >= countdownSchema {
+		return nil
+	}
+	if cd.Metadata.Schema < 2 {
+		if err := migrateCountdownLyrics(db, cd); err != nil {
+			return errors.Wrapf(err, "cannot migrate countdown with ID %s", cd.ID)
+		}
+	}
+	cd.Metadata.Schema = countdownSchema
+	return nil
+}
+
+// SetLyrics stores the JSON encoded lyrics compressed. Every node must produce
+// the same compressed bytes, which holds as long as all of them run the same
+// binary.
+func (m *Countdown) SetLyrics(raw []byte) error {
+	if len(raw) > maxLyricsSize {
+		return errors.Field("Lyrics", errors.ErrInput, "too long")
+	}
+	var buf bytes.Buffer
+	w, err := flate.NewWriter(&buf, flate.BestCompression)
+	if err != nil {
+		return errors.Wrap(errors.ErrHuman, "cannot create compressor")
+	}
+	if _, err := w.Write(raw); err != nil {
+		return errors.Wrap(errors.ErrHuman, "cannot compress lyrics")
+	}
+	if err := w.Close(); err != nil {
+		return errors.Wrap(errors.ErrHuman, "cannot compress lyrics")
+	}
+	m.CompressedLyrics = buf.Bytes()
+	return nil
+}
+
+// LyricsJSON returns the JSON encoded lyrics of the countdown.
+func (m *Countdown) LyricsJSON() ([]byte, error) {
+	return decompressLyrics(m.CompressedLyrics)
+}
+
+// decompressLyrics returns the JSON encoded lyrics. It fails if the lyrics
+// are longer than maxLyricsSize once decompressed.
+func decompressLyrics(compressed []byte) ([]byte, error) {
+	if len(compressed) == 0 {
+		return nil, errors.Field("CompressedLyrics", errors.ErrEmpty, "required")
+	}
+	r := flate.NewReader(bytes.NewReader(compressed))
+	defer r.Close()
+	raw, err := ioutil.ReadAll(io.LimitReader(r, maxLyricsSize+1))
+	if err != nil {
+		return nil, errors.Field("CompressedLyrics", errors.ErrInput, "cannot decompress")
+	}
+	if len(raw) > maxLyricsSize {
+		return nil, errors.Field("CompressedLyrics", errors.ErrInput, "too long once decompressed")
+	}
+	return raw, nil
+}
+
+// lyricsLines returns the lines of JSON encoded lyrics.
+func lyricsLines(raw []byte) ([]string, error) {
+	var lines []string
+	if err := json.Unmarshal(raw, &lines); err != nil {
+		return nil, errors.Wrap(errors.ErrState, "cannot unmarshal lyrics")
+	}
+	return lines, nil
+}
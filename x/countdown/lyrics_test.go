@@ -0,0 +1,95 @@
+package countdown
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestMigrateCountdown(t *testing.T) {
+	b, err := json.Marshal(lyrics)
+	assert.Nil(t, err)
+	revealed, err := json.Marshal(lyrics[:3])
+	assert.Nil(t, err)
+
+	kv := store.MemStore()
+	bucket := NewCountdownBucket()
+
+	now := weave.AsUnixTime(time.Now().Round(time.Second))
+	legacy := &Countdown{
+		Metadata:           &weave.Metadata{Schema: 1},
+		ID:                 weavetest.SequenceID(1),
+		Owner:              weavetest.NewCondition().Address(),
+		Title:              "final countdown",
+		Lyrics:             b,
+		Countdown:          revealed,
+		CreatedAt:          now,
+		MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+		ScheduleStart:      now,
+	}
+	err = bucket.Put(kv, legacy)
+	assert.Nil(t, err)
+
+	var cd Countdown
+	err = bucket.One(kv, legacy.ID, &cd)
+	assert.Nil(t, err)
+	assert.Nil(t, cd.Validate())
+	assert.Equal(t, uint32(countdownSchema), cd.Metadata.Schema)
+	assert.Equal(t, int32(3), cd.RevealedCount)
+	assert.Equal(t, 0, len(cd.Lyrics))
+	assert.Equal(t, 0, len(cd.Countdown))
+
+	raw, err := cd.LyricsJSON()
+	assert.Nil(t, err)
+	assert.Equal(t, b, raw)
+	got, err := cd.RevealedLines()
+	assert.Nil(t, err)
+	assert.Equal(t, lyrics[:3], got)
+
+	if len(cd.CompressedLyrics) >= len(b) {
+		t.Fatalf("want compressed lyrics shorter than %d bytes, got %d", len(b), len(cd.CompressedLyrics))
+	}
+
+	// queries return migrated countdowns
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+	models, err := qr.Handler("/countdowns").Query(kv, "", legacy.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(models))
+	var queried Countdown
+	assert.Nil(t, queried.Unmarshal(models[0].Value))
+	assert.Equal(t, &cd, &queried)
+}
+
+func TestDecompressLyricsLimit(t *testing.T) {
+	var cd Countdown
+	if err := cd.SetLyrics(make([]byte, maxLyricsSize+1)); !errors.ErrInput.Is(err) {
+		t.Fatalf("want oversized lyrics to fail, got %+v", err)
+	}
+
+	// a small payload expanding beyond the limit is rejected
+	var buf bytes.Buffer
+	w, err := flate.NewWriter(&buf, flate.BestCompression)
+	assert.Nil(t, err)
+	_, err = w.Write(bytes.Repeat([]byte{' '}, maxLyricsSize+1))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Close())
+
+	cd.CompressedLyrics = buf.Bytes()
+	if _, err := cd.LyricsJSON(); !errors.ErrInput.Is(err) {
+		t.Fatalf("want a decompression bomb to fail, got %+v", err)
+	}
+
+	cd.CompressedLyrics = []byte("not compressed")
+	if _, err := cd.LyricsJSON(); !errors.ErrInput.Is(err) {
+		t.Fatalf("want corrupted lyrics to fail, got %+v", err)
+	}
+}
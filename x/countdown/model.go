@@ -1,11 +1,21 @@
 package countdown
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/json"
+	"io"
+	"mime"
 	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/iov-one/blog-tutorial/morm"
 
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/orm"
 )
@@ -25,6 +35,8 @@ func (m *User) Copy() orm.CloneableData {
 		ID:           copyBytes(m.ID),
 		Username:     m.Username,
 		RegisteredAt: m.RegisteredAt,
+		Owner:        m.Owner.Clone(),
+		Deposit:      copyCoin(m.Deposit),
 	}
 }
 
@@ -46,6 +58,12 @@ func (m *User) Validate() error {
 		errs = errors.AppendField(errs, "RegisteredAt", errors.ErrEmpty)
 	}
 
+	// users registered before deposits were introduced have no owner
+	if len(m.Owner) != 0 {
+		errs = errors.AppendField(errs, "Owner", m.Owner.Validate())
+	}
+	errs = errors.AppendField(errs, "Deposit", validateDeposit(m.Deposit))
+
 	return errs
 }
 
@@ -60,19 +78,48 @@ func (m *Countdown) SetID(id []byte) error {
 // Copy produces a new copy to fulfill the Model interface
 func (m *Countdown) Copy() orm.CloneableData {
 	return &Countdown{
-		Metadata:  m.Metadata.Copy(),
-		ID:        copyBytes(m.ID),
-		Owner:     m.Owner.Clone(),
-		Title:     m.Title,
-		Lyrics:    copyBytes(m.Lyrics),
-		Countdown: copyBytes(m.Countdown),
-		CreatedAt: m.CreatedAt,
+		Metadata:           m.Metadata.Copy(),
+		ID:                 copyBytes(m.ID),
+		Owner:              m.Owner.Clone(),
+		Title:              m.Title,
+		Lyrics:             copyBytes(m.Lyrics),
+		Countdown:          copyBytes(m.Countdown),
+		CreatedAt:          m.CreatedAt,
+		CompletedAt:        m.CompletedAt,
+		DeleteAt:           m.DeleteAt,
+		MissedRevealPolicy: m.MissedRevealPolicy,
+		Reveals:            copyReveals(m.Reveals),
+		Editors:            copyEditors(m.Editors),
+		TaskID:             copyBytes(m.TaskID),
+		PausedAt:           m.PausedAt,
+		ScheduleStart:      m.ScheduleStart,
+		NextRevealAt:       m.NextRevealAt,
+		PendingOwner:       m.PendingOwner.Clone(),
+		TipTotal:           copyCoins(m.TipTotal),
+		TopTippers:         copyTopTippers(m.TopTippers),
+		Bounty:             copyCoins(m.Bounty),
+		Beneficiary:        m.Beneficiary.Clone(),
+		Sponsor:            m.Sponsor.Clone(),
+		UnlockPrice:        copyCoin(m.UnlockPrice),
+		UnlockedRevealAt:   m.UnlockedRevealAt,
+		Deposit:            copyCoin(m.Deposit),
+		Depositor:          m.Depositor.Clone(),
+		FlagCount:          m.FlagCount,
+		HiddenAt:           m.HiddenAt,
+		Attachments:        copyAttachments(m.Attachments),
+		CompressedLyrics:   copyBytes(m.CompressedLyrics),
+		RevealedCount:      m.RevealedCount,
+		SuccessorID:        copyBytes(m.SuccessorID),
+		PredecessorID:      copyBytes(m.PredecessorID),
+		RepeatPolicy:       m.RepeatPolicy,
+		RepeatCount:        m.RepeatCount,
+		Iteration:          m.Iteration,
+		SourceID:           copyBytes(m.SourceID),
+		SourceOwner:        m.SourceOwner.Clone(),
+		Tags:               copyStrings(m.Tags),
 	}
 }
 
-var validCountdownTitle = regexp.MustCompile(`^[a-zA-Z0-9$@$!%*?&#'^;-_. +]{4,32}$`).MatchString
-var validCountdownLyrics = regexp.MustCompile(`^[a-zA-Z0-9$@$!%*?&#'^;-_. +]{4,1000}$`).MatchString
-
 // Validate validates countdown's fields
 func (m *Countdown) Validate() error {
 	var errs error
@@ -80,25 +127,566 @@ func (m *Countdown) Validate() error {
 	errs = errors.AppendField(errs, "ID", isGenID(m.ID, false))
 	errs = errors.AppendField(errs, "Owner", m.Owner.Validate())
 
-	if !validCountdownTitle(m.Title) {
-		errs = errors.AppendField(errs, "Title", errors.ErrModel)
+	errs = errors.AppendField(errs, "Title", validateTitle(m.Title))
+
+	var lines []string
+	if m.Metadata != nil && m.Metadata.Schema < 2 {
+		errs = errors.Append(errs, validateLyrics(m.Lyrics))
+		lines, _ = lyricsLines(m.Lyrics)
+	} else {
+		if len(m.Lyrics) != 0 {
+			errs = errors.AppendField(errs, "Lyrics", errors.Wrap(errors.ErrInput, "replaced by compressed lyrics"))
+		}
+		if len(m.Countdown) != 0 {
+			errs = errors.AppendField(errs, "Countdown", errors.Wrap(errors.ErrInput, "replaced by revealed count"))
+		}
+		if raw, err := m.LyricsJSON(); err != nil {
+			errs = errors.Append(errs, err)
+		} else if err := validateLyrics(raw); err != nil {
+			errs = errors.Append(errs, err)
+		} else {
+			lines, _ = lyricsLines(raw)
+			if m.RevealedCount < 0 || int(m.RevealedCount) > len(lines) {
+				errs = errors.AppendField(errs, "RevealedCount", errors.Wrap(errors.ErrInput, "out of range"))
+			}
+		}
+	}
+
+	if err := m.CreatedAt.Validate(); err != nil {
+		errs = errors.AppendField(errs, "CreatedAt", err)
+	} else if m.CreatedAt == 0 {
+		errs = errors.AppendField(errs, "CreatedAt", errors.ErrEmpty)
+	}
+
+	errs = errors.AppendField(errs, "MissedRevealPolicy", m.MissedRevealPolicy.Validate())
+
+	for i, r := range m.Reveals {
+		errs = errors.AppendField(errs, "Reveals."+strconv.Itoa(i), r.Validate())
+	}
+
+	seen := make(map[string]bool, len(m.Editors))
+	for i, e := range m.Editors {
+		field := "Editors." + strconv.Itoa(i)
+		if err := e.Validate(); err != nil {
+			errs = errors.AppendField(errs, field, err)
+		} else if seen[e.Address.String()] {
+			errs = errors.AppendField(errs, field, errors.Wrap(errors.ErrDuplicate, "address already has a role"))
+		}
+		if e != nil {
+			seen[e.Address.String()] = true
+		}
+	}
+
+	errs = errors.AppendField(errs, "PausedAt", m.PausedAt.Validate())
+
+	if err := m.ScheduleStart.Validate(); err != nil {
+		errs = errors.AppendField(errs, "ScheduleStart", err)
+	} else if m.ScheduleStart == 0 {
+		errs = errors.AppendField(errs, "ScheduleStart", errors.ErrEmpty)
 	}
 
-	if len(m.Lyrics) == 0 {
-		errs = errors.AppendField(errs, "Lyrics", errors.ErrEmpty)
+	errs = errors.AppendField(errs, "NextRevealAt", m.NextRevealAt.Validate())
+
+	if len(m.PendingOwner) != 0 {
+		errs = errors.AppendField(errs, "PendingOwner", m.PendingOwner.Validate())
+	}
+
+	errs = errors.AppendField(errs, "TipTotal", validateTips(m.TipTotal))
+
+	for i, t := range m.TopTippers {
+		errs = errors.AppendField(errs, "TopTippers."+strconv.Itoa(i), t.Validate())
+	}
+
+	errs = errors.Append(errs, validateBounty(m.Bounty, m.Beneficiary, m.Sponsor))
+
+	if m.UnlockPrice != nil {
+		errs = errors.AppendField(errs, "UnlockPrice", validateUnlockPrice(m.UnlockPrice))
+	}
+	errs = errors.AppendField(errs, "UnlockedRevealAt", m.UnlockedRevealAt.Validate())
+
+	errs = errors.AppendField(errs, "Deposit", validateDeposit(m.Deposit))
+	if m.Deposit != nil {
+		errs = errors.AppendField(errs, "Depositor", m.Depositor.Validate())
+	}
+
+	if m.FlagCount < 0 {
+		errs = errors.AppendField(errs, "FlagCount", errors.ErrInput)
+	}
+	errs = errors.AppendField(errs, "HiddenAt", m.HiddenAt.Validate())
+
+	errs = errors.Append(errs, validateAttachments(m.Attachments))
+	if lines != nil {
+		errs = errors.Append(errs, checkAttachmentLines(m.Attachments, len(lines)))
+	}
+
+	errs = errors.AppendField(errs, "SuccessorID", isGenID(m.SuccessorID, true))
+	errs = errors.AppendField(errs, "PredecessorID", isGenID(m.PredecessorID, true))
+	if len(m.PredecessorID) != 0 && (m.RevealedCount != 0 || len(m.TaskID) != 0) {
+		errs = errors.AppendField(errs, "PredecessorID", errors.Wrap(errors.ErrState, "countdown already started"))
+	}
+
+	errs = errors.Append(errs, validateRepeat(m.RepeatPolicy, m.RepeatCount))
+	if m.Iteration < 0 || (m.RepeatPolicy == RepeatPolicy_Times && m.Iteration > m.RepeatCount) {
+		errs = errors.AppendField(errs, "Iteration", errors.Wrap(errors.ErrInput, "out of range"))
+	}
+
+	errs = errors.AppendField(errs, "SourceID", isGenID(m.SourceID, true))
+	if len(m.SourceID) != 0 {
+		errs = errors.AppendField(errs, "SourceOwner", m.SourceOwner.Validate())
+	} else if len(m.SourceOwner) != 0 {
+		errs = errors.AppendField(errs, "SourceOwner", errors.Wrap(errors.ErrInput, "not a fork"))
+	}
+
+	errs = errors.Append(errs, validateTags(m.Tags))
+
+	return errs
+}
+
+// CanRepeat returns true if the repeat policy allows the countdown to start
+// over once more.
+func (m *Countdown) CanRepeat() bool {
+	switch m.RepeatPolicy {
+	case RepeatPolicy_Forever:
+		return true
+	case RepeatPolicy_Times:
+		return m.Iteration < m.RepeatCount
+	default:
+		return false
 	}
+}
+
+// Restart starts the next iteration of the countdown. The revealed lines are
+// reset and the reveal schedule is counted from start. The countdown must be
+// scheduled and stored afterwards.
+func (m *Countdown) Restart(start weave.UnixTime) {
+	m.Iteration++
+	m.RevealedCount = 0
+	m.Reveals = nil
+	m.CompletedAt = 0
+	m.UnlockedRevealAt = 0
+	m.ScheduleStart = start
+}
+
+// WaitsForPredecessor returns true if the countdown starts once another
+// countdown completes.
+func (m *Countdown) WaitsForPredecessor() bool {
+	return len(m.PredecessorID) != 0
+}
+
+// LineAttachment returns the attachment of the given line, nil if the line has
+// no attachment.
+func (m *Countdown) LineAttachment(line int) *Attachment {
+	i := sort.Search(len(m.Attachments), func(i int) bool {
+		return int(m.Attachments[i].Line) >= line
+	})
+	if i < len(m.Attachments) && int(m.Attachments[i].Line) == line {
+		return m.Attachments[i]
+	}
+	return nil
+}
+
+// Lines returns the lyrics of the countdown, one entry per line.
+func (m *Countdown) Lines() ([]string, error) {
+	raw, err := m.LyricsJSON()
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrState, "cannot decompress lyrics")
+	}
+	return lyricsLines(raw)
+}
+
+// RevealedLines returns the lines of the lyrics that were already revealed.
+func (m *Countdown) RevealedLines() ([]string, error) {
+	if m.RevealedCount == 0 {
+		return nil, nil
+	}
+	lines, err := m.Lines()
+	if err != nil {
+		return nil, err
+	}
+	if int(m.RevealedCount) > len(lines) {
+		return nil, errors.Wrap(errors.ErrState, "more lines revealed than lyrics")
+	}
+	return lines[:m.RevealedCount], nil
+}
+
+// Status returns the lifecycle status of the countdown.
+func (m *Countdown) Status() CountdownStatus {
+	switch {
+	case m.CompletedAt != 0:
+		return CountdownStatus_Completed
+	case m.PausedAt != 0:
+		return CountdownStatus_Paused
+	case m.RevealedCount > 0:
+		return CountdownStatus_Active
+	default:
+		return CountdownStatus_Scheduled
+	}
+}
+
+// Progress returns how far the countdown is. The completion estimate assumes
+// one line is revealed per interval after the pending reveal.
+func (m *Countdown) Progress(interval time.Duration) (*CountdownProgress, error) {
+	lines, err := m.Lines()
+	if err != nil {
+		return nil, err
+	}
+	p := &CountdownProgress{
+		CountdownID:   m.ID,
+		Status:        m.Status(),
+		RevealedCount: m.RevealedCount,
+		TotalLines:    int32(len(lines)),
+		Iteration:     m.Iteration,
+	}
+	if p.TotalLines > 0 {
+		p.PercentComplete = p.RevealedCount * 100 / p.TotalLines
+	}
+
+	switch {
+	case m.CompletedAt != 0:
+		p.EstimatedCompletionAt = m.CompletedAt
+		// the next iteration of a repeating countdown may be scheduled
+		if len(m.TaskID) != 0 {
+			p.NextRevealAt = m.NextRevealAt
+		}
+	case len(m.TaskID) != 0 && m.NextRevealAt != 0:
+		p.NextRevealAt = m.NextRevealAt
+		remaining := p.TotalLines - p.RevealedCount - 1
+		p.EstimatedCompletionAt = m.NextRevealAt.Add(time.Duration(remaining) * interval)
+	}
+	return p, nil
+}
+
+// Validate validates reveal's fields
+func (m *Reveal) Validate() error {
+	if m == nil {
+		return errors.ErrEmpty
+	}
+
+	var errs error
+
+	if m.Line < 0 {
+		errs = errors.AppendField(errs, "Line", errors.ErrInput)
+	}
+
+	if m.Height <= 0 {
+		errs = errors.AppendField(errs, "Height", errors.ErrInput)
+	}
+
+	if err := m.RevealedAt.Validate(); err != nil {
+		errs = errors.AppendField(errs, "RevealedAt", err)
+	} else if m.RevealedAt == 0 {
+		errs = errors.AppendField(errs, "RevealedAt", errors.ErrEmpty)
+	}
+
+	return errs
+}
+
+// maxMimeTypeLength is the maximum length of the media type of an attachment.
+const maxMimeTypeLength = 128
+
+// Validate validates attachment's fields
+func (m *Attachment) Validate() error {
+	if m == nil {
+		return errors.ErrEmpty
+	}
+
+	var errs error
+
+	if m.Line < 0 {
+		errs = errors.AppendField(errs, "Line", errors.ErrInput)
+	}
+	errs = errors.AppendField(errs, "Hash", validateHash(m.Hash))
+	errs = errors.AppendField(errs, "MimeType", validateMimeType(m.MimeType))
+
+	return errs
+}
 
-	var lyrics []string
+// Verify returns an error if the content read from r does not match the
+// attachment hash. Clients use it to check media downloaded from an off-chain
+// source before displaying it.
+func (m *Attachment) Verify(r io.Reader) error {
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return errors.Wrap(errors.ErrInput, "cannot read content")
+	}
+	if !bytes.Equal(h.Sum(nil), m.Hash) {
+		return errors.Wrap(errors.ErrInput, "content does not match the attachment hash")
+	}
+	return nil
+}
 
-	if err := json.Unmarshal(m.Lyrics, lyrics); err != nil {
-		return nil, errors.Wrapf(err, "cannot unmarshal lyrics for countdown id %s", msg.ID)
+// validateMimeType ensures the media type is an image or an audio type.
+func validateMimeType(mimeType string) error {
+	if mimeType == "" {
+		return errors.ErrEmpty
+	}
+	if len(mimeType) > maxMimeTypeLength {
+		return errors.Wrapf(errors.ErrInput, "must not be longer than %d characters", maxMimeTypeLength)
 	}
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		return errors.Wrap(errors.ErrInput, "invalid media type")
+	}
+	if !strings.HasPrefix(mediaType, "image/") && !strings.HasPrefix(mediaType, "audio/") {
+		return errors.Wrap(errors.ErrInput, "must be an image or an audio type")
+	}
+	return nil
+}
 
-	for i, line := range lyrics {
-		if !validCountdownLyrics(line) {
-			errs = errors.AppendField(errs, "Lyrics line "+string(i), errors.ErrModel)
+// validateAttachments ensures all attachments are valid and ordered by line,
+// with at most one attachment per line.
+func validateAttachments(attachments []*Attachment) error {
+	var errs error
+	for i, a := range attachments {
+		field := "Attachments." + strconv.Itoa(i)
+		if err := a.Validate(); err != nil {
+			errs = errors.AppendField(errs, field, err)
+		} else if i > 0 && attachments[i-1] != nil && a.Line <= attachments[i-1].Line {
+			errs = errors.AppendField(errs, field, errors.Wrap(errors.ErrInput, "must be ordered by line, one per line"))
 		}
 	}
+	return errs
+}
+
+// checkAttachmentLines ensures all attachments belong to one of the given
+// number of lines. Attachments must be ordered by line.
+func checkAttachmentLines(attachments []*Attachment, lines int) error {
+	if n := len(attachments); n != 0 && attachments[n-1] != nil && int(attachments[n-1].Line) >= lines {
+		return errors.Field("Attachments", errors.ErrInput, "line out of range")
+	}
+	return nil
+}
+
+// Validate validates editor's fields
+func (m *Editor) Validate() error {
+	if m == nil {
+		return errors.ErrEmpty
+	}
+
+	var errs error
+
+	errs = errors.AppendField(errs, "Address", m.Address.Validate())
+	errs = errors.AppendField(errs, "Role", m.Role.Validate())
+
+	return errs
+}
+
+// Validate returns an error if the role is not one of the known values.
+func (r EditorRole) Validate() error {
+	if r == EditorRole_Invalid {
+		return errors.ErrEmpty
+	}
+	if _, ok := EditorRole_name[int32(r)]; !ok {
+		return errors.Wrapf(errors.ErrInput, "unknown editor role %d", r)
+	}
+	return nil
+}
+
+// Validate validates top tipper's fields
+func (m *TopTipper) Validate() error {
+	if m == nil {
+		return errors.ErrEmpty
+	}
+
+	var errs error
+
+	errs = errors.AppendField(errs, "Address", m.Address.Validate())
+
+	if m.Total == nil {
+		errs = errors.AppendField(errs, "Total", errors.ErrEmpty)
+	} else if err := m.Total.Validate(); err != nil {
+		errs = errors.AppendField(errs, "Total", err)
+	} else if !m.Total.IsPositive() {
+		errs = errors.AppendField(errs, "Total", errors.ErrAmount)
+	}
+
+	return errs
+}
+
+var _ morm.Model = (*Tipper)(nil)
+
+// SetID is a minimal implementation, useful when the ID is a separate protobuf field
+func (m *Tipper) SetID(id []byte) error {
+	m.ID = id
+	return nil
+}
+
+// Copy produces a new copy to fulfill the Model interface
+func (m *Tipper) Copy() orm.CloneableData {
+	return &Tipper{
+		Metadata:    m.Metadata.Copy(),
+		ID:          copyBytes(m.ID),
+		CountdownID: copyBytes(m.CountdownID),
+		Address:     m.Address.Clone(),
+		Total:       copyCoins(m.Total),
+	}
+}
+
+// Validate validates tipper's fields
+func (m *Tipper) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "CountdownID", isGenID(m.CountdownID, false))
+	errs = errors.AppendField(errs, "Address", m.Address.Validate())
+
+	if !bytes.Equal(m.ID, tipperID(m.CountdownID, m.Address)) {
+		errs = errors.AppendField(errs, "ID", errors.ErrInput)
+	}
+
+	errs = errors.AppendField(errs, "Total", validateTips(m.Total))
+
+	return errs
+}
+
+var _ morm.Model = (*Flag)(nil)
+
+// SetID is a minimal implementation, useful when the ID is a separate protobuf field
+func (m *Flag) SetID(id []byte) error {
+	m.ID = id
+	return nil
+}
+
+// Copy produces a new copy to fulfill the Model interface
+func (m *Flag) Copy() orm.CloneableData {
+	return &Flag{
+		Metadata:    m.Metadata.Copy(),
+		ID:          copyBytes(m.ID),
+		CountdownID: copyBytes(m.CountdownID),
+		Reporter:    m.Reporter.Clone(),
+		Reason:      m.Reason,
+		FlaggedAt:   m.FlaggedAt,
+	}
+}
+
+// Validate validates flag's fields
+func (m *Flag) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "CountdownID", isGenID(m.CountdownID, false))
+	errs = errors.AppendField(errs, "Reporter", m.Reporter.Validate())
+
+	if !bytes.Equal(m.ID, flagID(m.CountdownID, m.Reporter)) {
+		errs = errors.AppendField(errs, "ID", errors.ErrInput)
+	}
+
+	errs = errors.AppendField(errs, "Reason", validateFlagReason(m.Reason))
+
+	if err := m.FlaggedAt.Validate(); err != nil {
+		errs = errors.AppendField(errs, "FlaggedAt", err)
+	} else if m.FlaggedAt == 0 {
+		errs = errors.AppendField(errs, "FlaggedAt", errors.ErrEmpty)
+	}
+
+	return errs
+}
+
+var _ morm.Model = (*Draft)(nil)
+
+// SetID is a minimal implementation, useful when the ID is a separate protobuf field
+func (m *Draft) SetID(id []byte) error {
+	m.ID = id
+	return nil
+}
+
+// Copy produces a new copy to fulfill the Model interface
+func (m *Draft) Copy() orm.CloneableData {
+	return &Draft{
+		Metadata:           m.Metadata.Copy(),
+		ID:                 copyBytes(m.ID),
+		Owner:              m.Owner.Clone(),
+		Title:              m.Title,
+		MissedRevealPolicy: m.MissedRevealPolicy,
+		Bounty:             copyCoins(m.Bounty),
+		Beneficiary:        m.Beneficiary.Clone(),
+		Sponsor:            m.Sponsor.Clone(),
+		DeleteAt:           m.DeleteAt,
+		CreatedAt:          m.CreatedAt,
+		Lyrics:             copyBytes(m.Lyrics),
+		Chunks:             m.Chunks,
+		LyricsHash:         copyBytes(m.LyricsHash),
+		RepeatPolicy:       m.RepeatPolicy,
+		RepeatCount:        m.RepeatCount,
+		Tags:               copyStrings(m.Tags),
+		Deposit:            copyCoin(m.Deposit),
+		Depositor:          m.Depositor.Clone(),
+	}
+}
+
+// Validate validates draft's fields. Lyrics are empty until the first chunk
+// is appended.
+func (m *Draft) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "ID", isGenID(m.ID, false))
+	errs = errors.AppendField(errs, "Owner", m.Owner.Validate())
+	errs = errors.AppendField(errs, "Title", validateTitle(m.Title))
+	errs = errors.AppendField(errs, "MissedRevealPolicy", m.MissedRevealPolicy.Validate())
+	errs = errors.Append(errs, validateBounty(m.Bounty, m.Beneficiary, m.Sponsor))
+	errs = errors.AppendField(errs, "DeleteAt", m.DeleteAt.Validate())
+	errs = errors.Append(errs, validateRepeat(m.RepeatPolicy, m.RepeatCount))
+	errs = errors.Append(errs, validateTags(m.Tags))
+
+	errs = errors.AppendField(errs, "Deposit", validateDeposit(m.Deposit))
+	if m.Deposit != nil {
+		errs = errors.AppendField(errs, "Depositor", m.Depositor.Validate())
+	}
+
+	if err := m.CreatedAt.Validate(); err != nil {
+		errs = errors.AppendField(errs, "CreatedAt", err)
+	} else if m.CreatedAt == 0 {
+		errs = errors.AppendField(errs, "CreatedAt", errors.ErrEmpty)
+	}
+
+	switch {
+	case m.Chunks < 0:
+		errs = errors.AppendField(errs, "Chunks", errors.ErrInput)
+	case m.Chunks == 0:
+		if len(m.Lyrics) != 0 {
+			errs = errors.AppendField(errs, "Lyrics", errors.Wrap(errors.ErrInput, "no chunk appended"))
+		}
+		if len(m.LyricsHash) != 0 {
+			errs = errors.AppendField(errs, "LyricsHash", errors.Wrap(errors.ErrInput, "no chunk appended"))
+		}
+	default:
+		errs = errors.Append(errs, validateLyrics(m.Lyrics))
+		errs = errors.AppendField(errs, "LyricsHash", validateHash(m.LyricsHash))
+	}
+
+	return errs
+}
+
+var _ morm.Model = (*Series)(nil)
+
+// SetID is a minimal implementation, useful when the ID is a separate protobuf field
+func (m *Series) SetID(id []byte) error {
+	m.ID = id
+	return nil
+}
+
+// Copy produces a new copy to fulfill the Model interface
+func (m *Series) Copy() orm.CloneableData {
+	ids := make([][]byte, len(m.CountdownIDs))
+	for i, id := range m.CountdownIDs {
+		ids[i] = copyBytes(id)
+	}
+	return &Series{
+		Metadata:     m.Metadata.Copy(),
+		ID:           copyBytes(m.ID),
+		Owner:        m.Owner.Clone(),
+		Title:        m.Title,
+		CountdownIDs: ids,
+		CreatedAt:    m.CreatedAt,
+	}
+}
+
+// Validate validates series's fields
+func (m *Series) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "ID", isGenID(m.ID, false))
+	errs = errors.AppendField(errs, "Owner", m.Owner.Validate())
+	errs = errors.AppendField(errs, "Title", validateTitle(m.Title))
+	errs = errors.AppendField(errs, "CountdownIDs", validateSeriesCountdowns(m.CountdownIDs))
 
 	if err := m.CreatedAt.Validate(); err != nil {
 		errs = errors.AppendField(errs, "CreatedAt", err)
@@ -109,6 +697,336 @@ func (m *Countdown) Validate() error {
 	return errs
 }
 
+// Index returns the position of the countdown with the given ID in the
+// series, -1 if it is not a member.
+func (m *Series) Index(countdownID []byte) int {
+	for i, id := range m.CountdownIDs {
+		if bytes.Equal(id, countdownID) {
+			return i
+		}
+	}
+	return -1
+}
+
+var _ morm.Model = (*CreationLog)(nil)
+
+// SetID is a minimal implementation, useful when the ID is a separate protobuf field
+func (m *CreationLog) SetID(id []byte) error {
+	m.ID = id
+	return nil
+}
+
+// Copy produces a new copy to fulfill the Model interface
+func (m *CreationLog) Copy() orm.CloneableData {
+	createdAt := make([]weave.UnixTime, len(m.CreatedAt))
+	copy(createdAt, m.CreatedAt)
+	return &CreationLog{
+		Metadata:  m.Metadata.Copy(),
+		ID:        copyBytes(m.ID),
+		CreatedAt: createdAt,
+	}
+}
+
+// Validate validates creation log's fields
+func (m *CreationLog) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "ID", weave.Address(m.ID).Validate())
+	for i, t := range m.CreatedAt {
+		field := "CreatedAt." + strconv.Itoa(i)
+		if err := t.Validate(); err != nil {
+			errs = errors.AppendField(errs, field, err)
+		} else if i > 0 && t < m.CreatedAt[i-1] {
+			errs = errors.AppendField(errs, field, errors.Wrap(errors.ErrInput, "must be in creation order"))
+		}
+	}
+
+	return errs
+}
+
+// maxSeriesCountdowns is the maximum number of countdowns in a series
+const maxSeriesCountdowns = 100
+
+// validateSeriesCountdowns ensures the members of a series are valid IDs, each
+// listed once.
+func validateSeriesCountdowns(ids [][]byte) error {
+	if len(ids) > maxSeriesCountdowns {
+		return errors.Wrapf(errors.ErrInput, "at most %d countdowns allowed", maxSeriesCountdowns)
+	}
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if err := isGenID(id, false); err != nil {
+			return err
+		}
+		if seen[string(id)] {
+			return errors.Wrapf(errors.ErrDuplicate, "countdown with ID %s listed twice", id)
+		}
+		seen[string(id)] = true
+	}
+	return nil
+}
+
+// NextLyricsHash returns the lyrics hash of a draft after appending a chunk
+// with the given lyrics to a draft with the given lyrics hash.
+func NextLyricsHash(prev, chunk []byte) []byte {
+	chunkHash := sha256.Sum256(chunk)
+	h := sha256.New()
+	h.Write(prev)
+	h.Write(chunkHash[:])
+	return h.Sum(nil)
+}
+
+// validateHash ensures the hash is a sha256 hash.
+func validateHash(hash []byte) error {
+	if len(hash) == 0 {
+		return errors.ErrEmpty
+	}
+	if len(hash) != sha256.Size {
+		return errors.Wrapf(errors.ErrInput, "must be %d bytes long", sha256.Size)
+	}
+	return nil
+}
+
+// maxFlagReasonLength is the maximum length of the reason of a flag.
+const maxFlagReasonLength = 256
+
+// validateFlagReason ensures a reason is given and it is not too long.
+func validateFlagReason(reason string) error {
+	if reason == "" {
+		return errors.ErrEmpty
+	}
+	if len(reason) > maxFlagReasonLength {
+		return errors.Wrapf(errors.ErrInput, "reason must not be longer than %d characters", maxFlagReasonLength)
+	}
+	return nil
+}
+
+// flagID returns the ID of the flag of a reporter on a countdown.
+func flagID(countdownID []byte, reporter weave.Address) []byte {
+	id := make([]byte, 0, len(countdownID)+len(reporter))
+	id = append(id, countdownID...)
+	return append(id, reporter...)
+}
+
+// BountyAddress returns the address holding the bounty of the countdown with
+// the given ID. Only the countdown handlers can move funds from it.
+func BountyAddress(countdownID []byte) weave.Address {
+	return weave.NewCondition(packageName, "bounty", countdownID).Address()
+}
+
+// DepositAddress is the address holding the storage deposits of all users and
+// countdowns. Only the countdown handlers can move funds from it.
+var DepositAddress = weave.NewCondition(packageName, "deposit", nil).Address()
+
+// validateRepeat ensures the repeat policy is known and the repeat count is
+// positive for the times policy only.
+func validateRepeat(policy RepeatPolicy, count int32) error {
+	if _, ok := RepeatPolicy_name[int32(policy)]; !ok {
+		return errors.Field("RepeatPolicy", errors.ErrInput, "unknown repeat policy")
+	}
+	if policy == RepeatPolicy_Times {
+		if count <= 0 {
+			return errors.Field("RepeatCount", errors.ErrInput, "must be positive")
+		}
+	} else if count != 0 {
+		return errors.Field("RepeatCount", errors.ErrInput, "only allowed with the times repeat policy")
+	}
+	return nil
+}
+
+// validateBounty ensures the bounty is a valid set of positive coins with a
+// beneficiary and a sponsor. All fields are empty if there is no bounty.
+func validateBounty(bounty []*coin.Coin, beneficiary, sponsor weave.Address) error {
+	if len(bounty) == 0 {
+		var errs error
+		if len(beneficiary) != 0 {
+			errs = errors.Append(errs, errors.Field("Beneficiary", errors.ErrInput, "no bounty"))
+		}
+		if len(sponsor) != 0 {
+			errs = errors.Append(errs, errors.Field("Sponsor", errors.ErrInput, "no bounty"))
+		}
+		return errs
+	}
+
+	var errs error
+
+	if err := coin.Coins(bounty).Validate(); err != nil {
+		errs = errors.AppendField(errs, "Bounty", err)
+	} else if !coin.Coins(bounty).IsPositive() {
+		errs = errors.AppendField(errs, "Bounty", errors.ErrAmount)
+	}
+
+	errs = errors.AppendField(errs, "Beneficiary", beneficiary.Validate())
+
+	if len(sponsor) != 0 {
+		errs = errors.AppendField(errs, "Sponsor", sponsor.Validate())
+	}
+
+	return errs
+}
+
+// validateDeposit ensures the storage deposit, if any, is not negative.
+func validateDeposit(deposit *coin.Coin) error {
+	if deposit == nil {
+		return nil
+	}
+	if err := deposit.Validate(); err != nil {
+		return err
+	}
+	if !deposit.IsNonNegative() {
+		return errors.Wrap(errors.ErrAmount, "deposit cannot be negative")
+	}
+	return nil
+}
+
+// validateUnlockPrice ensures the price of an early unlock is positive.
+func validateUnlockPrice(price *coin.Coin) error {
+	if err := price.Validate(); err != nil {
+		return err
+	}
+	if !price.IsPositive() {
+		return errors.Wrap(errors.ErrAmount, "price must be positive")
+	}
+	return nil
+}
+
+// tipperID returns the ID of the tipper record of an address on a countdown.
+func tipperID(countdownID []byte, addr weave.Address) []byte {
+	id := make([]byte, 0, len(countdownID)+len(addr))
+	id = append(id, countdownID...)
+	return append(id, addr...)
+}
+
+// validateTips ensures the tips sum is a valid set of positive coins.
+func validateTips(tips []*coin.Coin) error {
+	if err := coin.Coins(tips).Validate(); err != nil {
+		return err
+	}
+	for _, c := range tips {
+		if !c.IsPositive() {
+			return errors.Wrap(errors.ErrAmount, "tips must be positive")
+		}
+	}
+	return nil
+}
+
+func copyCoin(in *coin.Coin) *coin.Coin {
+	if in == nil {
+		return nil
+	}
+	return in.Clone()
+}
+
+func copyCoins(in []*coin.Coin) []*coin.Coin {
+	if in == nil {
+		return nil
+	}
+	cpy := make([]*coin.Coin, len(in))
+	for i, c := range in {
+		cpy[i] = c.Clone()
+	}
+	return cpy
+}
+
+func copyTopTippers(in []*TopTipper) []*TopTipper {
+	if in == nil {
+		return nil
+	}
+	cpy := make([]*TopTipper, len(in))
+	for i, t := range in {
+		cpy[i] = &TopTipper{Address: t.Address.Clone(), Total: t.Total.Clone()}
+	}
+	return cpy
+}
+
+func copyEditors(in []*Editor) []*Editor {
+	if in == nil {
+		return nil
+	}
+	cpy := make([]*Editor, len(in))
+	for i, e := range in {
+		cpy[i] = &Editor{Address: e.Address.Clone(), Role: e.Role}
+	}
+	return cpy
+}
+
+func copyAttachments(in []*Attachment) []*Attachment {
+	if in == nil {
+		return nil
+	}
+	cpy := make([]*Attachment, len(in))
+	for i, a := range in {
+		cpy[i] = &Attachment{Line: a.Line, Hash: copyBytes(a.Hash), MimeType: a.MimeType}
+	}
+	return cpy
+}
+
+func copyReveals(in []*Reveal) []*Reveal {
+	if in == nil {
+		return nil
+	}
+	cpy := make([]*Reveal, len(in))
+	for i, r := range in {
+		cpy[i] = &Reveal{Line: r.Line, Height: r.Height, RevealedAt: r.RevealedAt}
+	}
+	return cpy
+}
+
+// validateLyrics ensures the lyrics are a JSON encoded list of valid lines.
+func validateLyrics(raw []byte) error {
+	if len(raw) == 0 {
+		return errors.Field("Lyrics", errors.ErrEmpty, "required")
+	}
+
+	if len(raw) > maxLyricsSize {
+		return errors.Field("Lyrics", errors.ErrInput, "too long")
+	}
+
+	var lines []string
+	if err := json.Unmarshal(raw, &lines); err != nil {
+		return errors.Field("Lyrics", errors.ErrInput, "must be a list of lines")
+	}
+
+	var errs error
+	for i, line := range lines {
+		errs = errors.AppendField(errs, "Lyrics line "+strconv.Itoa(i), validateLine(line))
+	}
+	return errs
+}
+
+// Validate returns an error if the policy is not one of the known values.
+func (p MissedRevealPolicy) Validate() error {
+	if p == MissedRevealPolicy_Invalid {
+		return errors.ErrEmpty
+	}
+	if _, ok := MissedRevealPolicy_name[int32(p)]; !ok {
+		return errors.Wrapf(errors.ErrInput, "unknown missed reveal policy %d", p)
+	}
+	return nil
+}
+
+// Validate returns an error if the status is not one of the known values.
+func (s CountdownStatus) Validate() error {
+	if s == CountdownStatus_Invalid {
+		return errors.ErrEmpty
+	}
+	if _, ok := CountdownStatus_name[int32(s)]; !ok {
+		return errors.Wrapf(errors.ErrInput, "unknown countdown status %d", s)
+	}
+	return nil
+}
+
+// Validate returns an error if the action is not one of the known values.
+func (a ModerationAction) Validate() error {
+	if a == ModerationAction_Invalid {
+		return errors.ErrEmpty
+	}
+	if _, ok := ModerationAction_name[int32(a)]; !ok {
+		return errors.Wrapf(errors.ErrInput, "unknown moderation action %d", a)
+	}
+	return nil
+}
+
 var _ morm.Model = (*CountdownTask)(nil)
 
 // SetID is a minimal implementation, useful when the ID is a separate protobuf field
@@ -120,17 +1038,20 @@ func (m *CountdownTask) SetID(id []byte) error {
 // Copy produces a new copy to fulfill the Model interface
 func (m *CountdownTask) Copy() orm.CloneableData {
 	return &CountdownTask{
-		Metadata:  m.Metadata.Copy(),
-		ID:        copyBytes(m.ID),
-		TaskOwner: m.TaskOwner.Clone(),
+		Metadata:    m.Metadata.Copy(),
+		ID:          copyBytes(m.ID),
+		CountdownID: copyBytes(m.CountdownID),
+		TaskOwner:   m.TaskOwner.Clone(),
 	}
 }
 
-// Validate validates user's fields
+// Validate validates task's fields. The ID is assigned by the scheduler and
+// is not known when the task is created, so it is optional.
 func (m *CountdownTask) Validate() error {
 	var errs error
 
-	errs = errors.AppendField(errs, "ID", isGenID(m.ID, false))
+	errs = errors.AppendField(errs, "ID", isGenID(m.ID, true))
+	errs = errors.AppendField(errs, "CountdownID", isGenID(m.CountdownID, false))
 	errs = errors.AppendField(errs, "TaskOwner", m.TaskOwner.Validate())
 
 	return errs
@@ -145,6 +1066,15 @@ func copyBytes(in []byte) []byte {
 	return cpy
 }
 
+func copyStrings(in []string) []string {
+	if in == nil {
+		return nil
+	}
+	cpy := make([]string, len(in))
+	copy(cpy, in)
+	return cpy
+}
+
 // isGenID ensures that the ID is 8 byte input.
 // if allowEmpty is set, we also allow empty
 // TODO change with validateSequence when weave 0.22.0 is released
@@ -1,6 +1,8 @@
 package countdown
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/json"
 	"testing"
 	"time"
@@ -159,14 +161,170 @@ func TestCountdownTask(t *testing.T) {
 
 func TestValidateCountdown(t *testing.T) {
 	now := weave.AsUnixTime(time.Now())
+	editor := weavetest.NewCondition().Address()
 	b, err := json.Marshal(lyrics)
 	assert.Nil(t, err)
+	hash := sha256.Sum256([]byte("cover"))
 
 	cases := map[string]struct {
 		model    orm.Model
 		wantErrs map[string]*errors.Error
 	}{
 		"success": {
+			model: &Countdown{
+				Metadata:           &weave.Metadata{Schema: 1},
+				ID:                 weavetest.SequenceID(1),
+				Owner:              weavetest.NewCondition().Address(),
+				Title:              "final countdown",
+				Lyrics:             b,
+				CreatedAt:          now,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				ScheduleStart:      now,
+				Editors: []*Editor{
+					{Address: weavetest.NewCondition().Address(), Role: EditorRole_Editor},
+				},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":           nil,
+				"ID":                 nil,
+				"Owner":              nil,
+				"Title":              nil,
+				"Lyrics":             nil,
+				"CreatedAt":          nil,
+				"CompletedAt":        nil,
+				"MissedRevealPolicy": nil,
+				"Editors.0":          nil,
+				"ScheduleStart":      nil,
+			},
+		},
+		"success compressed lyrics": {
+			model: &Countdown{
+				Metadata:           &weave.Metadata{Schema: countdownSchema},
+				ID:                 weavetest.SequenceID(1),
+				Owner:              weavetest.NewCondition().Address(),
+				Title:              "final countdown",
+				CompressedLyrics:   compressLyrics(t, b),
+				RevealedCount:      2,
+				CreatedAt:          now,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				ScheduleStart:      now,
+			},
+			wantErrs: map[string]*errors.Error{
+				"Lyrics":           nil,
+				"Countdown":        nil,
+				"CompressedLyrics": nil,
+				"RevealedCount":    nil,
+			},
+		},
+		"failure uncompressed lyrics": {
+			model: &Countdown{
+				Metadata:           &weave.Metadata{Schema: countdownSchema},
+				ID:                 weavetest.SequenceID(1),
+				Owner:              weavetest.NewCondition().Address(),
+				Title:              "final countdown",
+				Lyrics:             b,
+				CompressedLyrics:   compressLyrics(t, b),
+				RevealedCount:      int32(len(lyrics) + 1),
+				CreatedAt:          now,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				ScheduleStart:      now,
+			},
+			wantErrs: map[string]*errors.Error{
+				"Lyrics":           errors.ErrInput,
+				"CompressedLyrics": nil,
+				"RevealedCount":    errors.ErrInput,
+			},
+		},
+		"failure iteration past repeat count": {
+			model: &Countdown{
+				Metadata:           &weave.Metadata{Schema: countdownSchema},
+				ID:                 weavetest.SequenceID(1),
+				Owner:              weavetest.NewCondition().Address(),
+				Title:              "final countdown",
+				CompressedLyrics:   compressLyrics(t, b),
+				CreatedAt:          now,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				ScheduleStart:      now,
+				RepeatPolicy:       RepeatPolicy_Times,
+				RepeatCount:        2,
+				Iteration:          3,
+			},
+			wantErrs: map[string]*errors.Error{
+				"RepeatPolicy": nil,
+				"RepeatCount":  nil,
+				"Iteration":    errors.ErrInput,
+			},
+		},
+		"failure duplicated editor": {
+			model: &Countdown{
+				Metadata:           &weave.Metadata{Schema: 1},
+				ID:                 weavetest.SequenceID(1),
+				Owner:              weavetest.NewCondition().Address(),
+				Title:              "final countdown",
+				Lyrics:             b,
+				CreatedAt:          now,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				ScheduleStart:      now,
+				Editors: []*Editor{
+					{Address: editor, Role: EditorRole_Editor},
+					{Address: editor, Role: EditorRole_Manager},
+					{Address: weavetest.NewCondition().Address()},
+				},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Editors.0":     nil,
+				"Editors.1":     errors.ErrDuplicate,
+				"Editors.2":     errors.ErrEmpty,
+				"ScheduleStart": nil,
+			},
+		},
+		"failure invalid reveal": {
+			model: &Countdown{
+				Metadata:           &weave.Metadata{Schema: 1},
+				ID:                 weavetest.SequenceID(1),
+				Owner:              weavetest.NewCondition().Address(),
+				Title:              "final countdown",
+				Lyrics:             b,
+				CreatedAt:          now,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				Reveals: []*Reveal{
+					{Line: 0, Height: 5, RevealedAt: now},
+					{Line: 1, RevealedAt: now},
+				},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":  nil,
+				"ID":        nil,
+				"Reveals.0": nil,
+				"Reveals.1": errors.ErrInput,
+			},
+		},
+		"failure invalid attachments": {
+			model: &Countdown{
+				Metadata:           &weave.Metadata{Schema: 1},
+				ID:                 weavetest.SequenceID(1),
+				Owner:              weavetest.NewCondition().Address(),
+				Title:              "final countdown",
+				Lyrics:             b,
+				CreatedAt:          now,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				ScheduleStart:      now,
+				Attachments: []*Attachment{
+					{Line: 1, Hash: hash[:], MimeType: "image/png"},
+					{Line: 1, Hash: hash[:], MimeType: "audio/mpeg"},
+					{Line: 2, Hash: hash[:10], MimeType: "text/html"},
+					{Line: int32(len(lyrics)), Hash: hash[:], MimeType: "audio/ogg; codecs=opus"},
+				},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Attachments.0": nil,
+				"Attachments.1": errors.ErrInput,
+				"Attachments.2": errors.ErrInput,
+				"Attachments.3": nil,
+				"Attachments":   errors.ErrInput,
+			},
+		},
+		"failure missing missed reveal policy": {
 			model: &Countdown{
 				Metadata:  &weave.Metadata{Schema: 1},
 				ID:        weavetest.SequenceID(1),
@@ -176,13 +334,14 @@ func TestValidateCountdown(t *testing.T) {
 				CreatedAt: now,
 			},
 			wantErrs: map[string]*errors.Error{
-				"Metadata":    nil,
-				"ID":          nil,
-				"Owner":       nil,
-				"Title":       nil,
-				"Lyrics":      nil,
-				"CreatedAt":   nil,
-				"CompletedAt": nil,
+				"Metadata":           nil,
+				"ID":                 nil,
+				"Owner":              nil,
+				"Title":              nil,
+				"Lyrics":             nil,
+				"CreatedAt":          nil,
+				"CompletedAt":        nil,
+				"MissedRevealPolicy": errors.ErrEmpty,
 			},
 		},
 		// TODO add missing metadata test
@@ -285,3 +444,106 @@ func TestValidateCountdown(t *testing.T) {
 		})
 	}
 }
+
+func TestAttachmentVerify(t *testing.T) {
+	content := []byte("cover art")
+	hash := sha256.Sum256(content)
+	a := &Attachment{Line: 2, Hash: hash[:], MimeType: "image/png"}
+
+	assert.Nil(t, a.Verify(bytes.NewReader(content)))
+	if err := a.Verify(bytes.NewReader([]byte("tampered art"))); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error, got %v", err)
+	}
+
+	cd := &Countdown{Attachments: []*Attachment{
+		{Line: 0, Hash: hash[:], MimeType: "audio/mpeg"},
+		a,
+	}}
+	assert.Equal(t, a, cd.LineAttachment(2))
+	if got := cd.LineAttachment(1); got != nil {
+		t.Fatalf("want no attachment for line 1, got %v", got)
+	}
+}
+
+func TestCountdownProgress(t *testing.T) {
+	b, err := json.Marshal(lyrics[:10])
+	assert.Nil(t, err)
+
+	now := weave.AsUnixTime(time.Now().Round(time.Second))
+	interval := time.Hour
+
+	cases := map[string]struct {
+		cd   Countdown
+		want CountdownProgress
+	}{
+		"scheduled": {
+			cd: Countdown{TaskID: weavetest.SequenceID(1), NextRevealAt: now},
+			want: CountdownProgress{
+				Status:                CountdownStatus_Scheduled,
+				TotalLines:            10,
+				NextRevealAt:          now,
+				EstimatedCompletionAt: now.Add(9 * interval),
+			},
+		},
+		"active": {
+			cd: Countdown{RevealedCount: 3, TaskID: weavetest.SequenceID(1), NextRevealAt: now},
+			want: CountdownProgress{
+				Status:                CountdownStatus_Active,
+				RevealedCount:         3,
+				TotalLines:            10,
+				PercentComplete:       30,
+				NextRevealAt:          now,
+				EstimatedCompletionAt: now.Add(6 * interval),
+			},
+		},
+		"paused has no estimate": {
+			cd: Countdown{RevealedCount: 5, PausedAt: now},
+			want: CountdownProgress{
+				Status:          CountdownStatus_Paused,
+				RevealedCount:   5,
+				TotalLines:      10,
+				PercentComplete: 50,
+			},
+		},
+		"completed": {
+			cd: Countdown{RevealedCount: 10, CompletedAt: now},
+			want: CountdownProgress{
+				Status:                CountdownStatus_Completed,
+				RevealedCount:         10,
+				TotalLines:            10,
+				PercentComplete:       100,
+				EstimatedCompletionAt: now,
+			},
+		},
+		"completed with next iteration scheduled": {
+			cd: Countdown{
+				RevealedCount: 10,
+				CompletedAt:   now,
+				TaskID:        weavetest.SequenceID(1),
+				NextRevealAt:  now.Add(interval),
+				RepeatPolicy:  RepeatPolicy_Forever,
+				Iteration:     2,
+			},
+			want: CountdownProgress{
+				Status:                CountdownStatus_Completed,
+				RevealedCount:         10,
+				TotalLines:            10,
+				PercentComplete:       100,
+				NextRevealAt:          now.Add(interval),
+				EstimatedCompletionAt: now,
+				Iteration:             2,
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			tc.cd.ID = weavetest.SequenceID(7)
+			tc.cd.CompressedLyrics = compressLyrics(t, b)
+			tc.want.CountdownID = tc.cd.ID
+
+			got, err := tc.cd.Progress(interval)
+			assert.Nil(t, err)
+			assert.Equal(t, &tc.want, got)
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package countdown
 
 import (
-	"encoding/json"
+	"bytes"
+	"crypto/sha256"
+	"strconv"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
@@ -12,6 +14,32 @@ func init() {
 	migration.MustRegister(1, &CreateUserMsg{}, migration.NoModification)
 	migration.MustRegister(1, &CreateCountdownMsg{}, migration.NoModification)
 	migration.MustRegister(1, &DeleteCountdownMsg{}, migration.NoModification)
+	migration.MustRegister(1, &GrantRoleMsg{}, migration.NoModification)
+	migration.MustRegister(1, &RevokeRoleMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UpdateLyricsMsg{}, migration.NoModification)
+	migration.MustRegister(1, &PauseCountdownMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ResumeCountdownMsg{}, migration.NoModification)
+	migration.MustRegister(1, &TransferCountdownMsg{}, migration.NoModification)
+	migration.MustRegister(1, &AcceptCountdownTransferMsg{}, migration.NoModification)
+	migration.MustRegister(1, &TipCountdownMsg{}, migration.NoModification)
+	migration.MustRegister(1, &SetUnlockPriceMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UnlockNextLineMsg{}, migration.NoModification)
+	migration.MustRegister(1, &DeleteUserMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UpdateConfigurationMsg{}, migration.NoModification)
+	migration.MustRegister(1, &FlagCountdownMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ModerateCountdownMsg{}, migration.NoModification)
+	migration.MustRegister(1, &CreateCountdownDraftMsg{}, migration.NoModification)
+	migration.MustRegister(1, &AppendLyricsChunkMsg{}, migration.NoModification)
+	migration.MustRegister(1, &PublishCountdownMsg{}, migration.NoModification)
+	migration.MustRegister(1, &DeleteCountdownDraftMsg{}, migration.NoModification)
+	migration.MustRegister(1, &MigrateCountdownsMsg{}, migration.NoModification)
+	migration.MustRegister(1, &CreateSeriesMsg{}, migration.NoModification)
+	migration.MustRegister(1, &AddSeriesCountdownMsg{}, migration.NoModification)
+	migration.MustRegister(1, &RemoveSeriesCountdownMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ReorderSeriesMsg{}, migration.NoModification)
+	migration.MustRegister(1, &SetSuccessorMsg{}, migration.NoModification)
+	migration.MustRegister(1, &RestartCountdownMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ForkCountdownMsg{}, migration.NoModification)
 }
 
 var _ weave.Msg = (*CreateUserMsg)(nil)
@@ -43,28 +71,39 @@ func (CreateCountdownMsg) Path() string {
 func (m CreateCountdownMsg) Validate() error {
 	var errs error
 
-	if !validCountdownTitle(m.Title) {
-		errs = errors.AppendField(errs, "Title", errors.ErrModel)
-	}
+	errs = errors.AppendField(errs, "Title", validateTitle(m.Title))
+	errs = errors.Append(errs, validateLyrics(m.Lyrics))
+	errs = errors.AppendField(errs, "MissedRevealPolicy", m.MissedRevealPolicy.Validate())
 
-	if len(m.Lyrics) == 0 {
-		errs = errors.AppendField(errs, "Lyrics", errors.ErrEmpty)
+	if len(m.Owner) != 0 {
+		errs = errors.AppendField(errs, "Owner", m.Owner.Validate())
 	}
 
-	var lyrics []string
+	errs = errors.Append(errs, validateBounty(m.Bounty, m.Beneficiary, m.Sponsor))
+	errs = errors.AppendField(errs, "DeleteAt", m.DeleteAt.Validate())
+	errs = errors.Append(errs, validateRepeat(m.RepeatPolicy, m.RepeatCount))
+	errs = errors.Append(errs, validateTags(m.Tags))
 
-	if err := json.Unmarshal(m.Lyrics, lyrics); err != nil {
-		return nil, errors.Wrapf(err, "cannot unmarshal lyrics for countdown id %s", msg.ID)
+	errs = errors.Append(errs, validateAttachments(m.Attachments))
+	if lines, err := lyricsLines(m.Lyrics); err == nil {
+		errs = errors.Append(errs, checkAttachmentLines(m.Attachments, len(lines)))
 	}
 
-	for i, line := range lyrics {
-		if !validCountdownLyrics(line) {
-			errs = errors.AppendField(errs, "Lyrics line "+string(i), errors.ErrModel)
-		}
-	}
 	return errs
 }
 
+var _ weave.Msg = (*DeleteUserMsg)(nil)
+
+// Path returns the routing path for this message.
+func (DeleteUserMsg) Path() string {
+	return "countdown/delete_user"
+}
+
+// Validate ensures DeleteUserMsg is valid
+func (m DeleteUserMsg) Validate() error {
+	return errors.AppendField(nil, "ID", isGenID(m.ID, false))
+}
+
 var _ weave.Msg = (*DeleteCountdownMsg)(nil)
 
 // Path returns the routing path for this message.
@@ -80,3 +119,531 @@ func (m DeleteCountdownMsg) Validate() error {
 
 	return errs
 }
+
+var _ weave.Msg = (*GrantRoleMsg)(nil)
+
+// Path returns the routing path for this message.
+func (GrantRoleMsg) Path() string {
+	return "countdown/grant_role"
+}
+
+// Validate ensures GrantRoleMsg is valid
+func (m GrantRoleMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "CountdownID", isGenID(m.CountdownID, false))
+	errs = errors.AppendField(errs, "Address", m.Address.Validate())
+	errs = errors.AppendField(errs, "Role", m.Role.Validate())
+
+	return errs
+}
+
+var _ weave.Msg = (*RevokeRoleMsg)(nil)
+
+// Path returns the routing path for this message.
+func (RevokeRoleMsg) Path() string {
+	return "countdown/revoke_role"
+}
+
+// Validate ensures RevokeRoleMsg is valid
+func (m RevokeRoleMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "CountdownID", isGenID(m.CountdownID, false))
+	errs = errors.AppendField(errs, "Address", m.Address.Validate())
+
+	return errs
+}
+
+var _ weave.Msg = (*UpdateLyricsMsg)(nil)
+
+// Path returns the routing path for this message.
+func (UpdateLyricsMsg) Path() string {
+	return "countdown/update_lyrics"
+}
+
+// Validate ensures UpdateLyricsMsg is valid
+func (m UpdateLyricsMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "CountdownID", isGenID(m.CountdownID, false))
+	errs = errors.Append(errs, validateLyrics(m.Lyrics))
+
+	errs = errors.Append(errs, validateAttachments(m.Attachments))
+	if lines, err := lyricsLines(m.Lyrics); err == nil {
+		errs = errors.Append(errs, checkAttachmentLines(m.Attachments, len(lines)))
+	}
+
+	return errs
+}
+
+var _ weave.Msg = (*PauseCountdownMsg)(nil)
+
+// Path returns the routing path for this message.
+func (PauseCountdownMsg) Path() string {
+	return "countdown/pause_countdown"
+}
+
+// Validate ensures PauseCountdownMsg is valid
+func (m PauseCountdownMsg) Validate() error {
+	return errors.AppendField(nil, "CountdownID", isGenID(m.CountdownID, false))
+}
+
+var _ weave.Msg = (*ResumeCountdownMsg)(nil)
+
+// Path returns the routing path for this message.
+func (ResumeCountdownMsg) Path() string {
+	return "countdown/resume_countdown"
+}
+
+// Validate ensures ResumeCountdownMsg is valid
+func (m ResumeCountdownMsg) Validate() error {
+	return errors.AppendField(nil, "CountdownID", isGenID(m.CountdownID, false))
+}
+
+var _ weave.Msg = (*TransferCountdownMsg)(nil)
+
+// Path returns the routing path for this message.
+func (TransferCountdownMsg) Path() string {
+	return "countdown/transfer_countdown"
+}
+
+// Validate ensures TransferCountdownMsg is valid
+func (m TransferCountdownMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "CountdownID", isGenID(m.CountdownID, false))
+	errs = errors.AppendField(errs, "NewOwner", m.NewOwner.Validate())
+
+	return errs
+}
+
+var _ weave.Msg = (*AcceptCountdownTransferMsg)(nil)
+
+// Path returns the routing path for this message.
+func (AcceptCountdownTransferMsg) Path() string {
+	return "countdown/accept_countdown_transfer"
+}
+
+// Validate ensures AcceptCountdownTransferMsg is valid
+func (m AcceptCountdownTransferMsg) Validate() error {
+	return errors.AppendField(nil, "CountdownID", isGenID(m.CountdownID, false))
+}
+
+var _ weave.Msg = (*TipCountdownMsg)(nil)
+
+// Path returns the routing path for this message.
+func (TipCountdownMsg) Path() string {
+	return "countdown/tip_countdown"
+}
+
+// Validate ensures TipCountdownMsg is valid
+func (m TipCountdownMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "CountdownID", isGenID(m.CountdownID, false))
+
+	if len(m.Tipper) != 0 {
+		errs = errors.AppendField(errs, "Tipper", m.Tipper.Validate())
+	}
+
+	if m.Amount == nil {
+		errs = errors.AppendField(errs, "Amount", errors.ErrEmpty)
+	} else if err := m.Amount.Validate(); err != nil {
+		errs = errors.AppendField(errs, "Amount", err)
+	} else if !m.Amount.IsPositive() {
+		errs = errors.AppendField(errs, "Amount", errors.ErrAmount)
+	}
+
+	return errs
+}
+
+var _ weave.Msg = (*SetUnlockPriceMsg)(nil)
+
+// Path returns the routing path for this message.
+func (SetUnlockPriceMsg) Path() string {
+	return "countdown/set_unlock_price"
+}
+
+// Validate ensures SetUnlockPriceMsg is valid
+func (m SetUnlockPriceMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "CountdownID", isGenID(m.CountdownID, false))
+
+	// a missing price disables early unlocks
+	if m.Price != nil {
+		errs = errors.AppendField(errs, "Price", validateUnlockPrice(m.Price))
+	}
+
+	return errs
+}
+
+var _ weave.Msg = (*UnlockNextLineMsg)(nil)
+
+// Path returns the routing path for this message.
+func (UnlockNextLineMsg) Path() string {
+	return "countdown/unlock_next_line"
+}
+
+// Validate ensures UnlockNextLineMsg is valid
+func (m UnlockNextLineMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "CountdownID", isGenID(m.CountdownID, false))
+
+	if len(m.Payer) != 0 {
+		errs = errors.AppendField(errs, "Payer", m.Payer.Validate())
+	}
+
+	return errs
+}
+
+var _ weave.Msg = (*UpdateConfigurationMsg)(nil)
+
+// Path returns the routing path for this message.
+func (UpdateConfigurationMsg) Path() string {
+	return "countdown/update_configuration"
+}
+
+// Validate ensures UpdateConfigurationMsg is valid. The patch is validated
+// once it is applied to the current configuration.
+func (m UpdateConfigurationMsg) Validate() error {
+	if m.Patch == nil {
+		return errors.Field("Patch", errors.ErrEmpty, "required")
+	}
+	return nil
+}
+
+var _ weave.Msg = (*FlagCountdownMsg)(nil)
+
+// Path returns the routing path for this message.
+func (FlagCountdownMsg) Path() string {
+	return "countdown/flag_countdown"
+}
+
+// Validate ensures FlagCountdownMsg is valid
+func (m FlagCountdownMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "CountdownID", isGenID(m.CountdownID, false))
+
+	if len(m.Reporter) != 0 {
+		errs = errors.AppendField(errs, "Reporter", m.Reporter.Validate())
+	}
+
+	errs = errors.AppendField(errs, "Reason", validateFlagReason(m.Reason))
+
+	return errs
+}
+
+var _ weave.Msg = (*ModerateCountdownMsg)(nil)
+
+// Path returns the routing path for this message.
+func (ModerateCountdownMsg) Path() string {
+	return "countdown/moderate_countdown"
+}
+
+// Validate ensures ModerateCountdownMsg is valid. The reason is optional.
+func (m ModerateCountdownMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "CountdownID", isGenID(m.CountdownID, false))
+	errs = errors.AppendField(errs, "Action", m.Action.Validate())
+
+	if len(m.Reason) > maxFlagReasonLength {
+		errs = errors.AppendField(errs, "Reason", errors.ErrInput)
+	}
+
+	return errs
+}
+
+var _ weave.Msg = (*CreateCountdownDraftMsg)(nil)
+
+// Path returns the routing path for this message.
+func (CreateCountdownDraftMsg) Path() string {
+	return "countdown/create_countdown_draft"
+}
+
+// Validate ensures CreateCountdownDraftMsg is valid
+func (m CreateCountdownDraftMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Title", validateTitle(m.Title))
+	errs = errors.AppendField(errs, "MissedRevealPolicy", m.MissedRevealPolicy.Validate())
+
+	if len(m.Owner) != 0 {
+		errs = errors.AppendField(errs, "Owner", m.Owner.Validate())
+	}
+
+	errs = errors.Append(errs, validateBounty(m.Bounty, m.Beneficiary, m.Sponsor))
+	errs = errors.AppendField(errs, "DeleteAt", m.DeleteAt.Validate())
+	errs = errors.Append(errs, validateRepeat(m.RepeatPolicy, m.RepeatCount))
+	errs = errors.Append(errs, validateTags(m.Tags))
+
+	return errs
+}
+
+var _ weave.Msg = (*AppendLyricsChunkMsg)(nil)
+
+// Path returns the routing path for this message.
+func (AppendLyricsChunkMsg) Path() string {
+	return "countdown/append_lyrics_chunk"
+}
+
+// Validate ensures AppendLyricsChunkMsg is valid and its hash matches the
+// chunk lyrics
+func (m AppendLyricsChunkMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "DraftID", isGenID(m.DraftID, false))
+
+	if m.Index < 0 {
+		errs = errors.AppendField(errs, "Index", errors.ErrInput)
+	}
+
+	errs = errors.Append(errs, validateLyrics(m.Lyrics))
+
+	if err := validateHash(m.Hash); err != nil {
+		errs = errors.AppendField(errs, "Hash", err)
+	} else if sum := sha256.Sum256(m.Lyrics); !bytes.Equal(m.Hash, sum[:]) {
+		errs = errors.AppendField(errs, "Hash", errors.Wrap(errors.ErrInput, "does not match the lyrics"))
+	}
+
+	return errs
+}
+
+var _ weave.Msg = (*PublishCountdownMsg)(nil)
+
+// Path returns the routing path for this message.
+func (PublishCountdownMsg) Path() string {
+	return "countdown/publish_countdown"
+}
+
+// Validate ensures PublishCountdownMsg is valid
+func (m PublishCountdownMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "DraftID", isGenID(m.DraftID, false))
+	errs = errors.AppendField(errs, "LyricsHash", validateHash(m.LyricsHash))
+	errs = errors.Append(errs, validateAttachments(m.Attachments))
+
+	return errs
+}
+
+var _ weave.Msg = (*DeleteCountdownDraftMsg)(nil)
+
+// Path returns the routing path for this message.
+func (DeleteCountdownDraftMsg) Path() string {
+	return "countdown/delete_countdown_draft"
+}
+
+// Validate ensures DeleteCountdownDraftMsg is valid
+func (m DeleteCountdownDraftMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "DraftID", isGenID(m.DraftID, false))
+
+	return errs
+}
+
+var _ weave.Msg = (*MigrateCountdownsMsg)(nil)
+
+// Path returns the routing path for this message.
+func (MigrateCountdownsMsg) Path() string {
+	return "countdown/migrate_countdowns"
+}
+
+// Validate ensures MigrateCountdownsMsg is valid
+func (m MigrateCountdownsMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "StartID", isGenID(m.StartID, false))
+	if m.Limit <= 0 || m.Limit > maxMigrateCountdowns {
+		errs = errors.AppendField(errs, "Limit", errors.Wrapf(errors.ErrInput, "must be between 1 and %d", maxMigrateCountdowns))
+	}
+
+	return errs
+}
+
+var _ weave.Msg = (*SetSuccessorMsg)(nil)
+
+// Path returns the routing path for this message.
+func (SetSuccessorMsg) Path() string {
+	return "countdown/set_successor"
+}
+
+// Validate ensures SetSuccessorMsg is valid. An empty successor ID removes the
+// successor.
+func (m SetSuccessorMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "CountdownID", isGenID(m.CountdownID, false))
+	if err := isGenID(m.SuccessorID, true); err != nil {
+		errs = errors.AppendField(errs, "SuccessorID", err)
+	} else if bytes.Equal(m.CountdownID, m.SuccessorID) {
+		errs = errors.AppendField(errs, "SuccessorID", errors.Wrap(errors.ErrInput, "countdown cannot succeed itself"))
+	}
+
+	return errs
+}
+
+var _ weave.Msg = (*RestartCountdownMsg)(nil)
+
+// Path returns the routing path for this message.
+func (RestartCountdownMsg) Path() string {
+	return "countdown/restart_countdown"
+}
+
+// Validate ensures RestartCountdownMsg is valid
+func (m RestartCountdownMsg) Validate() error {
+	return errors.AppendField(nil, "CountdownID", isGenID(m.CountdownID, false))
+}
+
+var _ weave.Msg = (*ForkCountdownMsg)(nil)
+
+// Path returns the routing path for this message.
+func (ForkCountdownMsg) Path() string {
+	return "countdown/fork_countdown"
+}
+
+// Validate ensures ForkCountdownMsg is valid. Selected lines must be unique
+// and edits must refer to selected lines.
+func (m ForkCountdownMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "SourceID", isGenID(m.SourceID, false))
+	errs = errors.AppendField(errs, "Title", validateTitle(m.Title))
+	errs = errors.AppendField(errs, "MissedRevealPolicy", m.MissedRevealPolicy.Validate())
+
+	selected := make(map[int32]bool, len(m.Lines))
+	for _, line := range m.Lines {
+		if line < 0 {
+			errs = errors.AppendField(errs, "Lines", errors.Wrapf(errors.ErrInput, "invalid line %d", line))
+		} else if selected[line] {
+			errs = errors.AppendField(errs, "Lines", errors.Wrapf(errors.ErrDuplicate, "line %d", line))
+		}
+		selected[line] = true
+	}
+
+	edited := make(map[int32]bool, len(m.Edits))
+	for i, e := range m.Edits {
+		field := "Edits." + strconv.Itoa(i)
+		if err := e.Validate(); err != nil {
+			errs = errors.AppendField(errs, field, err)
+			continue
+		}
+		if edited[e.Line] {
+			errs = errors.AppendField(errs, field, errors.Wrapf(errors.ErrDuplicate, "line %d", e.Line))
+		} else if len(m.Lines) != 0 && int(e.Line) >= len(m.Lines) {
+			errs = errors.AppendField(errs, field, errors.Wrapf(errors.ErrInput, "line %d is not selected", e.Line))
+		}
+		edited[e.Line] = true
+	}
+
+	return errs
+}
+
+// Validate ensures the edit replaces a line with valid text
+func (m *LineEdit) Validate() error {
+	if m == nil {
+		return errors.ErrEmpty
+	}
+	if m.Line < 0 {
+		return errors.Wrapf(errors.ErrInput, "invalid line %d", m.Line)
+	}
+	return validateLine(m.Text)
+}
+
+var _ weave.Msg = (*CreateSeriesMsg)(nil)
+
+// Path returns the routing path for this message.
+func (CreateSeriesMsg) Path() string {
+	return "countdown/create_series"
+}
+
+// Validate ensures CreateSeriesMsg is valid
+func (m CreateSeriesMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Title", validateTitle(m.Title))
+	if len(m.Owner) != 0 {
+		errs = errors.AppendField(errs, "Owner", m.Owner.Validate())
+	}
+	errs = errors.AppendField(errs, "CountdownIDs", validateSeriesCountdowns(m.CountdownIDs))
+
+	return errs
+}
+
+var _ weave.Msg = (*AddSeriesCountdownMsg)(nil)
+
+// Path returns the routing path for this message.
+func (AddSeriesCountdownMsg) Path() string {
+	return "countdown/add_series_countdown"
+}
+
+// Validate ensures AddSeriesCountdownMsg is valid
+func (m AddSeriesCountdownMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "SeriesID", isGenID(m.SeriesID, false))
+	errs = errors.AppendField(errs, "CountdownID", isGenID(m.CountdownID, false))
+	if m.Position < 0 {
+		errs = errors.AppendField(errs, "Position", errors.ErrInput)
+	}
+
+	return errs
+}
+
+var _ weave.Msg = (*RemoveSeriesCountdownMsg)(nil)
+
+// Path returns the routing path for this message.
+func (RemoveSeriesCountdownMsg) Path() string {
+	return "countdown/remove_series_countdown"
+}
+
+// Validate ensures RemoveSeriesCountdownMsg is valid
+func (m RemoveSeriesCountdownMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "SeriesID", isGenID(m.SeriesID, false))
+	errs = errors.AppendField(errs, "CountdownID", isGenID(m.CountdownID, false))
+
+	return errs
+}
+
+var _ weave.Msg = (*ReorderSeriesMsg)(nil)
+
+// Path returns the routing path for this message.
+func (ReorderSeriesMsg) Path() string {
+	return "countdown/reorder_series"
+}
+
+// Validate ensures ReorderSeriesMsg is valid
+func (m ReorderSeriesMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "SeriesID", isGenID(m.SeriesID, false))
+	errs = errors.AppendField(errs, "CountdownIDs", validateSeriesCountdowns(m.CountdownIDs))
+
+	return errs
+}
+
+var _ weave.Msg = (*CountdownTask)(nil)
+
+// Path returns the routing path for this message.
+func (CountdownTask) Path() string {
+	return "countdown/countdown_task"
+}
+
+var _ weave.Msg = (*ExpireCountdownTask)(nil)
+
+// Path returns the routing path for this message.
+func (ExpireCountdownTask) Path() string {
+	return "countdown/expire_countdown_task"
+}
+
+// Validate ensures ExpireCountdownTask is valid
+func (m ExpireCountdownTask) Validate() error {
+	return errors.AppendField(nil, "CountdownID", isGenID(m.CountdownID, false))
+}
@@ -1,10 +1,13 @@
 package countdown
 
 import (
+	"crypto/sha256"
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/weavetest/assert"
@@ -49,20 +52,222 @@ func TestValidateCreateCountdownMsg(t *testing.T) {
 	b, err := json.Marshal(lyrics)
 	assert.Nil(t, err)
 
+	mediaHash := sha256.Sum256([]byte("media"))
+
 	cases := map[string]struct {
 		msg      weave.Msg
 		wantErrs map[string]*errors.Error
 	}{
 		"success": {
+			msg: &CreateCountdownMsg{
+				Metadata:           &weave.Metadata{Schema: 1},
+				Title:              "final countdown",
+				Lyrics:             b,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":           nil,
+				"Title":              nil,
+				"Lyrics":             nil,
+				"MissedRevealPolicy": nil,
+			},
+		},
+		"failure missing missed reveal policy": {
 			msg: &CreateCountdownMsg{
 				Metadata: &weave.Metadata{Schema: 1},
 				Title:    "final countdown",
 				Lyrics:   b,
 			},
 			wantErrs: map[string]*errors.Error{
-				"Metadata": nil,
-				"Title":    nil,
-				"Lyrics":   nil,
+				"Metadata":           nil,
+				"Title":              nil,
+				"Lyrics":             nil,
+				"MissedRevealPolicy": errors.ErrEmpty,
+			},
+		},
+		"failure unknown missed reveal policy": {
+			msg: &CreateCountdownMsg{
+				Metadata:           &weave.Metadata{Schema: 1},
+				Title:              "final countdown",
+				Lyrics:             b,
+				MissedRevealPolicy: 42,
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":           nil,
+				"Title":              nil,
+				"Lyrics":             nil,
+				"MissedRevealPolicy": errors.ErrInput,
+			},
+		},
+		"success with attachments": {
+			msg: &CreateCountdownMsg{
+				Metadata:           &weave.Metadata{Schema: 1},
+				Title:              "final countdown",
+				Lyrics:             b,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				Attachments: []*Attachment{
+					{Line: 0, Hash: mediaHash[:], MimeType: "image/jpeg"},
+					{Line: 4, Hash: mediaHash[:], MimeType: "audio/ogg; codecs=opus"},
+				},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Attachments.0": nil,
+				"Attachments.1": nil,
+				"Attachments":   nil,
+			},
+		},
+		"failure invalid attachments": {
+			msg: &CreateCountdownMsg{
+				Metadata:           &weave.Metadata{Schema: 1},
+				Title:              "final countdown",
+				Lyrics:             b,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				Attachments: []*Attachment{
+					{Line: 0, MimeType: "image/jpeg"},
+					{Line: 1, Hash: mediaHash[:], MimeType: "application/javascript"},
+					{Line: 2, Hash: mediaHash[:], MimeType: "image/"},
+					{Line: 100, Hash: mediaHash[:], MimeType: "audio/mpeg"},
+				},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Attachments.0": errors.ErrEmpty,
+				"Attachments.1": errors.ErrInput,
+				"Attachments.2": errors.ErrInput,
+				"Attachments.3": nil,
+				"Attachments":   errors.ErrInput,
+			},
+		},
+		"success with bounty": {
+			msg: &CreateCountdownMsg{
+				Metadata:           &weave.Metadata{Schema: 1},
+				Title:              "final countdown",
+				Lyrics:             b,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				Bounty:             []*coin.Coin{coin.NewCoinp(10, 0, "IOV")},
+				Beneficiary:        weavetest.NewCondition().Address(),
+			},
+			wantErrs: map[string]*errors.Error{
+				"Bounty":      nil,
+				"Beneficiary": nil,
+				"Sponsor":     nil,
+			},
+		},
+		"failure bounty without beneficiary": {
+			msg: &CreateCountdownMsg{
+				Metadata:           &weave.Metadata{Schema: 1},
+				Title:              "final countdown",
+				Lyrics:             b,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				Bounty:             []*coin.Coin{coin.NewCoinp(10, 0, "IOV")},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Bounty":      nil,
+				"Beneficiary": errors.ErrEmpty,
+			},
+		},
+		"failure beneficiary without bounty": {
+			msg: &CreateCountdownMsg{
+				Metadata:           &weave.Metadata{Schema: 1},
+				Title:              "final countdown",
+				Lyrics:             b,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				Beneficiary:        weavetest.NewCondition().Address(),
+			},
+			wantErrs: map[string]*errors.Error{
+				"Beneficiary": errors.ErrInput,
+			},
+		},
+		"success with repeat policy": {
+			msg: &CreateCountdownMsg{
+				Metadata:           &weave.Metadata{Schema: 1},
+				Title:              "final countdown",
+				Lyrics:             b,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				RepeatPolicy:       RepeatPolicy_Times,
+				RepeatCount:        7,
+			},
+			wantErrs: map[string]*errors.Error{
+				"RepeatPolicy": nil,
+				"RepeatCount":  nil,
+			},
+		},
+		"failure repeat times without count": {
+			msg: &CreateCountdownMsg{
+				Metadata:           &weave.Metadata{Schema: 1},
+				Title:              "final countdown",
+				Lyrics:             b,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				RepeatPolicy:       RepeatPolicy_Times,
+			},
+			wantErrs: map[string]*errors.Error{
+				"RepeatPolicy": nil,
+				"RepeatCount":  errors.ErrInput,
+			},
+		},
+		"failure repeat count without times policy": {
+			msg: &CreateCountdownMsg{
+				Metadata:           &weave.Metadata{Schema: 1},
+				Title:              "final countdown",
+				Lyrics:             b,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				RepeatPolicy:       RepeatPolicy_Forever,
+				RepeatCount:        3,
+			},
+			wantErrs: map[string]*errors.Error{
+				"RepeatPolicy": nil,
+				"RepeatCount":  errors.ErrInput,
+			},
+		},
+		"failure unknown repeat policy": {
+			msg: &CreateCountdownMsg{
+				Metadata:           &weave.Metadata{Schema: 1},
+				Title:              "final countdown",
+				Lyrics:             b,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				RepeatPolicy:       42,
+			},
+			wantErrs: map[string]*errors.Error{
+				"RepeatPolicy": errors.ErrInput,
+			},
+		},
+		"success with tags": {
+			msg: &CreateCountdownMsg{
+				Metadata:           &weave.Metadata{Schema: 1},
+				Title:              "final countdown",
+				Lyrics:             b,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				Tags:               []string{"synth-pop", "80s"},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Tags":   nil,
+				"Tags.0": nil,
+				"Tags.1": nil,
+			},
+		},
+		"failure invalid tags": {
+			msg: &CreateCountdownMsg{
+				Metadata:           &weave.Metadata{Schema: 1},
+				Title:              "final countdown",
+				Lyrics:             b,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				Tags:               []string{"Synth Pop", "rock", "rock"},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Tags.0": errors.ErrInput,
+				"Tags.1": nil,
+				"Tags.2": errors.ErrDuplicate,
+			},
+		},
+		"failure too many tags": {
+			msg: &CreateCountdownMsg{
+				Metadata:           &weave.Metadata{Schema: 1},
+				Title:              "final countdown",
+				Lyrics:             b,
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				Tags:               []string{"a1", "b2", "c3", "d4", "e5", "f6"},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Tags": errors.ErrInput,
 			},
 		},
 		"failure missing title": {
@@ -76,6 +281,32 @@ func TestValidateCreateCountdownMsg(t *testing.T) {
 				"Lyrics":   nil,
 			},
 		},
+		"success with international title and lyrics": {
+			msg: &CreateCountdownMsg{
+				Metadata:           &weave.Metadata{Schema: 1},
+				Title:              "Der letzte Countdown 🚀",
+				Lyrics:             []byte(`["Zéro, un, deux", "最後のカウントダウン"]`),
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+			},
+			wantErrs: map[string]*errors.Error{
+				"Title":         nil,
+				"Lyrics line 0": nil,
+				"Lyrics line 1": nil,
+			},
+		},
+		"failure control characters": {
+			msg: &CreateCountdownMsg{
+				Metadata:           &weave.Metadata{Schema: 1},
+				Title:              "final\tcountdown",
+				Lyrics:             []byte(`["first line", "second\u0007line"]`),
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+			},
+			wantErrs: map[string]*errors.Error{
+				"Title":         errors.ErrModel,
+				"Lyrics line 0": nil,
+				"Lyrics line 1": errors.ErrModel,
+			},
+		},
 		"failure missing lyrics": {
 			msg: &CreateCountdownMsg{
 				Metadata: &weave.Metadata{Schema: 1},
@@ -134,16 +365,536 @@ func TestValidateDeleteCountdown(t *testing.T) {
 	}
 }
 
+func TestValidateGrantRoleMsg(t *testing.T) {
+	cases := map[string]struct {
+		msg      weave.Msg
+		wantErrs map[string]*errors.Error
+	}{
+		"success": {
+			msg: &GrantRoleMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: weavetest.SequenceID(1),
+				Address:     weavetest.NewCondition().Address(),
+				Role:        EditorRole_Editor,
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":    nil,
+				"CountdownID": nil,
+				"Address":     nil,
+				"Role":        nil,
+			},
+		},
+		"failure missing fields": {
+			msg: &GrantRoleMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":    nil,
+				"CountdownID": errors.ErrEmpty,
+				"Address":     errors.ErrEmpty,
+				"Role":        errors.ErrEmpty,
+			},
+		},
+		"failure unknown role": {
+			msg: &GrantRoleMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: weavetest.SequenceID(1),
+				Address:     weavetest.NewCondition().Address(),
+				Role:        42,
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":    nil,
+				"CountdownID": nil,
+				"Address":     nil,
+				"Role":        errors.ErrInput,
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.msg.Validate()
+			for field, wantErr := range tc.wantErrs {
+				assert.FieldError(t, err, field, wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateTransferCountdownMsg(t *testing.T) {
+	cases := map[string]struct {
+		msg      weave.Msg
+		wantErrs map[string]*errors.Error
+	}{
+		"success": {
+			msg: &TransferCountdownMsg{
+				Metadata:      &weave.Metadata{Schema: 1},
+				CountdownID:   weavetest.SequenceID(1),
+				NewOwner:      weavetest.NewCondition().Address(),
+				RequireAccept: true,
+			},
+			wantErrs: map[string]*errors.Error{
+				"CountdownID": nil,
+				"NewOwner":    nil,
+			},
+		},
+		"failure missing fields": {
+			msg: &TransferCountdownMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			wantErrs: map[string]*errors.Error{
+				"CountdownID": errors.ErrEmpty,
+				"NewOwner":    errors.ErrEmpty,
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.msg.Validate()
+			for field, wantErr := range tc.wantErrs {
+				assert.FieldError(t, err, field, wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateTipCountdownMsg(t *testing.T) {
+	cases := map[string]struct {
+		msg      weave.Msg
+		wantErrs map[string]*errors.Error
+	}{
+		"success": {
+			msg: &TipCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: weavetest.SequenceID(1),
+				Amount:      coin.NewCoinp(1, 0, "IOV"),
+			},
+			wantErrs: map[string]*errors.Error{
+				"CountdownID": nil,
+				"Tipper":      nil,
+				"Amount":      nil,
+			},
+		},
+		"failure missing fields": {
+			msg: &TipCountdownMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			wantErrs: map[string]*errors.Error{
+				"CountdownID": errors.ErrEmpty,
+				"Tipper":      nil,
+				"Amount":      errors.ErrEmpty,
+			},
+		},
+		"failure negative amount": {
+			msg: &TipCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: weavetest.SequenceID(1),
+				Tipper:      []byte("bad"),
+				Amount:      coin.NewCoinp(-1, 0, "IOV"),
+			},
+			wantErrs: map[string]*errors.Error{
+				"CountdownID": nil,
+				"Tipper":      errors.ErrInput,
+				"Amount":      errors.ErrAmount,
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.msg.Validate()
+			for field, wantErr := range tc.wantErrs {
+				assert.FieldError(t, err, field, wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateModerationMsgs(t *testing.T) {
+	cases := map[string]struct {
+		msg      weave.Msg
+		wantErrs map[string]*errors.Error
+	}{
+		"success flag": {
+			msg: &FlagCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: weavetest.SequenceID(1),
+				Reason:      "offensive lyrics",
+			},
+			wantErrs: map[string]*errors.Error{
+				"CountdownID": nil,
+				"Reporter":    nil,
+				"Reason":      nil,
+			},
+		},
+		"failure flag without reason": {
+			msg: &FlagCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: weavetest.SequenceID(1),
+				Reporter:    []byte("bad"),
+			},
+			wantErrs: map[string]*errors.Error{
+				"CountdownID": nil,
+				"Reporter":    errors.ErrInput,
+				"Reason":      errors.ErrEmpty,
+			},
+		},
+		"success moderate": {
+			msg: &ModerateCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: weavetest.SequenceID(1),
+				Action:      ModerationAction_Hide,
+			},
+			wantErrs: map[string]*errors.Error{
+				"CountdownID": nil,
+				"Action":      nil,
+				"Reason":      nil,
+			},
+		},
+		"failure moderate with unknown action": {
+			msg: &ModerateCountdownMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Action:   ModerationAction(42),
+				Reason:   strings.Repeat("x", maxFlagReasonLength+1),
+			},
+			wantErrs: map[string]*errors.Error{
+				"CountdownID": errors.ErrEmpty,
+				"Action":      errors.ErrInput,
+				"Reason":      errors.ErrInput,
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.msg.Validate()
+			for field, wantErr := range tc.wantErrs {
+				assert.FieldError(t, err, field, wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateAppendLyricsChunkMsg(t *testing.T) {
+	chunk, err := json.Marshal(lyrics[:3])
+	assert.Nil(t, err)
+	hash := sha256.Sum256(chunk)
+
+	cases := map[string]struct {
+		msg      weave.Msg
+		wantErrs map[string]*errors.Error
+	}{
+		"success": {
+			msg: &AppendLyricsChunkMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				DraftID:  weavetest.SequenceID(1),
+				Lyrics:   chunk,
+				Hash:     hash[:],
+			},
+			wantErrs: map[string]*errors.Error{
+				"DraftID": nil,
+				"Index":   nil,
+				"Lyrics":  nil,
+				"Hash":    nil,
+			},
+		},
+		"failure hash does not match": {
+			msg: &AppendLyricsChunkMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				DraftID:  weavetest.SequenceID(1),
+				Index:    -1,
+				Lyrics:   chunk,
+				Hash:     make([]byte, sha256.Size),
+			},
+			wantErrs: map[string]*errors.Error{
+				"DraftID": nil,
+				"Index":   errors.ErrInput,
+				"Lyrics":  nil,
+				"Hash":    errors.ErrInput,
+			},
+		},
+		"failure missing fields": {
+			msg: &AppendLyricsChunkMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			wantErrs: map[string]*errors.Error{
+				"DraftID": errors.ErrEmpty,
+				"Lyrics":  errors.ErrEmpty,
+				"Hash":    errors.ErrEmpty,
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.msg.Validate()
+			for field, wantErr := range tc.wantErrs {
+				assert.FieldError(t, err, field, wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateMigrateCountdownsMsg(t *testing.T) {
+	cases := map[string]struct {
+		msg      weave.Msg
+		wantErrs map[string]*errors.Error
+	}{
+		"success": {
+			msg: &MigrateCountdownsMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				StartID:  weavetest.SequenceID(1),
+				Limit:    maxMigrateCountdowns,
+			},
+			wantErrs: map[string]*errors.Error{
+				"StartID": nil,
+				"Limit":   nil,
+			},
+		},
+		"failure limit too high": {
+			msg: &MigrateCountdownsMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				StartID:  weavetest.SequenceID(1),
+				Limit:    maxMigrateCountdowns + 1,
+			},
+			wantErrs: map[string]*errors.Error{
+				"StartID": nil,
+				"Limit":   errors.ErrInput,
+			},
+		},
+		"failure missing fields": {
+			msg: &MigrateCountdownsMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			wantErrs: map[string]*errors.Error{
+				"StartID": errors.ErrEmpty,
+				"Limit":   errors.ErrInput,
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.msg.Validate()
+			for field, wantErr := range tc.wantErrs {
+				assert.FieldError(t, err, field, wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateSetSuccessorMsg(t *testing.T) {
+	cases := map[string]struct {
+		msg      weave.Msg
+		wantErrs map[string]*errors.Error
+	}{
+		"success": {
+			msg: &SetSuccessorMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: weavetest.SequenceID(1),
+				SuccessorID: weavetest.SequenceID(2),
+			},
+			wantErrs: map[string]*errors.Error{
+				"CountdownID": nil,
+				"SuccessorID": nil,
+			},
+		},
+		"success removing the successor": {
+			msg: &SetSuccessorMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: weavetest.SequenceID(1),
+			},
+			wantErrs: map[string]*errors.Error{
+				"CountdownID": nil,
+				"SuccessorID": nil,
+			},
+		},
+		"failure own successor": {
+			msg: &SetSuccessorMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: weavetest.SequenceID(1),
+				SuccessorID: weavetest.SequenceID(1),
+			},
+			wantErrs: map[string]*errors.Error{
+				"CountdownID": nil,
+				"SuccessorID": errors.ErrInput,
+			},
+		},
+		"failure invalid IDs": {
+			msg: &SetSuccessorMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				SuccessorID: []byte{1},
+			},
+			wantErrs: map[string]*errors.Error{
+				"CountdownID": errors.ErrEmpty,
+				"SuccessorID": errors.ErrInput,
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.msg.Validate()
+			for field, wantErr := range tc.wantErrs {
+				assert.FieldError(t, err, field, wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateForkCountdownMsg(t *testing.T) {
+	cases := map[string]struct {
+		msg      weave.Msg
+		wantErrs map[string]*errors.Error
+	}{
+		"success": {
+			msg: &ForkCountdownMsg{
+				Metadata:           &weave.Metadata{Schema: 1},
+				SourceID:           weavetest.SequenceID(1),
+				Title:              "remixed countdown",
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				Lines:              []int32{4, 0, 2},
+				Edits:              []*LineEdit{{Line: 2, Text: "a brand new line"}},
+			},
+			wantErrs: map[string]*errors.Error{
+				"SourceID":           nil,
+				"Title":              nil,
+				"MissedRevealPolicy": nil,
+				"Lines":              nil,
+				"Edits.0":            nil,
+			},
+		},
+		"failure duplicated lines": {
+			msg: &ForkCountdownMsg{
+				Metadata:           &weave.Metadata{Schema: 1},
+				SourceID:           weavetest.SequenceID(1),
+				Title:              "remixed countdown",
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				Lines:              []int32{1, 1},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Lines": errors.ErrDuplicate,
+			},
+		},
+		"failure invalid edits": {
+			msg: &ForkCountdownMsg{
+				Metadata:           &weave.Metadata{Schema: 1},
+				SourceID:           weavetest.SequenceID(1),
+				Title:              "remixed countdown",
+				MissedRevealPolicy: MissedRevealPolicy_CatchUp,
+				Lines:              []int32{0, 1},
+				Edits: []*LineEdit{
+					{Line: 2, Text: "not selected"},
+					{Line: 0},
+					{Line: 1, Text: "first edit"},
+					{Line: 1, Text: "second edit"},
+				},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Edits.0": errors.ErrInput,
+				"Edits.1": errors.ErrModel,
+				"Edits.2": nil,
+				"Edits.3": errors.ErrDuplicate,
+			},
+		},
+		"failure missing fields": {
+			msg: &ForkCountdownMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			wantErrs: map[string]*errors.Error{
+				"SourceID":           errors.ErrEmpty,
+				"Title":              errors.ErrModel,
+				"MissedRevealPolicy": errors.ErrEmpty,
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.msg.Validate()
+			for field, wantErr := range tc.wantErrs {
+				assert.FieldError(t, err, field, wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateSeriesMsgs(t *testing.T) {
+	cases := map[string]struct {
+		msg      weave.Msg
+		wantErrs map[string]*errors.Error
+	}{
+		"create success": {
+			msg: &CreateSeriesMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				Title:        "album countdown",
+				CountdownIDs: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(2)},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Title":        nil,
+				"Owner":        nil,
+				"CountdownIDs": nil,
+			},
+		},
+		"create failure duplicate countdown": {
+			msg: &CreateSeriesMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				Title:        "album countdown",
+				CountdownIDs: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(1)},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Title":        nil,
+				"CountdownIDs": errors.ErrDuplicate,
+			},
+		},
+		"add failure negative position": {
+			msg: &AddSeriesCountdownMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				SeriesID:    weavetest.SequenceID(1),
+				CountdownID: weavetest.SequenceID(2),
+				Position:    -1,
+			},
+			wantErrs: map[string]*errors.Error{
+				"SeriesID":    nil,
+				"CountdownID": nil,
+				"Position":    errors.ErrInput,
+			},
+		},
+		"remove failure missing fields": {
+			msg: &RemoveSeriesCountdownMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			wantErrs: map[string]*errors.Error{
+				"SeriesID":    errors.ErrEmpty,
+				"CountdownID": errors.ErrEmpty,
+			},
+		},
+		"reorder failure invalid countdown ID": {
+			msg: &ReorderSeriesMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				SeriesID:     weavetest.SequenceID(1),
+				CountdownIDs: [][]byte{{1, 2, 3}},
+			},
+			wantErrs: map[string]*errors.Error{
+				"SeriesID":     nil,
+				"CountdownIDs": errors.ErrInput,
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.msg.Validate()
+			for field, wantErr := range tc.wantErrs {
+				assert.FieldError(t, err, field, wantErr)
+			}
+		})
+	}
+}
+
 func TestCountdownTask(t *testing.T) {
 	cases := map[string]struct {
 		msg      weave.Msg
 		wantErrs map[string]*errors.Error
 	}{
 		"success": {
-			msg: &CountdownTaskMsg{
+			msg: &CountdownTask{
 				Metadata:    &weave.Metadata{Schema: 1},
 				ID:          weavetest.SequenceID(1),
 				CountdownID: weavetest.SequenceID(1),
+				TaskOwner:   weavetest.NewCondition().Address(),
 			},
 			wantErrs: map[string]*errors.Error{
 				"Metadata":    nil,
@@ -152,20 +903,22 @@ func TestCountdownTask(t *testing.T) {
 			},
 		},
 		// add missing metadata test
-		"failure missing task id": {
-			msg: &CountdownTaskMsg{
-				Metadata: &weave.Metadata{Schema: 1},
+		"success missing task id": {
+			msg: &CountdownTask{
+				Metadata:    &weave.Metadata{Schema: 1},
+				CountdownID: weavetest.SequenceID(1),
 			},
 			wantErrs: map[string]*errors.Error{
 				"Metadata":    nil,
-				"ID":          errors.ErrEmpty,
+				"ID":          nil,
 				"CountdownID": nil,
 			},
 		},
 		"failure invalid task id": {
-			msg: &CountdownTaskMsg{
-				Metadata: &weave.Metadata{Schema: 1},
-				ID:       []byte{0, 0},
+			msg: &CountdownTask{
+				Metadata:    &weave.Metadata{Schema: 1},
+				ID:          []byte{0, 0},
+				CountdownID: weavetest.SequenceID(1),
 			},
 			wantErrs: map[string]*errors.Error{
 				"Metadata":    nil,
@@ -173,6 +926,16 @@ func TestCountdownTask(t *testing.T) {
 				"CountdownID": nil,
 			},
 		},
+		"failure missing countdown id": {
+			msg: &CountdownTask{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":    nil,
+				"ID":          nil,
+				"CountdownID": errors.ErrEmpty,
+			},
+		},
 	}
 	for testName, tc := range cases {
 		t.Run(testName, func(t *testing.T) {
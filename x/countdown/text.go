@@ -0,0 +1,133 @@
+package countdown
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/rivo/uniseg"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	minTitleLength = 4
+	maxTitleLength = 32
+	// maxTitleBytes bounds the size of a title, a single character can be
+	// made of any number of code points
+	maxTitleBytes = 4 * maxTitleLength
+
+	// maxTags is the maximum number of tags of a countdown
+	maxTags      = 5
+	minTagLength = 2
+	maxTagLength = 24
+	tagSeparator = '-'
+
+	// zeroWidthJoiner is the only format character allowed, it joins emoji
+	// into a single character
+	zeroWidthJoiner = '\u200d'
+)
+
+// validateTitle ensures the countdown title is valid text between
+// minTitleLength and maxTitleLength characters and of at most maxTitleBytes
+// bytes.
+func validateTitle(title string) error {
+	if err := validateText(title); err != nil {
+		return err
+	}
+	if len(title) > maxTitleBytes {
+		return errors.Wrapf(errors.ErrModel, "must not be longer than %d bytes", maxTitleBytes)
+	}
+	if n := graphemeCount(title); n < minTitleLength || n > maxTitleLength {
+		return errors.Wrapf(errors.ErrModel, "must be between %d and %d characters", minTitleLength, maxTitleLength)
+	}
+	return nil
+}
+
+// NormalizeTag returns the normalized form of a tag: NFC normalized, lower
+// case and with runs of spaces and underscores replaced by a single hyphen.
+// Clients should normalize tags before creating a countdown or querying
+// countdowns by tag.
+func NormalizeTag(tag string) string {
+	fields := strings.FieldsFunc(norm.NFC.String(strings.ToLower(tag)), func(r rune) bool {
+		return unicode.IsSpace(r) || r == '_'
+	})
+	return strings.Join(fields, string(tagSeparator))
+}
+
+// validateTags ensures there are at most maxTags unique, normalized tags.
+func validateTags(tags []string) error {
+	var errs error
+	if len(tags) > maxTags {
+		errs = errors.AppendField(errs, "Tags", errors.Wrapf(errors.ErrInput, "at most %d tags allowed", maxTags))
+	}
+	seen := make(map[string]bool, len(tags))
+	for i, tag := range tags {
+		field := "Tags." + strconv.Itoa(i)
+		if err := validateTag(tag); err != nil {
+			errs = errors.AppendField(errs, field, err)
+		} else if seen[tag] {
+			errs = errors.AppendField(errs, field, errors.Wrapf(errors.ErrDuplicate, "tag %q", tag))
+		}
+		seen[tag] = true
+	}
+	return errs
+}
+
+// validateTag ensures the tag is normalized and made of lower case letters,
+// numbers and single hyphens between them.
+func validateTag(tag string) error {
+	if !utf8.ValidString(tag) || NormalizeTag(tag) != tag {
+		return errors.Wrap(errors.ErrInput, "must be normalized")
+	}
+	if n := utf8.RuneCountInString(tag); n < minTagLength || n > maxTagLength {
+		return errors.Wrapf(errors.ErrInput, "must be between %d and %d characters", minTagLength, maxTagLength)
+	}
+	if strings.HasPrefix(tag, string(tagSeparator)) || strings.HasSuffix(tag, string(tagSeparator)) || strings.Contains(tag, "--") {
+		return errors.Wrap(errors.ErrInput, "hyphens must separate words")
+	}
+	for _, r := range tag {
+		if r != tagSeparator && !unicode.In(r, unicode.L, unicode.M, unicode.N) {
+			return errors.Wrapf(errors.ErrInput, "character %s is not allowed", strconv.QuoteRune(r))
+		}
+	}
+	return nil
+}
+
+// validateLine ensures a lyrics line is valid, not empty text. Its length
+// limits are part of the module configuration.
+func validateLine(line string) error {
+	if line == "" {
+		return errors.Wrap(errors.ErrModel, "line is empty")
+	}
+	return validateText(line)
+}
+
+// validateText ensures s is NFC normalized UTF-8 text made of letters, marks,
+// numbers, punctuation, symbols and spaces. Control characters, line
+// separators and format characters other than the zero width joiner are
+// rejected.
+func validateText(s string) error {
+	if !utf8.ValidString(s) {
+		return errors.Wrap(errors.ErrModel, "invalid UTF-8")
+	}
+	if !norm.NFC.IsNormalString(s) {
+		return errors.Wrap(errors.ErrModel, "NFC normalization is required")
+	}
+	for _, r := range s {
+		if r == zeroWidthJoiner {
+			continue
+		}
+		if !unicode.In(r, unicode.L, unicode.M, unicode.N, unicode.P, unicode.S, unicode.Zs) {
+			return errors.Wrapf(errors.ErrModel, "character %s is not allowed", strconv.QuoteRune(r))
+		}
+	}
+	return nil
+}
+
+// graphemeCount returns the number of user perceived characters of s, the
+// number of its extended grapheme clusters as defined by UAX #29.
+func graphemeCount(s string) int {
+	return uniseg.GraphemeClusterCount(s)
+}
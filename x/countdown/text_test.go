@@ -0,0 +1,215 @@
+package countdown
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestValidateTitle(t *testing.T) {
+	cases := map[string]struct {
+		title   string
+		wantErr *errors.Error
+	}{
+		"ascii":                    {title: "final countdown"},
+		"punctuation":              {title: "Ten, nine (eight) - seven?"},
+		"accents":                  {title: "Café crème"},
+		"non latin script":         {title: "最後のカウントダウン"},
+		"emoji":                    {title: "liftoff 👩\u200d🚀🚀"},
+		"too short":                {title: "abc", wantErr: errors.ErrModel},
+		"too short with marks":     {title: "ééé", wantErr: errors.ErrModel},
+		"maximal length":           {title: strings.Repeat("é", 32)},
+		"too long":                 {title: strings.Repeat("é", 33), wantErr: errors.ErrModel},
+		"not normalized":           {title: "Cafe\u0301 creme", wantErr: errors.ErrModel},
+		"control character":        {title: "final\ncountdown", wantErr: errors.ErrModel},
+		"format character":         {title: "final\u200bcountdown", wantErr: errors.ErrModel},
+		"line separator":           {title: "final\u2028countdown", wantErr: errors.ErrModel},
+		"invalid utf8":             {title: "final\xffcountdown", wantErr: errors.ErrModel},
+		"empty":                    {title: "", wantErr: errors.ErrModel},
+		"flags count as one each":  {title: "🇩🇪🇫🇷🇮🇹🇪🇸"},
+		"joined emoji count once":  {title: "👨\u200d👩\u200d👧👨\u200d👩\u200d👧👨\u200d👩\u200d👧", wantErr: errors.ErrModel},
+		"skin tone extends emoji":  {title: "👍🏽👍🏽👍🏽👍🏽"},
+		"keycap sequence is valid": {title: "1️⃣2️⃣3️⃣4️⃣"},
+		"long joined emoji chain":  {title: "abc" + strings.Repeat("👨\u200d", 20) + "👧", wantErr: errors.ErrModel},
+		"many combining marks":     {title: "abcx" + strings.Repeat("\u0301", 100), wantErr: errors.ErrModel},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := validateTitle(tc.title); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestValidateLine(t *testing.T) {
+	cases := map[string]struct {
+		line    string
+		wantErr *errors.Error
+	}{
+		"ascii":             {line: "(Ten, nine, eight, seven, six, five, four, three, two, one)"},
+		"accents":           {line: "Nous partons ensemble, mais c'est un adieu"},
+		"non latin script":  {line: "Это последний отсчёт"},
+		"single character":  {line: "!"},
+		"empty":             {line: "", wantErr: errors.ErrModel},
+		"tab":               {line: "one\ttwo", wantErr: errors.ErrModel},
+		"bidi override":     {line: "one\u202etwo", wantErr: errors.ErrModel},
+		"private use":       {line: "one\ue000two", wantErr: errors.ErrModel},
+		"decomposed accent": {line: "e\u0301te\u0301", wantErr: errors.ErrModel},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := validateLine(tc.line); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestValidateTextNotNormalized(t *testing.T) {
+	err := validateText("Cafe\u0301")
+	if !errors.ErrModel.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if !strings.Contains(err.Error(), "NFC normalization is required") {
+		t.Fatalf("want the error to require NFC normalization, got %q", err)
+	}
+}
+
+func TestValidateLines(t *testing.T) {
+	conf := Configuration{MinLineLength: 4, MaxLineLength: 10}
+
+	cases := map[string]struct {
+		maxBytes int32
+		lines    []string
+		wantErr  *errors.Error
+	}{
+		"valid lines": {
+			lines: []string{"final", "countdown"},
+		},
+		"too short": {
+			lines:   []string{"fin"},
+			wantErr: errors.ErrInput,
+		},
+		"too long": {
+			lines:   []string{"final countdown"},
+			wantErr: errors.ErrInput,
+		},
+		"joined emoji chain exceeds the default size": {
+			lines:   []string{"abc" + strings.Repeat("👨\u200d", 10) + "👧"},
+			wantErr: errors.ErrInput,
+		},
+		"configured size": {
+			maxBytes: 12,
+			lines:    []string{"ééééé é"},
+			wantErr:  errors.ErrInput,
+		},
+		"within the configured size": {
+			maxBytes: 12,
+			lines:    []string{"final"},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			c := conf
+			c.MaxLineBytes = tc.maxBytes
+			if err := c.validateLines(tc.lines); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestGraphemeCount(t *testing.T) {
+	cases := map[string]struct {
+		text string
+		want int
+	}{
+		"ascii":                {text: "abc", want: 3},
+		"precomposed accents":  {text: "été", want: 3},
+		"combining marks":      {text: "e\u0301te\u0301", want: 3},
+		"ideographs":           {text: "日本語", want: 3},
+		"emoji with modifier":  {text: "👍🏽", want: 1},
+		"zero width joiner":    {text: "👨\u200d👩\u200d👧", want: 1},
+		"regional indicators":  {text: "🇩🇪🇫🇷", want: 2},
+		"keycap":               {text: "1️⃣", want: 1},
+		"odd regional symbols": {text: "🇩🇪🇫", want: 2},
+		"CR LF":                {text: "\r\n", want: 1},
+		"Hangul L V T jamo":    {text: "\u1100\u1161\u11a8", want: 1},
+		"prepend character":    {text: "\u0600\u0661", want: 1},
+		"spacing mark":         {text: "\u0e01\u0e33", want: 1},
+		"joiner after letter":  {text: "a\u200db", want: 2},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			assert.Equal(t, tc.want, graphemeCount(tc.text))
+		})
+	}
+}
+
+func TestNormalizeTag(t *testing.T) {
+	cases := map[string]string{
+		"Synth Pop":       "synth-pop",
+		"  new_wave  ":    "new-wave",
+		"Café Concert":   "café-concert",
+		"hip-hop":         "hip-hop",
+		"ÉLECTRO   Swing": "électro-swing",
+	}
+	for tag, want := range cases {
+		t.Run(tag, func(t *testing.T) {
+			assert.Equal(t, want, NormalizeTag(tag))
+		})
+	}
+}
+
+func TestValidateTags(t *testing.T) {
+	cases := map[string]struct {
+		tags     []string
+		wantErrs map[string]*errors.Error
+	}{
+		"normalized tags": {
+			tags: []string{"synth-pop", "80s", "café-concert", "ロック"},
+			wantErrs: map[string]*errors.Error{
+				"Tags":   nil,
+				"Tags.0": nil,
+				"Tags.1": nil,
+				"Tags.2": nil,
+				"Tags.3": nil,
+			},
+		},
+		"no tags": {
+			wantErrs: map[string]*errors.Error{
+				"Tags": nil,
+			},
+		},
+		"too many tags": {
+			tags: []string{"one", "two", "three", "four", "five", "six"},
+			wantErrs: map[string]*errors.Error{
+				"Tags": errors.ErrInput,
+			},
+		},
+		"invalid tags": {
+			tags: []string{"Synth Pop", "a", "-rock", "rock--pop", "rock&roll", strings.Repeat("x", maxTagLength+1), "rock", "rock"},
+			wantErrs: map[string]*errors.Error{
+				"Tags.0": errors.ErrInput,
+				"Tags.1": errors.ErrInput,
+				"Tags.2": errors.ErrInput,
+				"Tags.3": errors.ErrInput,
+				"Tags.4": errors.ErrInput,
+				"Tags.5": errors.ErrInput,
+				"Tags.6": nil,
+				"Tags.7": errors.ErrDuplicate,
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := validateTags(tc.tags)
+			for field, wantErr := range tc.wantErrs {
+				assert.FieldError(t, err, field, wantErr)
+			}
+		})
+	}
+}